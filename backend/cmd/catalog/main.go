@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"palaam/internal/catalog"
+	"palaam/internal/config"
+	database "palaam/internal/db"
+
+	"github.com/sethvargo/go-envconfig"
+)
+
+const usage = `Usage: go run ./cmd/catalog import [-dir catalogs] [-dry-run]
+
+Imports the assessment catalog files in dir and prints what changed.
+With -dry-run nothing is saved.`
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] != "import" {
+		log.Fatalln(usage)
+	}
+
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	dir := flags.String("dir", catalog.Dir, "directory holding the catalog files")
+	dryRun := flags.Bool("dry-run", false, "print the changes without saving them")
+	flags.Usage = func() { fmt.Fprintln(os.Stderr, usage) }
+	flags.Parse(os.Args[2:])
+
+	catalogs, err := catalog.Load(*dir)
+	if err != nil {
+		log.Fatalln("Failed to load catalogs: ", err)
+	}
+
+	var dbConfig config.DB
+	if err := envconfig.Process(context.Background(), &dbConfig); err != nil {
+		log.Fatalln("Error processing .env file: ", err)
+	}
+
+	db, err := database.NewConnection(&dbConfig)
+	if err != nil {
+		log.Fatalln("Failed to connect to database: ", err)
+	}
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		log.Fatalln("Failed to load migrations: ", err)
+	}
+	if err := migrator.EnsureCurrent(); err != nil {
+		log.Fatalln(err)
+	}
+
+	diffs, err := catalog.NewImporter(db).Import(catalogs, *dryRun)
+	for _, diff := range diffs {
+		fmt.Println(diff)
+	}
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if *dryRun {
+		fmt.Println("Dry run, nothing was saved")
+	}
+}
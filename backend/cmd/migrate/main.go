@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+
+	"palaam/internal/config"
+	database "palaam/internal/db"
+
+	"github.com/sethvargo/go-envconfig"
+)
+
+const usage = `Usage: go run ./cmd/migrate <command>
+
+Commands:
+  up             apply every pending migration
+  down [steps]   revert the latest applied migrations (default 1)
+  status         list migrations and when they were applied
+  create <name>  add empty up and down files for a new migration`
+
+func main() {
+	if len(os.Args) < 2 {
+		log.Fatalln(usage)
+	}
+
+	// Creating files doesn't need a database
+	if os.Args[1] == "create" {
+		if len(os.Args) < 3 {
+			log.Fatalln(usage)
+		}
+		up, down, err := database.CreateMigration(database.MigrationsDir, os.Args[2])
+		if err != nil {
+			log.Fatalln("Failed to create migration: ", err)
+		}
+		fmt.Println("Created", up)
+		fmt.Println("Created", down)
+		return
+	}
+
+	var dbConfig config.DB
+	if err := envconfig.Process(context.Background(), &dbConfig); err != nil {
+		log.Fatalln("Error processing .env file: ", err)
+	}
+
+	db, err := database.NewConnection(&dbConfig)
+	if err != nil {
+		log.Fatalln("Failed to connect to database: ", err)
+	}
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		log.Fatalln("Failed to load migrations: ", err)
+	}
+
+	switch os.Args[1] {
+	case "up":
+		applied, err := migrator.Up()
+		for _, migration := range applied {
+			fmt.Printf("Applied %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalln(err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("Schema is up to date")
+		}
+
+	case "down":
+		steps := 1
+		if len(os.Args) > 2 {
+			if steps, err = strconv.Atoi(os.Args[2]); err != nil || steps < 1 {
+				log.Fatalln("steps must be a positive number")
+			}
+		}
+		reverted, err := migrator.Down(steps)
+		for _, migration := range reverted {
+			fmt.Printf("Reverted %04d_%s\n", migration.Version, migration.Name)
+		}
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+	case "status":
+		statuses, err := migrator.Status()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		for _, status := range statuses {
+			applied := "pending"
+			if status.AppliedAt != nil {
+				applied = status.AppliedAt.Format("2006-01-02 15:04:05")
+			}
+			fmt.Printf("%04d_%-40s %s\n", status.Version, status.Name, applied)
+		}
+
+	default:
+		log.Fatalln(usage)
+	}
+}
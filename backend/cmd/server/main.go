@@ -7,12 +7,13 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata" // TIMEZONE must resolve on hosts without a zoneinfo database
 
 	"palaam/internal/config"
+	database "palaam/internal/db"
 	"palaam/internal/service"
 
 	"github.com/gofiber/fiber/v2"
-	_ "github.com/lib/pq"
 	"github.com/sethvargo/go-envconfig"
 	"github.com/watchakorn-18k/scalar-go"
 )
@@ -24,12 +25,23 @@ func main() {
 		log.Fatalln("Error processing .env file: ", err)
 	}
 
+	db, err := database.NewConnection(&config.DB)
+	if err != nil {
+		log.Fatalln("Failed to connect to database: ", err)
+	}
+
+	// The schema is changed with cmd/migrate, never by the server
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		log.Fatalln("Failed to load migrations: ", err)
+	}
+	if err := migrator.EnsureCurrent(); err != nil {
+		log.Fatalln("Refusing to start: ", err)
+	}
+
 	app := fiber.New(fiber.Config{
 		AppName: config.Application.Name,
 	})
-	appService := service.NewServer(config)
-
-	routes.AppRouter(app, appService)
 
 	app.Use("/docs", func(c *fiber.Ctx) error {
 		htmlContent, err := scalar.ApiReferenceHTML(&scalar.Options{
@@ -47,6 +59,11 @@ func main() {
 		return c.SendString(htmlContent)
 	})
 
+	// Registered after /docs so the documentation stays public
+	if err := service.InitApp(app, db, config); err != nil {
+		log.Fatalln("Failed to initialize application: ", err)
+	}
+
 	go func() {
 		if err := app.Listen(":" + config.Application.Port); err != nil {
 			log.Fatalf("Failed to start server: %v", err)
@@ -0,0 +1,397 @@
+package audit
+
+// backend/internal/audit/callbacks.go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/models"
+)
+
+// entities maps the tables holding patient data to the entity type written to the log
+var entities = map[string]string{
+	"patients":                   "patient",
+	"sessions":                   "session",
+	"activities":                 "activity",
+	"medicines":                  "medicine",
+	"guardians":                  "guardian",
+	"onboarding_responses":       "onboarding_response",
+	"assessment_administrations": "assessment_administration",
+	"session_series":             "session_series",
+	"invoices":                   "invoice",
+	"payments":                   "payment",
+	"patient_discounts":          "patient_discount",
+	"tax_invoices":               "tax_invoice",
+	"treatment_plans":            "treatment_plan",
+	"treatment_goals":            "treatment_goal",
+	"treatment_targets":          "treatment_target",
+	"trials":                     "trial",
+	"behavior_incidents":         "behavior_incident",
+	"behavior_measurements":      "behavior_measurement",
+	"measurement_data_points":    "measurement_data_point",
+	"medication_administrations": "medication_administration",
+	"prescription_events":        "prescription_event",
+}
+
+// unlogged are columns left out of the changes written to the log, such as rendered documents
+var unlogged = map[string]bool{
+	"pdf": true,
+}
+
+const auditTable = "audit_logs"
+
+// beforeKey stores the rows an update or delete matched, before it ran
+const beforeKey = "audit:before"
+
+var ErrAppendOnly = errors.New("audit log entries can't be changed or removed")
+
+// change is a column's value before and after a write. Creates have no before value and deletes no after value.
+type change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// RegisterCallbacks audits every create, update, delete and query of patient data
+// made through db on behalf of an actor, and stops audit log entries from being changed.
+func RegisterCallbacks(db *gorm.DB) error {
+	callbacks := db.Callback()
+
+	if err := callbacks.Create().After("gorm:create").Register("audit:create", afterCreate); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("audit:before_update", beforeWrite); err != nil {
+		return err
+	}
+	if err := callbacks.Update().After("gorm:update").Register("audit:update", afterUpdate); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().Before("gorm:delete").Register("audit:before_delete", beforeWrite); err != nil {
+		return err
+	}
+	if err := callbacks.Delete().After("gorm:delete").Register("audit:delete", afterDelete); err != nil {
+		return err
+	}
+	return callbacks.Query().After("gorm:query").Register("audit:query", afterQuery)
+}
+
+// audited returns the actor and entity type when a successful statement touches patient data on behalf of someone
+func audited(db *gorm.DB) (*Actor, string, bool) {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return nil, "", false
+	}
+	entity, ok := entities[db.Statement.Table]
+	if !ok {
+		return nil, "", false
+	}
+	actor, ok := ActorFrom(db.Statement.Context)
+	return actor, entity, ok
+}
+
+func beforeWrite(db *gorm.DB) {
+	if db.Statement.Table == auditTable {
+		db.AddError(ErrAppendOnly)
+		return
+	}
+	if _, _, ok := audited(db); !ok {
+		return
+	}
+
+	rows, err := snapshot(db)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+	db.InstanceSet(beforeKey, rows)
+}
+
+func afterCreate(db *gorm.DB) {
+	actor, entity, ok := audited(db)
+	if !ok {
+		return
+	}
+
+	pk := primaryKey(db)
+	for _, row := range modelRows(db) {
+		record(db, actor, models.AuditCreate, entity, row[pk], row, diff(nil, row))
+	}
+}
+
+func afterUpdate(db *gorm.DB) {
+	actor, entity, ok := audited(db)
+	if !ok {
+		return
+	}
+
+	before := matchedRows(db)
+	if len(before) == 0 {
+		return
+	}
+
+	pk := primaryKey(db)
+	ids := make([]any, 0, len(before))
+	for _, row := range before {
+		ids = append(ids, row[pk])
+	}
+
+	var after []map[string]any
+	if err := newDB(db).Table(db.Statement.Table).Where(pk+" IN ?", ids).Find(&after).Error; err != nil {
+		db.AddError(err)
+		return
+	}
+	afterByID := make(map[string]map[string]any, len(after))
+	for _, row := range after {
+		afterByID[fmt.Sprint(plain(row[pk]))] = row
+	}
+
+	for _, row := range before {
+		record(db, actor, models.AuditUpdate, entity, row[pk], row, diff(row, afterByID[fmt.Sprint(plain(row[pk]))]))
+	}
+}
+
+func afterDelete(db *gorm.DB) {
+	actor, entity, ok := audited(db)
+	if !ok {
+		return
+	}
+
+	pk := primaryKey(db)
+	for _, row := range matchedRows(db) {
+		record(db, actor, models.AuditDelete, entity, row[pk], row, diff(row, nil))
+	}
+}
+
+func afterQuery(db *gorm.DB) {
+	actor, entity, ok := audited(db)
+	if !ok {
+		return
+	}
+
+	pk := primaryKey(db)
+	for _, row := range modelRows(db) {
+		key := entity + ":" + fmt.Sprint(plain(row[pk]))
+		if _, seen := actor.viewed.LoadOrStore(key, true); seen {
+			continue
+		}
+		record(db, actor, models.AuditView, entity, row[pk], row, nil)
+	}
+}
+
+// record appends an entry to the audit log. A failure fails the audited statement,
+// so patient data is never read or written without a trace.
+func record(db *gorm.DB, actor *Actor, action models.AuditAction, entity string, id any, row map[string]any, changes map[string]change) {
+	entry := &models.AuditLog{
+		ActorID:    actor.ID,
+		ActorRole:  actor.Role,
+		Action:     action,
+		EntityType: entity,
+		EntityID:   fmt.Sprint(plain(id)),
+		PatientID:  patientOf(db, row),
+		RequestID:  actor.RequestID,
+		IP:         actor.IP,
+	}
+
+	if len(changes) > 0 {
+		data, err := json.Marshal(changes)
+		if err != nil {
+			db.AddError(err)
+			return
+		}
+		encoded := string(data)
+		entry.Changes = &encoded
+	}
+
+	if err := newDB(db).Create(entry).Error; err != nil {
+		db.AddError(err)
+	}
+}
+
+// patientOf resolves the patient a row belongs to, so the log can be filtered by patient.
+// Guardians can have several children and aren't tied to one.
+func patientOf(db *gorm.DB, row map[string]any) *string {
+	var id any
+	switch db.Statement.Table {
+	case "patients":
+		id = row["id"]
+	case "guardians":
+		return nil
+	case "activities":
+		sessionID := plain(row["session_id"])
+		if sessionID == nil {
+			return nil
+		}
+		var patientID string
+		err := newDB(db).Table("sessions").Select("patient_id").Where("id = ?", sessionID).Scan(&patientID).Error
+		if err != nil || patientID == "" {
+			return nil
+		}
+		return &patientID
+	case "payments", "tax_invoices":
+		invoiceID := plain(row["invoice_id"])
+		if invoiceID == nil {
+			return nil
+		}
+		var patientID string
+		err := newDB(db).Table("invoices").Select("patient_id").Where("id = ?", invoiceID).Scan(&patientID).Error
+		if err != nil || patientID == "" {
+			return nil
+		}
+		return &patientID
+	case "trials":
+		activityID := plain(row["activity_id"])
+		if activityID == nil {
+			return nil
+		}
+		var patientID string
+		err := newDB(db).Table("activities").
+			Joins("JOIN sessions ON sessions.id = activities.session_id").
+			Select("sessions.patient_id").
+			Where("activities.id = ?", activityID).
+			Scan(&patientID).Error
+		if err != nil || patientID == "" {
+			return nil
+		}
+		return &patientID
+	case "measurement_data_points":
+		measurementID := plain(row["measurement_id"])
+		if measurementID == nil {
+			return nil
+		}
+		var patientID string
+		err := newDB(db).Table("behavior_measurements").Select("patient_id").Where("id = ?", measurementID).Scan(&patientID).Error
+		if err != nil || patientID == "" {
+			return nil
+		}
+		return &patientID
+	case "treatment_goals", "treatment_targets":
+		planID := plain(row["plan_id"])
+		if planID == nil {
+			return nil
+		}
+		var patientID string
+		err := newDB(db).Table("treatment_plans").Select("patient_id").Where("id = ?", planID).Scan(&patientID).Error
+		if err != nil || patientID == "" {
+			return nil
+		}
+		return &patientID
+	default:
+		id = row["patient_id"]
+	}
+
+	if id = plain(id); id == nil {
+		return nil
+	}
+	patientID := fmt.Sprint(id)
+	return &patientID
+}
+
+// snapshot loads the rows matched by the WHERE clause of an update or delete that hasn't run yet
+func snapshot(db *gorm.DB) ([]map[string]any, error) {
+	where, ok := db.Statement.Clauses["WHERE"]
+	if !ok {
+		// Gorm refuses updates and deletes without conditions
+		return nil, nil
+	}
+
+	var rows []map[string]any
+	err := newDB(db).Table(db.Statement.Table).Clauses(where.Expression).Find(&rows).Error
+	return rows, err
+}
+
+// matchedRows returns the rows beforeWrite saved for the statement
+func matchedRows(db *gorm.DB) []map[string]any {
+	value, _ := db.InstanceGet(beforeKey)
+	rows, _ := value.([]map[string]any)
+	return rows
+}
+
+// modelRows returns the column values of the models a statement created or loaded
+func modelRows(db *gorm.DB) []map[string]any {
+	stmt := db.Statement
+	var rows []map[string]any
+
+	add := func(value reflect.Value) {
+		value = reflect.Indirect(value)
+		if value.Kind() != reflect.Struct || value.Type() != stmt.Schema.ModelType {
+			return
+		}
+		row := make(map[string]any, len(stmt.Schema.DBNames))
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName == "" {
+				continue
+			}
+			row[field.DBName], _ = field.ValueOf(stmt.Context, value)
+		}
+		rows = append(rows, row)
+	}
+
+	switch value := reflect.Indirect(stmt.ReflectValue); value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			add(value.Index(i))
+		}
+	case reflect.Struct:
+		add(value)
+	}
+	return rows
+}
+
+// diff returns the columns whose values differ between two versions of a row
+func diff(before, after map[string]any) map[string]change {
+	changes := map[string]change{}
+	for column := range before {
+		changes[column] = change{}
+	}
+	for column := range after {
+		changes[column] = change{}
+	}
+
+	for column := range changes {
+		if unlogged[column] {
+			delete(changes, column)
+			continue
+		}
+		from, to := plain(before[column]), plain(after[column])
+		if fmt.Sprint(from) == fmt.Sprint(to) {
+			delete(changes, column)
+			continue
+		}
+		changes[column] = change{Before: from, After: to}
+	}
+	return changes
+}
+
+// plain dereferences pointers and turns driver byte slices into strings, so values compare and encode cleanly
+func plain(value any) any {
+	if value == nil {
+		return nil
+	}
+
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if b, ok := rv.Interface().([]byte); ok {
+		return string(b)
+	}
+	return rv.Interface()
+}
+
+func primaryKey(db *gorm.DB) string {
+	if field := db.Statement.Schema.PrioritizedPrimaryField; field != nil {
+		return field.DBName
+	}
+	return "id"
+}
+
+// newDB starts a fresh statement on the same connection or transaction, without the actor
+func newDB(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true, Context: withoutActor(db.Statement.Context)})
+}
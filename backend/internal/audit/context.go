@@ -0,0 +1,42 @@
+package audit
+
+// backend/internal/audit/context.go
+
+import (
+	"context"
+	"sync"
+
+	"palaam/internal/models"
+)
+
+// Actor is who a request acts on behalf of, as recorded in the audit log
+type Actor struct {
+	ID        string
+	Role      models.StaffRole
+	RequestID string
+	IP        string
+
+	// viewed remembers the rows already logged as viewed during the request, so
+	// a handler that reads the same patient several times logs it once
+	viewed sync.Map
+}
+
+type actorKey struct{}
+
+// WithActor returns a context whose database operations are audited as actor
+func WithActor(ctx context.Context, actor *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor stored in ctx, if any. Operations without an
+// actor, such as migrations and seeding, aren't audited.
+func ActorFrom(ctx context.Context) (*Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(*Actor)
+	return actor, ok && actor != nil
+}
+
+// withoutActor returns ctx with the actor removed, so the queries the audit
+// callbacks make themselves aren't audited
+func withoutActor(ctx context.Context) context.Context {
+	return context.WithValue(ctx, actorKey{}, (*Actor)(nil))
+}
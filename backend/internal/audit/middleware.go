@@ -0,0 +1,30 @@
+package audit
+
+// backend/internal/audit/middleware.go
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+
+	"palaam/internal/auth"
+)
+
+// Middleware stores the signed-in caller, request ID and client IP on the request's
+// user context. It must run after auth.Middleware.
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		claims, ok := auth.ClaimsFrom(c)
+		if !ok {
+			return c.Next()
+		}
+
+		requestID, _ := c.Locals(requestid.ConfigDefault.ContextKey).(string)
+		c.SetUserContext(WithActor(c.UserContext(), &Actor{
+			ID:        claims.Subject,
+			Role:      claims.Role,
+			RequestID: requestID,
+			IP:        c.IP(),
+		}))
+		return c.Next()
+	}
+}
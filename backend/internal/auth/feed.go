@@ -0,0 +1,26 @@
+package auth
+
+// backend/internal/auth/feed.go
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// GenerateFeedToken returns a random token for a calendar feed URL
+func GenerateFeedToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashFeedToken hashes a feed token for storage. Feed tokens are random, so unlike
+// passwords they can be looked up by a plain hash.
+func HashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,69 @@
+package auth
+
+// backend/internal/auth/middleware.go
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+const claimsKey = "auth.claims"
+
+// Middleware rejects requests that don't carry a valid bearer token and stores
+// the token's claims on the request. Requests to any of the public paths skip the check.
+// Public paths may have parameters, such as /calendar/staff/:id.
+func Middleware(tokens *TokenManager, publicPaths ...string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if slices.ContainsFunc(publicPaths, func(pattern string) bool { return matchPath(pattern, c.Path()) }) {
+			return c.Next()
+		}
+
+		header := c.Get(fiber.HeaderAuthorization)
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "missing bearer token",
+			})
+		}
+
+		claims, err := tokens.Parse(token)
+		if err != nil {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+
+		c.Locals(claimsKey, claims)
+		return c.Next()
+	}
+}
+
+// ClaimsFrom returns the claims of the authenticated caller, if any
+func ClaimsFrom(c *fiber.Ctx) (*Claims, bool) {
+	claims, ok := c.Locals(claimsKey).(*Claims)
+	return claims, ok
+}
+
+// matchPath reports whether a path matches a route pattern, where segments starting
+// with a colon match any one segment
+func matchPath(pattern, path string) bool {
+	patternSegments := strings.Split(strings.Trim(pattern, "/"), "/")
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(patternSegments) != len(pathSegments) {
+		return false
+	}
+	for i, segment := range patternSegments {
+		if strings.HasPrefix(segment, ":") {
+			if pathSegments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package auth
+
+// backend/internal/auth/otp.go
+
+import (
+	"crypto/rand"
+	"fmt"
+	"log/slog"
+	"math/big"
+)
+
+const (
+	ChannelEmail = "email"
+	ChannelSMS   = "sms"
+)
+
+// OTPSender delivers one-time sign-in codes to guardians
+type OTPSender interface {
+	Send(channel, destination, code string) error
+}
+
+// LogSender is a local stand-in for an email or SMS provider. It writes codes to the log.
+type LogSender struct{}
+
+func (LogSender) Send(channel, destination, code string) error {
+	slog.Info("guardian sign-in code", "channel", channel, "destination", destination, "code", code)
+	return nil
+}
+
+// GenerateCode returns a random six digit code
+func GenerateCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
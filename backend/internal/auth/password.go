@@ -0,0 +1,22 @@
+package auth
+
+// backend/internal/auth/password.go
+
+import "golang.org/x/crypto/bcrypt"
+
+// HashPassword hashes a plain-text password for storage
+func HashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// CheckPassword reports whether a plain-text password matches a stored hash
+func CheckPassword(hash, password string) bool {
+	if hash == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -0,0 +1,92 @@
+package auth
+
+// backend/internal/auth/token.go
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+var ErrInvalidToken = errors.New("invalid or expired token")
+
+// Claims are the custom JWT claims carried by every access token.
+// The token subject is the authenticated staff member's ID, or the guardian's ID
+// for portal tokens, which carry models.RoleGuardian.
+type Claims struct {
+	Role models.StaffRole `json:"role"`
+	jwt.RegisteredClaims
+}
+
+// StaffID returns the ID of the staff member the token was issued to
+func (c *Claims) StaffID() string {
+	return c.Subject
+}
+
+// GuardianID returns the ID of the guardian a portal token was issued to
+func (c *Claims) GuardianID() string {
+	if c.Role != models.RoleGuardian {
+		return ""
+	}
+	return c.Subject
+}
+
+type TokenManager struct {
+	secret []byte
+	ttl    time.Duration
+	issuer string
+}
+
+// NewTokenManager creates a token manager that signs tokens with the configured secret
+func NewTokenManager(cfg config.Auth, issuer string) *TokenManager {
+	return &TokenManager{
+		secret: []byte(cfg.JWTSecret),
+		ttl:    cfg.TokenTTL,
+		issuer: issuer,
+	}
+}
+
+// Issue signs a new access token for a staff member
+func (m *TokenManager) Issue(staff *models.Staff) (string, error) {
+	return m.sign(staff.ID, staff.Role)
+}
+
+// IssueGuardian signs a new portal access token for a guardian
+func (m *TokenManager) IssueGuardian(guardian *models.Guardian) (string, error) {
+	return m.sign(guardian.ID, models.RoleGuardian)
+}
+
+func (m *TokenManager) sign(subject string, role models.StaffRole) (string, error) {
+	now := time.Now()
+	claims := Claims{
+		Role: role,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   subject,
+			Issuer:    m.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(m.ttl)),
+		},
+	}
+
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+}
+
+// Parse validates a signed token and returns its claims
+func (m *TokenManager) Parse(token string) (*Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil || claims.Subject == "" {
+		return nil, ErrInvalidToken
+	}
+	return &claims, nil
+}
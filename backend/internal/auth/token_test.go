@@ -0,0 +1,121 @@
+package auth
+
+// backend/internal/auth/token_test.go
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestParse(t *testing.T) {
+	tokens := NewTokenManager(config.Auth{JWTSecret: "secret", TokenTTL: time.Hour}, "palaam")
+	staff := &models.Staff{ID: "staff-1", Role: models.RoleTherapist}
+	token, err := tokens.Issue(staff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expired, err := NewTokenManager(config.Auth{JWTSecret: "secret", TokenTTL: -time.Minute}, "palaam").Issue(staff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherSecret, err := NewTokenManager(config.Auth{JWTSecret: "other", TokenTTL: time.Hour}, "palaam").Issue(staff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherIssuer, err := NewTokenManager(config.Auth{JWTSecret: "secret", TokenTTL: time.Hour}, "other").Issue(staff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{"valid", token, false},
+		{"expired", expired, true},
+		{"signed with another secret", otherSecret, true},
+		{"from another issuer", otherIssuer, true},
+		{"malformed", "not-a-token", true},
+		{"empty", "", true},
+	}
+	for _, tt := range tests {
+		claims, err := tokens.Parse(tt.token)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Parse error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (claims.StaffID() != staff.ID || claims.Role != staff.Role) {
+			t.Errorf("%s: Parse = %s %s, want %s %s", tt.name, claims.StaffID(), claims.Role, staff.ID, staff.Role)
+		}
+	}
+}
+
+func TestCheckPassword(t *testing.T) {
+	hash, err := HashPassword("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		hash, password string
+		want           bool
+	}{
+		{hash, "correct horse", true},
+		{hash, "wrong horse", false},
+		{hash, "", false},
+		{"", "correct horse", false},
+	}
+	for _, tt := range tests {
+		if got := CheckPassword(tt.hash, tt.password); got != tt.want {
+			t.Errorf("CheckPassword(%q, %q) = %v, want %v", tt.hash, tt.password, got, tt.want)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tokens := NewTokenManager(config.Auth{JWTSecret: "secret", TokenTTL: time.Hour}, "palaam")
+	token, err := tokens.Issue(&models.Staff{ID: "staff-1", Role: models.RoleAdmin})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	app := fiber.New()
+	app.Use(Middleware(tokens, "/auth/login"))
+	app.All("/*", func(c *fiber.Ctx) error {
+		if claims, ok := ClaimsFrom(c); ok {
+			return c.SendString(claims.StaffID())
+		}
+		return c.SendString("anonymous")
+	})
+
+	tests := []struct {
+		path, header string
+		want         int
+	}{
+		{"/auth/login", "", fiber.StatusOK},
+		{"/patients", "", fiber.StatusUnauthorized},
+		{"/patients", "Bearer ", fiber.StatusUnauthorized},
+		{"/patients", "Basic " + token, fiber.StatusUnauthorized},
+		{"/patients", "Bearer not-a-token", fiber.StatusUnauthorized},
+		{"/patients", "Bearer " + token, fiber.StatusOK},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(fiber.MethodGet, tt.path, nil)
+		if tt.header != "" {
+			req.Header.Set(fiber.HeaderAuthorization, tt.header)
+		}
+		resp, err := app.Test(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != tt.want {
+			t.Errorf("GET %s with %q = %d, want %d", tt.path, tt.header, resp.StatusCode, tt.want)
+		}
+	}
+}
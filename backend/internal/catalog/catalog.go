@@ -0,0 +1,207 @@
+// Package catalog loads assessment definitions from versioned YAML or JSON files
+// and imports them into the database.
+package catalog
+
+// backend/internal/catalog/catalog.go
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"palaam/internal/models"
+)
+
+// Dir is where catalog files live, relative to the backend directory
+const Dir = "catalogs"
+
+// Catalog is one assessment as described by its file. Bump Version whenever the file changes,
+// so older copies of the file can't overwrite a newer import.
+type Catalog struct {
+	Name        string  `yaml:"name" json:"name"`
+	Version     int     `yaml:"version" json:"version"`
+	Description string  `yaml:"description" json:"description"`
+	Scoring     Scoring `yaml:"scoring" json:"scoring"`
+	Groups      []Group `yaml:"groups" json:"groups"`
+
+	// File the catalog was loaded from
+	File string `yaml:"-" json:"-"`
+}
+
+// Scoring describes how answers are scored. It's stored with the assessment as JSON.
+type Scoring = models.AssessmentScoring
+
+// Group is a numbered section of an assessment. Assessments without sections use a single group 0.
+// Its domain and milestone level apply to every question in it that doesn't set its own.
+type Group struct {
+	Number    int        `yaml:"number" json:"number"`
+	Name      string     `yaml:"name" json:"name"`
+	Domain    string     `yaml:"domain" json:"domain"`
+	Level     *int       `yaml:"level" json:"level"`
+	Questions []Question `yaml:"questions" json:"questions"`
+}
+
+// Question is identified by its code, which must stay the same when the text is reworded.
+// The code defaults to the text, and the answer type to a score.
+type Question struct {
+	Code     string            `yaml:"code" json:"code"`
+	Text     string            `yaml:"text" json:"text"`
+	Type     models.AnswerType `yaml:"type" json:"type"`
+	MaxScore *float64          `yaml:"max_score" json:"max_score"`
+	Domain   string            `yaml:"domain" json:"domain"`
+	Level    *int              `yaml:"level" json:"level"`
+}
+
+// Load reads every .yaml, .yml and .json catalog in dir, sorted by file name
+func Load(dir string) ([]*Catalog, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalogs []*Catalog
+	names := map[string]string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".yaml", ".yml", ".json":
+		default:
+			continue
+		}
+
+		catalog, err := LoadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := names[catalog.Name]; ok {
+			return nil, fmt.Errorf("%s: assessment %q is already defined in %s", path, catalog.Name, other)
+		}
+		names[catalog.Name] = path
+		catalogs = append(catalogs, catalog)
+	}
+
+	sort.Slice(catalogs, func(i, j int) bool {
+		return catalogs[i].File < catalogs[j].File
+	})
+	return catalogs, nil
+}
+
+// LoadFile reads and validates a single catalog file
+func LoadFile(path string) (*Catalog, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalog Catalog
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&catalog)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&catalog)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	catalog.File = path
+	if err := catalog.normalize(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &catalog, nil
+}
+
+// normalize trims text, fills in default codes and checks the catalog is usable
+func (c *Catalog) normalize() error {
+	c.Name = strings.TrimSpace(c.Name)
+	c.Description = strings.TrimSpace(c.Description)
+	if c.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(c.Name) > 100 {
+		return fmt.Errorf("name can't be longer than 100 characters")
+	}
+	if c.Version < 1 {
+		return fmt.Errorf("version must be 1 or higher")
+	}
+
+	levels := map[int]bool{}
+	if milestones := c.Scoring.Milestones; milestones != nil {
+		if milestones.Mastery <= 0 || milestones.Mastery > 1 {
+			return fmt.Errorf("scoring.milestones.mastery must be above 0 and at most 1")
+		}
+		for _, level := range milestones.Levels {
+			levels[level.Level] = true
+		}
+	}
+
+	groups := map[int]bool{}
+	codes := map[string]bool{}
+	for i := range c.Groups {
+		group := &c.Groups[i]
+		group.Name = strings.TrimSpace(group.Name)
+		group.Domain = strings.TrimSpace(group.Domain)
+		if groups[group.Number] {
+			return fmt.Errorf("group %d is defined twice", group.Number)
+		}
+		groups[group.Number] = true
+
+		for j := range group.Questions {
+			question := &group.Questions[j]
+			question.Text = strings.TrimSpace(question.Text)
+			question.Code = strings.TrimSpace(question.Code)
+			if question.Text == "" {
+				return fmt.Errorf("question %d of group %d has no text", j+1, group.Number)
+			}
+			if question.Code == "" {
+				question.Code = question.Text
+			}
+			if len(question.Code) > 100 {
+				return fmt.Errorf("question code %q is longer than 100 characters, give it a shorter code", question.Code)
+			}
+			if codes[question.Code] {
+				return fmt.Errorf("question code %q is used twice", question.Code)
+			}
+			codes[question.Code] = true
+
+			if question.MaxScore != nil && *question.MaxScore <= 0 {
+				return fmt.Errorf("question %q must have a positive max_score", question.Code)
+			}
+
+			switch question.Type {
+			case "":
+				question.Type = models.AnswerScore
+			case models.AnswerScore, models.AnswerYesNo, models.AnswerText:
+			default:
+				return fmt.Errorf("question %q has unknown type %q, use score, yes_no or text", question.Code, question.Type)
+			}
+
+			question.Domain = strings.TrimSpace(question.Domain)
+			if question.Domain == "" {
+				question.Domain = group.Domain
+			}
+			if question.Level == nil {
+				question.Level = group.Level
+			}
+			if question.Level != nil && !levels[*question.Level] {
+				return fmt.Errorf("question %q is at milestone level %d, which isn't listed under scoring.milestones", question.Code, *question.Level)
+			}
+			if question.Level != nil && question.Domain == "" {
+				return fmt.Errorf("question %q has a milestone level but no domain", question.Code)
+			}
+		}
+	}
+	return nil
+}
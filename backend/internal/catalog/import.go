@@ -0,0 +1,292 @@
+package catalog
+
+// backend/internal/catalog/import.go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// errDryRun rolls back a dry run's transaction once the diff is known
+var errDryRun = errors.New("dry run")
+
+// Diff is what an import changed, or would change, for one assessment
+type Diff struct {
+	Assessment string
+	File       string
+	Created    bool
+	Fields     []string // Assessment fields that changed
+	Added      []string // Codes of new questions
+	Changed    []QuestionChange
+	Retired    []string // Codes of questions no longer in the file
+	Restored   []string // Codes of retired questions back in the file
+}
+
+type QuestionChange struct {
+	Code   string
+	Fields []string
+}
+
+// Empty reports whether the database already matched the file
+func (d *Diff) Empty() bool {
+	return !d.Created && len(d.Fields) == 0 && len(d.Added) == 0 && len(d.Changed) == 0 &&
+		len(d.Retired) == 0 && len(d.Restored) == 0
+}
+
+func (d *Diff) String() string {
+	if d.Empty() {
+		return d.Assessment + ": up to date"
+	}
+
+	var b strings.Builder
+	b.WriteString(d.Assessment + ":")
+	if d.Created {
+		b.WriteString(" created")
+	} else if len(d.Fields) > 0 {
+		b.WriteString(" changed " + strings.Join(d.Fields, ", "))
+	}
+	for _, code := range d.Added {
+		fmt.Fprintf(&b, "\n  + %s", code)
+	}
+	for _, change := range d.Changed {
+		fmt.Fprintf(&b, "\n  ~ %s (%s)", change.Code, strings.Join(change.Fields, ", "))
+	}
+	for _, code := range d.Restored {
+		fmt.Fprintf(&b, "\n  ^ %s (restored)", code)
+	}
+	for _, code := range d.Retired {
+		fmt.Fprintf(&b, "\n  - %s (retired)", code)
+	}
+	return b.String()
+}
+
+type Importer struct {
+	db *gorm.DB
+}
+
+func NewImporter(db *gorm.DB) *Importer {
+	return &Importer{db: db}
+}
+
+// Import brings the assessments and questions in the database in line with the catalogs.
+// Each catalog is applied in its own transaction. Importing the same files again changes
+// nothing. Questions missing from a file are retired rather than deleted, so past responses
+// keep pointing at them. With dryRun set, the diffs are computed and every change is rolled back.
+func (i *Importer) Import(catalogs []*Catalog, dryRun bool) ([]*Diff, error) {
+	diffs := make([]*Diff, 0, len(catalogs))
+	for _, catalog := range catalogs {
+		var diff *Diff
+		err := i.db.Transaction(func(tx *gorm.DB) error {
+			var err error
+			if diff, err = apply(repository.NewRepository(tx), catalog); err != nil {
+				return err
+			}
+			if dryRun {
+				return errDryRun
+			}
+			return nil
+		})
+		if err != nil && !errors.Is(err, errDryRun) {
+			return diffs, fmt.Errorf("%s: %w", catalog.File, err)
+		}
+		diffs = append(diffs, diff)
+	}
+	return diffs, nil
+}
+
+// apply imports a single catalog and returns what it changed
+func apply(repo *repository.Repository, catalog *Catalog) (*Diff, error) {
+	diff := &Diff{Assessment: catalog.Name, File: catalog.File}
+
+	scoring, err := encodeScoring(catalog.Scoring)
+	if err != nil {
+		return nil, err
+	}
+	description := optional(catalog.Description)
+
+	assessment, err := repo.Assessment.FindByName(catalog.Name)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		assessment = &models.Assessment{
+			Name:        catalog.Name,
+			Description: description,
+			Version:     catalog.Version,
+			Scoring:     scoring,
+		}
+		if err := repo.Assessment.Create(assessment); err != nil {
+			return nil, err
+		}
+		diff.Created = true
+	} else if err != nil {
+		return nil, err
+	} else {
+		if catalog.Version < assessment.Version {
+			return nil, fmt.Errorf("version %d of %s is older than the imported version %d", catalog.Version, catalog.Name, assessment.Version)
+		}
+
+		updates := map[string]interface{}{}
+		if !equalStrings(assessment.Description, description) {
+			updates["description"] = description
+			diff.Fields = append(diff.Fields, "description")
+		}
+		if assessment.Version != catalog.Version {
+			updates["version"] = catalog.Version
+			diff.Fields = append(diff.Fields, "version")
+		}
+		if !equalStrings(assessment.Scoring, scoring) {
+			updates["scoring"] = scoring
+			diff.Fields = append(diff.Fields, "scoring")
+		}
+		if len(updates) > 0 {
+			if err := repo.Assessment.Update(assessment.ID, updates); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	existing, err := repo.OnboardingQuestion.FindAllByAssessmentID(assessment.ID)
+	if err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]*models.OnboardingQuestion, len(existing))
+	for _, question := range existing {
+		byCode[question.Code] = question
+	}
+
+	position := 0
+	for _, group := range catalog.Groups {
+		groupName := optional(group.Name)
+		for _, question := range group.Questions {
+			position++
+
+			current, ok := byCode[question.Code]
+			if !ok {
+				if err := repo.OnboardingQuestion.Create(&models.OnboardingQuestion{
+					AssessmentID:   assessment.ID,
+					Code:           question.Code,
+					Text:           question.Text,
+					Group:          group.Number,
+					GroupName:      groupName,
+					Position:       position,
+					MaxScore:       question.MaxScore,
+					AnswerType:     question.Type,
+					Domain:         optional(question.Domain),
+					MilestoneLevel: question.Level,
+				}); err != nil {
+					return nil, err
+				}
+				diff.Added = append(diff.Added, question.Code)
+				continue
+			}
+			delete(byCode, question.Code)
+
+			updates := map[string]interface{}{}
+			var fields []string
+			if current.Text != question.Text {
+				updates["text"] = question.Text
+				fields = append(fields, "text")
+			}
+			if current.Group != group.Number {
+				updates["group_number"] = group.Number
+				fields = append(fields, "group")
+			}
+			if !equalStrings(current.GroupName, groupName) {
+				updates["group_name"] = groupName
+				fields = append(fields, "group name")
+			}
+			if current.Position != position {
+				updates["position"] = position
+				fields = append(fields, "position")
+			}
+			if !equalFloats(current.MaxScore, question.MaxScore) {
+				updates["max_score"] = question.MaxScore
+				fields = append(fields, "max score")
+			}
+			if current.AnswerType != question.Type {
+				updates["answer_type"] = question.Type
+				fields = append(fields, "type")
+			}
+			if !equalStrings(current.Domain, optional(question.Domain)) {
+				updates["domain"] = optional(question.Domain)
+				fields = append(fields, "domain")
+			}
+			if !equalInts(current.MilestoneLevel, question.Level) {
+				updates["milestone_level"] = question.Level
+				fields = append(fields, "level")
+			}
+			if current.Retired {
+				updates["retired"] = false
+				diff.Restored = append(diff.Restored, question.Code)
+			}
+			if len(updates) == 0 {
+				continue
+			}
+			if err := repo.OnboardingQuestion.Update(current.ID, updates); err != nil {
+				return nil, err
+			}
+			if len(fields) > 0 {
+				diff.Changed = append(diff.Changed, QuestionChange{Code: question.Code, Fields: fields})
+			}
+		}
+	}
+
+	// Whatever is left is no longer in the file
+	for _, question := range existing {
+		if _, ok := byCode[question.Code]; !ok || question.Retired {
+			continue
+		}
+		if err := repo.OnboardingQuestion.Update(question.ID, map[string]interface{}{"retired": true}); err != nil {
+			return nil, err
+		}
+		diff.Retired = append(diff.Retired, question.Code)
+	}
+
+	return diff, nil
+}
+
+// encodeScoring stores the scoring metadata as JSON, or nothing when the catalog has none
+func encodeScoring(scoring Scoring) (*string, error) {
+	if len(scoring.Scale) == 0 && scoring.Milestones == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(scoring)
+	if err != nil {
+		return nil, err
+	}
+	encoded := string(data)
+	return &encoded, nil
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func equalStrings(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalFloats(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalInts(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,11 @@
+package config
+
+import "time"
+
+type Auth struct {
+	JWTSecret     string        `env:"JWT_SECRET, required"` // the key used to sign access tokens
+	TokenTTL      time.Duration `env:"JWT_TTL, default=12h"` // how long an issued access token stays valid
+	AdminEmail    string        `env:"ADMIN_EMAIL"`          // email of the admin account created on first boot
+	AdminPassword string        `env:"ADMIN_PASSWORD"`       // password of the admin account created on first boot
+	OTPTTL        time.Duration `env:"OTP_TTL, default=10m"` // how long a guardian sign-in code stays valid
+}
@@ -0,0 +1,10 @@
+package config
+
+type Billing struct {
+	PaymentTermDays int     `env:"INVOICE_DUE_DAYS, default=15"` // how many days after it's issued an invoice is due
+	LegalName       string  `env:"CLINIC_LEGAL_NAME"`            // the clinic's name as registered for GST, printed on tax invoices
+	Address         string  `env:"CLINIC_ADDRESS"`               // the clinic's registered address, printed on tax invoices
+	GSTIN           string  `env:"CLINIC_GSTIN"`                 // the clinic's GST identification number
+	SAC             string  `env:"INVOICE_SAC, default=999319"`  // services accounting code of the sessions, other human health services by default
+	GSTRate         float64 `env:"GST_RATE, default=0"`          // percent of GST included in session prices, split evenly into CGST and SGST; 0 as healthcare is exempt
+}
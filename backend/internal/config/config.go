@@ -3,4 +3,8 @@ package config
 type Config struct {
 	Application Application
 	DB          DB
+	Auth        Auth
+	Scheduling  Scheduling
+	Timesheets  Timesheets
+	Billing     Billing
 }
@@ -1,15 +1,65 @@
 package config
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
 
 type DB struct {
-	Host     string `env:"DB_HOST, required"`     // the database host to connect to
-	Port     string `env:"DB_PORT, required"`     // the database port to connect to
-	User     string `env:"DB_USER, required"`     // the user to connect to the database with
-	Password string `env:"DB_PASSWORD, required"` // the password to connect to the database with
-	Name     string `env:"DB_NAME, required"`     // the name of the database to connect to
+	Driver   string `env:"DB_DRIVER, default=mysql"`    // the database to use: mysql, postgres or sqlite
+	Host     string `env:"DB_HOST"`                     // the database host to connect to
+	Port     string `env:"DB_PORT"`                     // the database port to connect to
+	User     string `env:"DB_USER"`                     // the user to connect to the database with
+	Password string `env:"DB_PASSWORD"`                 // the password to connect to the database with
+	Name     string `env:"DB_NAME, required"`           // the name of the database to connect to, or the file path for sqlite
+	SSLMode  string `env:"DB_SSLMODE, default=require"` // the postgres sslmode to connect with
+}
+
+// DSN returns the connection string for the configured driver
+func (db *DB) DSN() (string, error) {
+	switch db.Driver {
+	case DriverMySQL:
+		if err := db.requireServer(); err != nil {
+			return "", err
+		}
+		return db.MySQLDSN(), nil
+	case DriverPostgres:
+		if err := db.requireServer(); err != nil {
+			return "", err
+		}
+		return db.PostgresDSN(), nil
+	case DriverSQLite:
+		return db.SQLiteDSN(), nil
+	default:
+		return "", fmt.Errorf("unsupported DB_DRIVER %q, expected mysql, postgres or sqlite", db.Driver)
+	}
+}
+
+func (db *DB) MySQLDSN() string {
+	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+		db.User, db.Password, db.Host, db.Port, db.Name)
+}
+
+func (db *DB) PostgresDSN() string {
+	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=%s", db.Host, db.User, db.Password, db.Name, db.Port, db.SSLMode)
+}
+
+// SQLiteDSN opens the file named by DB_NAME with foreign keys enforced, which SQLite leaves off by default
+func (db *DB) SQLiteDSN() string {
+	pragmas := url.Values{"_pragma": {"foreign_keys(1)", "busy_timeout(5000)"}}
+	return "file:" + db.Name + "?" + pragmas.Encode()
 }
 
-func (db *DB) Connection() string {
-	return fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=require", db.Host, db.User, db.Password, db.Name, db.Port)
+// requireServer checks the settings needed to reach a database server
+func (db *DB) requireServer() error {
+	if db.Host == "" || db.Port == "" || db.User == "" {
+		return fmt.Errorf("DB_HOST, DB_PORT and DB_USER are required for %s", db.Driver)
+	}
+	return nil
 }
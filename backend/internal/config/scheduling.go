@@ -0,0 +1,18 @@
+package config
+
+import "time"
+
+type Scheduling struct {
+	Timezone      string        `env:"TIMEZONE, default=Asia/Kolkata"` // the clinic's local time, which recurring sessions and operating hours follow
+	SeriesHorizon time.Duration `env:"SERIES_HORIZON, default=672h"`   // how far ahead sessions of a recurring series are generated
+}
+
+// Location loads the clinic's timezone, falling back to UTC when it's unknown.
+// The server refuses to start with an unknown timezone, so the fallback is for tools.
+func (s Scheduling) Location() *time.Location {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
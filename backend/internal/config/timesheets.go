@@ -0,0 +1,6 @@
+package config
+
+type Timesheets struct {
+	UnderUtilization float64 `env:"TIMESHEET_UNDER, default=0.9"` // share of expected hours below which staff are flagged as under-utilized
+	OverUtilization  float64 `env:"TIMESHEET_OVER, default=1.1"`  // share of expected hours above which staff are flagged as over-utilized
+}
@@ -0,0 +1,345 @@
+// internal/db/migrate.go
+
+package database
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migrations are numbered pairs of files, e.g. 0003_add_invoices.up.sql and
+// 0003_add_invoices.down.sql. Statements end with a semicolon at the end of a line.
+//
+// The same files run on every supported database, so they stick to portable SQL.
+// Identifiers that need quoting use backticks, and the few types that differ are
+// written as placeholders (see dialects). Indexes are dropped with MySQL's
+// `DROP INDEX name ON table`, which is rewritten for the other databases.
+//
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+// MigrationsDir is where `migrate create` writes new migration files, relative to the backend directory
+const MigrationsDir = "internal/db/migrations"
+
+var migrationName = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+// ErrSchemaBehind is returned when migrations are waiting to be applied
+var ErrSchemaBehind = errors.New("database schema is behind, run `go run ./cmd/migrate up`")
+
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// schemaMigration is a row of the table recording which migrations have been applied
+type schemaMigration struct {
+	Version   int `gorm:"primaryKey;autoIncrement:false"`
+	Name      string
+	AppliedAt time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// dialects replaces the placeholders in migrations with each database's own types
+var dialects = map[string]*strings.Replacer{
+	"mysql": strings.NewReplacer(
+		"${AUTO_ID}", "INT NOT NULL AUTO_INCREMENT PRIMARY KEY",
+		"${TIMESTAMP}", "DATETIME(3)",
+		"${BLOB}", "MEDIUMBLOB",
+	),
+	"postgres": strings.NewReplacer(
+		"${AUTO_ID}", "SERIAL PRIMARY KEY",
+		"${TIMESTAMP}", "TIMESTAMPTZ",
+		"${BLOB}", "BYTEA",
+		"`", `"`,
+	),
+	"sqlite": strings.NewReplacer(
+		"${AUTO_ID}", "INTEGER PRIMARY KEY AUTOINCREMENT",
+		"${TIMESTAMP}", "DATETIME",
+		"${BLOB}", "BLOB",
+	),
+}
+
+// dropIndexOn matches MySQL's DROP INDEX statements, which name the table the index is on
+var dropIndexOn = regexp.MustCompile(`(?im)^(\s*DROP INDEX\s+\S+)\s+ON\s+\S+\s*;`)
+
+type Migrator struct {
+	db         *gorm.DB
+	name       string
+	dialect    *strings.Replacer
+	migrations []Migration
+}
+
+// NewMigrator loads the embedded migrations and creates the migrations table if it doesn't exist yet
+func NewMigrator(db *gorm.DB) (*Migrator, error) {
+	files, err := fs.Sub(migrationFiles, "migrations")
+	if err != nil {
+		return nil, err
+	}
+	migrations, err := loadMigrations(files)
+	if err != nil {
+		return nil, err
+	}
+
+	dialect, ok := dialects[db.Dialector.Name()]
+	if !ok {
+		return nil, fmt.Errorf("migrations don't support the %s database", db.Dialector.Name())
+	}
+
+	if !db.Migrator().HasTable(&schemaMigration{}) {
+		if err := db.Migrator().CreateTable(&schemaMigration{}); err != nil {
+			return nil, fmt.Errorf("failed to create migrations table: %w", err)
+		}
+	}
+
+	return &Migrator{db: db, name: db.Dialector.Name(), dialect: dialect, migrations: migrations}, nil
+}
+
+// Status lists every migration with the time it was applied, if it has been
+func (m *Migrator) Status() ([]MigrationStatus, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]MigrationStatus, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := MigrationStatus{Migration: migration}
+		if row, ok := applied[migration.Version]; ok {
+			status.AppliedAt = &row.AppliedAt
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Pending lists the migrations that haven't been applied, oldest first
+func (m *Migrator) Pending() ([]Migration, error) {
+	applied, err := m.applied()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if _, ok := applied[migration.Version]; !ok {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// EnsureCurrent returns ErrSchemaBehind if any migration hasn't been applied
+func (m *Migrator) EnsureCurrent() error {
+	pending, err := m.Pending()
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d pending, starting at %04d_%s", ErrSchemaBehind, len(pending), pending[0].Version, pending[0].Name)
+	}
+	return nil
+}
+
+// Up applies every pending migration in order and returns the ones it applied
+func (m *Migrator) Up() ([]Migration, error) {
+	pending, err := m.Pending()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, migration := range pending {
+		err := m.run(migration.Up, func(tx *gorm.DB) error {
+			return tx.Create(&schemaMigration{
+				Version:   migration.Version,
+				Name:      migration.Name,
+				AppliedAt: time.Now(),
+			}).Error
+		})
+		if err != nil {
+			return pending[:i], fmt.Errorf("migration %04d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+	}
+	return pending, nil
+}
+
+// Down reverts the latest steps applied migrations, newest first, and returns the ones it reverted
+func (m *Migrator) Down(steps int) ([]Migration, error) {
+	statuses, err := m.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted []Migration
+	for i := len(statuses) - 1; i >= 0 && len(reverted) < steps; i-- {
+		migration := statuses[i].Migration
+		if statuses[i].AppliedAt == nil {
+			continue
+		}
+
+		err := m.run(migration.Down, func(tx *gorm.DB) error {
+			return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+		})
+		if err != nil {
+			return reverted, fmt.Errorf("reverting migration %04d_%s failed: %w", migration.Version, migration.Name, err)
+		}
+		reverted = append(reverted, migration)
+	}
+	return reverted, nil
+}
+
+// run executes a migration's statements and records the result in one transaction.
+// MySQL commits DDL statements implicitly, so a failed schema change may still need manual cleanup.
+func (m *Migrator) run(script string, record func(tx *gorm.DB) error) error {
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		for _, statement := range splitStatements(m.translate(script)) {
+			if err := tx.Exec(statement).Error; err != nil {
+				return err
+			}
+		}
+		return record(tx)
+	})
+}
+
+// translate rewrites a migration for the connected database
+func (m *Migrator) translate(script string) string {
+	script = m.dialect.Replace(script)
+	if m.name != "mysql" {
+		// PostgreSQL and SQLite index names are unique per schema, not per table
+		script = dropIndexOn.ReplaceAllString(script, "$1;")
+	}
+	return script
+}
+
+// applied returns the recorded migrations by version
+func (m *Migrator) applied() (map[int]schemaMigration, error) {
+	var rows []schemaMigration
+	if err := m.db.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int]schemaMigration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	return applied, nil
+}
+
+// loadMigrations pairs up the up and down files of every migration in a directory, ordered by version
+func loadMigrations(files fs.FS) ([]Migration, error) {
+	entries, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, path := range entries {
+		match := migrationName.FindStringSubmatch(path)
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q", path)
+		}
+
+		version, _ := strconv.Atoi(match[1])
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by both %q and %q", version, migration.Name, match[2])
+		}
+
+		content, err := fs.ReadFile(files, path)
+		if err != nil {
+			return nil, err
+		}
+		if match[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s needs both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// splitStatements splits a script into statements on semicolons that end a line, dropping comment lines
+func splitStatements(script string) []string {
+	var statements []string
+	var current strings.Builder
+
+	for _, line := range strings.Split(script, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+
+		current.WriteString(line)
+		current.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(current.String()))
+			current.Reset()
+		}
+	}
+	if rest := strings.TrimSpace(current.String()); rest != "" {
+		statements = append(statements, rest)
+	}
+	return statements
+}
+
+// CreateMigration writes empty up and down files for a new migration in dir, numbered after the latest one there
+func CreateMigration(dir, name string) (upPath, downPath string, err error) {
+	name = strings.ToLower(strings.Join(strings.Fields(name), "_"))
+	if !regexp.MustCompile(`^\w+$`).MatchString(name) {
+		return "", "", fmt.Errorf("invalid migration name %q", name)
+	}
+
+	existing, err := loadMigrations(os.DirFS(dir))
+	if err != nil {
+		return "", "", err
+	}
+	version := 1
+	if len(existing) > 0 {
+		version = existing[len(existing)-1].Version + 1
+	}
+
+	base := filepath.Join(dir, fmt.Sprintf("%04d_%s", version, name))
+	upPath, downPath = base+".up.sql", base+".down.sql"
+	if err := os.WriteFile(upPath, []byte("-- "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(downPath, []byte("-- Revert "+name+"\n"), 0o644); err != nil {
+		return "", "", err
+	}
+	return upPath, downPath, nil
+}
@@ -0,0 +1,173 @@
+// internal/db/migrate_test.go
+
+package database
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   []string
+	}{
+		{
+			name:   "one statement per line",
+			script: "CREATE TABLE a (id INT);\nCREATE TABLE b (id INT);\n",
+			want:   []string{"CREATE TABLE a (id INT);", "CREATE TABLE b (id INT);"},
+		},
+		{
+			name:   "statement over several lines",
+			script: "CREATE TABLE a (\n    id INT,\n    name TEXT\n);\n",
+			want:   []string{"CREATE TABLE a (\n    id INT,\n    name TEXT\n);"},
+		},
+		{
+			name:   "comments and blank lines",
+			script: "-- Adds a\n\nCREATE TABLE a (id INT);\n    -- indented comment\nDROP TABLE b;",
+			want:   []string{"CREATE TABLE a (id INT);", "DROP TABLE b;"},
+		},
+		{
+			name:   "semicolon inside a line",
+			script: "INSERT INTO a (note) VALUES ('x; y');\n",
+			want:   []string{"INSERT INTO a (note) VALUES ('x; y');"},
+		},
+		{
+			name:   "last statement without a semicolon",
+			script: "DROP TABLE a;\nDROP TABLE b",
+			want:   []string{"DROP TABLE a;", "DROP TABLE b"},
+		},
+		{
+			name:   "only comments",
+			script: "-- Nothing to do\n",
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		if got := splitStatements(tt.script); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: splitStatements() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	script := "CREATE TABLE a (id ${AUTO_ID}, at ${TIMESTAMP});\nDROP INDEX idx_a_at ON a;"
+	tests := []struct {
+		dialect string
+		want    string
+	}{
+		{"mysql", "CREATE TABLE a (id INT NOT NULL AUTO_INCREMENT PRIMARY KEY, at DATETIME(3));\nDROP INDEX idx_a_at ON a;"},
+		{"postgres", "CREATE TABLE a (id SERIAL PRIMARY KEY, at TIMESTAMPTZ);\nDROP INDEX idx_a_at;"},
+		{"sqlite", "CREATE TABLE a (id INTEGER PRIMARY KEY AUTOINCREMENT, at DATETIME);\nDROP INDEX idx_a_at;"},
+	}
+	for _, tt := range tests {
+		m := &Migrator{name: tt.dialect, dialect: dialects[tt.dialect]}
+		if got := m.translate(script); got != tt.want {
+			t.Errorf("translate() for %s = %q, want %q", tt.dialect, got, tt.want)
+		}
+	}
+}
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "ordered by version",
+			files: fstest.MapFS{
+				"0002_b.up.sql":   {Data: []byte("up b")},
+				"0002_b.down.sql": {Data: []byte("down b")},
+				"0001_a.up.sql":   {Data: []byte("up a")},
+				"0001_a.down.sql": {Data: []byte("down a")},
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name:    "missing down file",
+			files:   fstest.MapFS{"0001_a.up.sql": {Data: []byte("up a")}},
+			wantErr: "needs both an up and a down file",
+		},
+		{
+			name: "version used twice",
+			files: fstest.MapFS{
+				"0001_a.up.sql":   {Data: []byte("up a")},
+				"0001_b.down.sql": {Data: []byte("down b")},
+			},
+			wantErr: "is used by both",
+		},
+		{
+			name:    "invalid name",
+			files:   fstest.MapFS{"add_table.sql": {Data: []byte("up")}},
+			wantErr: "invalid migration file name",
+		},
+	}
+	for _, tt := range tests {
+		migrations, err := loadMigrations(tt.files)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s: loadMigrations() error = %v, want %q", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: loadMigrations() error = %v", tt.name, err)
+			continue
+		}
+		var got []string
+		for _, migration := range migrations {
+			got = append(got, migration.Name)
+			if migration.Up != "up "+migration.Name || migration.Down != "down "+migration.Name {
+				t.Errorf("%s: migration %s has its up and down files mixed up", tt.name, migration.Name)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: loadMigrations() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// The bundled migrations must apply and revert cleanly, so each down file undoes its up file
+func TestMigrationsUpAndDown(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB.SetMaxOpenConns(1)
+	defer sqlDB.Close()
+
+	migrator, err := NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	applied, err := migrator.Up()
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if err := migrator.EnsureCurrent(); err != nil {
+		t.Fatalf("EnsureCurrent() after Up() error = %v", err)
+	}
+	reverted, err := migrator.Down(len(applied))
+	if err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+	if len(reverted) != len(applied) {
+		t.Errorf("reverted %d migrations, want %d", len(reverted), len(applied))
+	}
+	if _, err := migrator.Up(); err != nil {
+		t.Fatalf("Up() after Down() error = %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+DROP TABLE audit_logs;
+DROP TABLE onboarding_responses;
+DROP TABLE onboarding_questions;
+DROP TABLE assessments;
+DROP TABLE medicines;
+DROP TABLE activities;
+DROP TABLE sessions;
+DROP TABLE guardian_login_codes;
+DROP TABLE patient_guardians;
+DROP TABLE guardians;
+DROP TABLE patients;
+DROP TABLE staffs;
+DROP TABLE operating_hours;
+DROP TABLE branches;
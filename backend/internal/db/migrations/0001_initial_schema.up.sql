@@ -0,0 +1,171 @@
+-- The schema previously created by AutoMigrate, with UUIDs stored as CHAR(36)
+
+CREATE TABLE branches (
+    id ${AUTO_ID},
+    location VARCHAR(255) NULL,
+    opening_date ${TIMESTAMP} NULL,
+    active BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE operating_hours (
+    branch_id INT NOT NULL,
+    day_of_week SMALLINT NOT NULL,
+    open_time VARCHAR(5) NULL,
+    close_time VARCHAR(5) NULL,
+    is_closed BOOLEAN NOT NULL DEFAULT FALSE,
+    PRIMARY KEY (branch_id, day_of_week),
+    CONSTRAINT fk_operating_hours_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE staffs (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    join_date ${TIMESTAMP} NULL,
+    expected_hours INT NOT NULL DEFAULT 0,
+    role VARCHAR(50) NOT NULL DEFAULT '',
+    primary_branch_id INT NULL,
+    email VARCHAR(255) NULL,
+    password_hash VARCHAR(255) NOT NULL DEFAULT '',
+    PRIMARY KEY (id),
+    CONSTRAINT fk_staffs_branch FOREIGN KEY (primary_branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE UNIQUE INDEX idx_staffs_email ON staffs (email);
+
+CREATE TABLE patients (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    dob VARCHAR(32) NOT NULL DEFAULT '',
+    active BOOLEAN NULL,
+    doctor_id CHAR(36) NULL,
+    staff_id CHAR(36) NULL,
+    primary_branch_id INT NULL,
+    therapy_types VARCHAR(255) NULL,
+    join_date ${TIMESTAMP} NULL,
+    updated_at ${TIMESTAMP} NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_patients_doctor FOREIGN KEY (doctor_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE SET NULL,
+    CONSTRAINT fk_staffs_patients FOREIGN KEY (staff_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_patients_branch FOREIGN KEY (primary_branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE TABLE guardians (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    phone_number VARCHAR(32) NULL,
+    email VARCHAR(255) NULL,
+    PRIMARY KEY (id)
+);
+
+CREATE TABLE patient_guardians (
+    patient_id CHAR(36) NOT NULL,
+    guardian_id CHAR(36) NOT NULL,
+    PRIMARY KEY (patient_id, guardian_id),
+    CONSTRAINT fk_patient_guardians_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_patient_guardians_guardian FOREIGN KEY (guardian_id) REFERENCES guardians (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE guardian_login_codes (
+    id ${AUTO_ID},
+    guardian_id CHAR(36) NOT NULL,
+    code_hash VARCHAR(255) NOT NULL,
+    channel VARCHAR(10) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at ${TIMESTAMP} NOT NULL,
+    consumed_at ${TIMESTAMP} NULL,
+    created_at ${TIMESTAMP} NULL,
+    CONSTRAINT fk_guardian_login_codes_guardian FOREIGN KEY (guardian_id) REFERENCES guardians (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_guardian_login_codes_guardian_id ON guardian_login_codes (guardian_id);
+
+CREATE TABLE sessions (
+    id CHAR(36) NOT NULL,
+    patient_id CHAR(36) NOT NULL,
+    staff_id CHAR(36) NOT NULL,
+    branch_id INT NULL,
+    start_time ${TIMESTAMP} NOT NULL,
+    end_time ${TIMESTAMP} NOT NULL,
+    description TEXT NULL,
+    shareable BOOLEAN NOT NULL DEFAULT FALSE,
+    response VARCHAR(50) NOT NULL DEFAULT '',
+    payment_received BOOLEAN NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_sessions_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_staffs_sessions FOREIGN KEY (staff_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_sessions_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE INDEX idx_sessions_patient_start ON sessions (patient_id, start_time);
+CREATE INDEX idx_sessions_staff_start ON sessions (staff_id, start_time);
+
+CREATE TABLE activities (
+    id CHAR(36) NOT NULL,
+    description TEXT NULL,
+    duration_minutes DOUBLE PRECISION NULL,
+    session_id CHAR(36) NULL,
+    created_at ${TIMESTAMP} NULL,
+    updated_at ${TIMESTAMP} NULL,
+    response_level VARCHAR(50) NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_sessions_activities FOREIGN KEY (session_id) REFERENCES sessions (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE medicines (
+    id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    brand_name VARCHAR(255) NULL,
+    dosage VARCHAR(255) NULL,
+    patient_id CHAR(36) NOT NULL,
+    prescriber_id CHAR(36) NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_medicines_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_staffs_medicines FOREIGN KEY (prescriber_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE TABLE assessments (
+    id ${AUTO_ID},
+    name VARCHAR(100) NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_assessments_name ON assessments (name);
+
+CREATE TABLE onboarding_questions (
+    text VARCHAR(100) NOT NULL,
+    `group` INT NOT NULL DEFAULT 0,
+    assessment_id INT NOT NULL,
+    PRIMARY KEY (text),
+    CONSTRAINT fk_onboarding_questions_assessment FOREIGN KEY (assessment_id) REFERENCES assessments (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE TABLE onboarding_responses (
+    id ${AUTO_ID},
+    question_text VARCHAR(100) NOT NULL,
+    patient_id CHAR(36) NOT NULL,
+    staff_id CHAR(36) NOT NULL,
+    session_id CHAR(36) NULL,
+    response_date ${TIMESTAMP} NULL,
+    CONSTRAINT fk_onboarding_responses_question FOREIGN KEY (question_text) REFERENCES onboarding_questions (text) ON UPDATE RESTRICT ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_staff FOREIGN KEY (staff_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_session FOREIGN KEY (session_id) REFERENCES sessions (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE TABLE audit_logs (
+    id ${AUTO_ID},
+    actor_id CHAR(36) NOT NULL,
+    actor_role VARCHAR(50) NOT NULL,
+    action VARCHAR(10) NOT NULL,
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(64) NOT NULL,
+    patient_id CHAR(36) NULL,
+    changes TEXT NULL,
+    request_id VARCHAR(64) NOT NULL DEFAULT '',
+    ip VARCHAR(45) NOT NULL DEFAULT '',
+    created_at ${TIMESTAMP} NOT NULL
+);
+
+CREATE INDEX idx_audit_logs_actor_id ON audit_logs (actor_id);
+CREATE INDEX idx_audit_entity ON audit_logs (entity_type, entity_id);
+CREATE INDEX idx_audit_logs_patient_id ON audit_logs (patient_id);
+CREATE INDEX idx_audit_logs_created_at ON audit_logs (created_at);
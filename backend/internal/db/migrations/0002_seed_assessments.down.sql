@@ -0,0 +1,4 @@
+DELETE FROM onboarding_questions
+WHERE assessment_id IN (SELECT id FROM assessments WHERE name IN ('VB-MAPP', 'ESFLS', 'ABLLS-R'));
+
+DELETE FROM assessments WHERE name IN ('VB-MAPP', 'ESFLS', 'ABLLS-R');
@@ -0,0 +1,17 @@
+-- Default assessments and their onboarding questions, previously seeded at startup
+
+INSERT INTO assessments (name) VALUES
+    ('VB-MAPP'),
+    ('ESFLS'),
+    ('ABLLS-R');
+
+INSERT INTO onboarding_questions (text, assessment_id)
+SELECT 'Does the patient exhibit basic mand capabilities?', id FROM assessments WHERE name = 'VB-MAPP'
+UNION ALL SELECT 'Can the patient engage in spontaneous vocal behavior?', id FROM assessments WHERE name = 'VB-MAPP'
+UNION ALL SELECT 'Does the patient display listener responding skills?', id FROM assessments WHERE name = 'VB-MAPP'
+UNION ALL SELECT 'Can the patient make requests for essential items?', id FROM assessments WHERE name = 'ESFLS'
+UNION ALL SELECT 'Is the patient able to tolerate specific situations?', id FROM assessments WHERE name = 'ESFLS'
+UNION ALL SELECT 'Can the patient engage in daily living activities?', id FROM assessments WHERE name = 'ESFLS'
+UNION ALL SELECT 'How would you rate the patient''s visual performance skills?', id FROM assessments WHERE name = 'ABLLS-R'
+UNION ALL SELECT 'Can the patient follow instructions?', id FROM assessments WHERE name = 'ABLLS-R'
+UNION ALL SELECT 'Does the patient demonstrate language comprehension?', id FROM assessments WHERE name = 'ABLLS-R';
@@ -0,0 +1,44 @@
+-- Questions are keyed by their text again. Where two assessments share a question
+-- text, only the first assessment keeps it.
+
+CREATE TABLE legacy_questions (
+    text VARCHAR(100) NOT NULL,
+    `group` INT NOT NULL DEFAULT 0,
+    assessment_id INT NOT NULL,
+    PRIMARY KEY (text),
+    CONSTRAINT fk_onboarding_questions_assessment FOREIGN KEY (assessment_id) REFERENCES assessments (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+INSERT INTO legacy_questions (text, `group`, assessment_id)
+SELECT q.text, q.group_number, q.assessment_id
+FROM onboarding_questions q
+WHERE q.id = (SELECT MIN(d.id) FROM onboarding_questions d WHERE d.text = q.text);
+
+CREATE TABLE legacy_responses (
+    id ${AUTO_ID},
+    question_text VARCHAR(100) NOT NULL,
+    patient_id CHAR(36) NOT NULL,
+    staff_id CHAR(36) NOT NULL,
+    session_id CHAR(36) NULL,
+    response_date ${TIMESTAMP} NULL,
+    CONSTRAINT fk_onboarding_responses_question FOREIGN KEY (question_text) REFERENCES legacy_questions (text) ON UPDATE RESTRICT ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_staff FOREIGN KEY (staff_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_session FOREIGN KEY (session_id) REFERENCES sessions (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+INSERT INTO legacy_responses (question_text, patient_id, staff_id, session_id, response_date)
+SELECT q.text, r.patient_id, r.staff_id, r.session_id, r.response_date
+FROM onboarding_responses r
+JOIN onboarding_questions q ON q.id = r.question_id
+WHERE q.text IN (SELECT text FROM legacy_questions);
+
+DROP TABLE onboarding_responses;
+DROP TABLE onboarding_questions;
+
+ALTER TABLE legacy_questions RENAME TO onboarding_questions;
+ALTER TABLE legacy_responses RENAME TO onboarding_responses;
+
+ALTER TABLE assessments DROP COLUMN scoring;
+ALTER TABLE assessments DROP COLUMN version;
+ALTER TABLE assessments DROP COLUMN description;
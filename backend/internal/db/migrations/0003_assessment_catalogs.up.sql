@@ -0,0 +1,50 @@
+-- Assessments are now imported from catalog files. Questions get a numeric ID and a
+-- stable code within their assessment instead of being keyed by their text.
+
+ALTER TABLE assessments ADD COLUMN description TEXT NULL;
+ALTER TABLE assessments ADD COLUMN version INT NOT NULL DEFAULT 0;
+ALTER TABLE assessments ADD COLUMN scoring TEXT NULL;
+
+CREATE TABLE assessment_questions (
+    id ${AUTO_ID},
+    assessment_id INT NOT NULL,
+    code VARCHAR(100) NOT NULL,
+    text TEXT NOT NULL,
+    group_number INT NOT NULL DEFAULT 0,
+    group_name VARCHAR(255) NULL,
+    position INT NOT NULL DEFAULT 0,
+    max_score DOUBLE PRECISION NULL,
+    retired BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_onboarding_questions_assessment_id FOREIGN KEY (assessment_id) REFERENCES assessments (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_onboarding_questions_code ON assessment_questions (assessment_id, code);
+
+-- Existing questions keep their text as their code
+INSERT INTO assessment_questions (assessment_id, code, text, group_number)
+SELECT assessment_id, text, text, `group` FROM onboarding_questions;
+
+CREATE TABLE assessment_responses (
+    id ${AUTO_ID},
+    question_id INT NOT NULL,
+    patient_id CHAR(36) NOT NULL,
+    staff_id CHAR(36) NOT NULL,
+    session_id CHAR(36) NULL,
+    response_date ${TIMESTAMP} NULL,
+    CONSTRAINT fk_onboarding_responses_question_id FOREIGN KEY (question_id) REFERENCES assessment_questions (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_patient_id FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_staff_id FOREIGN KEY (staff_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_onboarding_responses_session_id FOREIGN KEY (session_id) REFERENCES sessions (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+INSERT INTO assessment_responses (question_id, patient_id, staff_id, session_id, response_date)
+SELECT q.id, r.patient_id, r.staff_id, r.session_id, r.response_date
+FROM onboarding_responses r
+JOIN onboarding_questions o ON o.text = r.question_text
+JOIN assessment_questions q ON q.assessment_id = o.assessment_id AND q.code = o.text;
+
+DROP TABLE onboarding_responses;
+DROP TABLE onboarding_questions;
+
+ALTER TABLE assessment_questions RENAME TO onboarding_questions;
+ALTER TABLE assessment_responses RENAME TO onboarding_responses;
@@ -0,0 +1,7 @@
+ALTER TABLE onboarding_responses DROP COLUMN notes;
+ALTER TABLE onboarding_responses DROP COLUMN answer;
+ALTER TABLE onboarding_responses DROP COLUMN score;
+
+ALTER TABLE onboarding_questions DROP COLUMN milestone_level;
+ALTER TABLE onboarding_questions DROP COLUMN domain;
+ALTER TABLE onboarding_questions DROP COLUMN answer_type;
@@ -0,0 +1,10 @@
+-- Questions say how they're answered and where they count towards milestones,
+-- and responses record the answer given.
+
+ALTER TABLE onboarding_questions ADD COLUMN answer_type VARCHAR(10) NOT NULL DEFAULT 'score';
+ALTER TABLE onboarding_questions ADD COLUMN domain VARCHAR(100) NULL;
+ALTER TABLE onboarding_questions ADD COLUMN milestone_level INT NULL;
+
+ALTER TABLE onboarding_responses ADD COLUMN score DOUBLE PRECISION NULL;
+ALTER TABLE onboarding_responses ADD COLUMN answer TEXT NULL;
+ALTER TABLE onboarding_responses ADD COLUMN notes TEXT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX idx_onboarding_responses_administration_id ON onboarding_responses;
+ALTER TABLE onboarding_responses DROP COLUMN administration_id;
+
+DROP TABLE assessment_administrations;
@@ -0,0 +1,36 @@
+-- Responses belong to an administration of an assessment, so repeated sittings can be
+-- told apart and compared.
+
+CREATE TABLE assessment_administrations (
+    id ${AUTO_ID},
+    patient_id CHAR(36) NOT NULL,
+    assessment_id INT NOT NULL,
+    administered_by CHAR(36) NOT NULL,
+    administered_at ${TIMESTAMP} NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'in_progress',
+    notes TEXT NULL,
+    CONSTRAINT fk_assessment_administrations_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_assessment_administrations_assessment FOREIGN KEY (assessment_id) REFERENCES assessments (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_assessment_administrations_staff FOREIGN KEY (administered_by) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_administrations_patient ON assessment_administrations (patient_id, assessment_id);
+
+-- SQLite can't add a foreign key to an existing table, so the service checks this one
+ALTER TABLE onboarding_responses ADD COLUMN administration_id INT NULL;
+
+CREATE INDEX idx_onboarding_responses_administration_id ON onboarding_responses (administration_id);
+
+-- Existing responses become one completed administration per patient and assessment
+INSERT INTO assessment_administrations (patient_id, assessment_id, administered_by, administered_at, status)
+SELECT r.patient_id, q.assessment_id, MIN(r.staff_id), COALESCE(MIN(r.response_date), CURRENT_TIMESTAMP), 'completed'
+FROM onboarding_responses r
+JOIN onboarding_questions q ON q.id = r.question_id
+GROUP BY r.patient_id, q.assessment_id;
+
+UPDATE onboarding_responses SET administration_id = (
+    SELECT a.id
+    FROM assessment_administrations a
+    JOIN onboarding_questions q ON q.assessment_id = a.assessment_id
+    WHERE q.id = onboarding_responses.question_id AND a.patient_id = onboarding_responses.patient_id
+);
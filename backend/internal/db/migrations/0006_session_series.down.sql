@@ -0,0 +1,6 @@
+DROP INDEX idx_sessions_series_id ON sessions;
+ALTER TABLE sessions DROP COLUMN occurs_at;
+ALTER TABLE sessions DROP COLUMN series_id;
+
+DROP TABLE session_series_exceptions;
+DROP TABLE session_series;
@@ -0,0 +1,36 @@
+-- Recurring series generate their sessions ahead of time. Sessions remember the
+-- occurrence they fill, and cancelled occurrences are kept so they aren't generated again.
+
+CREATE TABLE session_series (
+    id CHAR(36) NOT NULL,
+    patient_id CHAR(36) NOT NULL,
+    staff_id CHAR(36) NOT NULL,
+    branch_id INT NULL,
+    recurrence VARCHAR(255) NOT NULL,
+    start_time ${TIMESTAMP} NOT NULL,
+    end_time ${TIMESTAMP} NOT NULL,
+    description TEXT NULL,
+    shareable BOOLEAN NOT NULL DEFAULT FALSE,
+    generated_until ${TIMESTAMP} NOT NULL,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_session_series_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_session_series_staff FOREIGN KEY (staff_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_session_series_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE SET NULL
+);
+
+CREATE INDEX idx_session_series_patient_id ON session_series (patient_id);
+
+CREATE TABLE session_series_exceptions (
+    id ${AUTO_ID},
+    series_id CHAR(36) NOT NULL,
+    occurs_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_session_series_exceptions FOREIGN KEY (series_id) REFERENCES session_series (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_session_series_exceptions_series_id ON session_series_exceptions (series_id);
+
+-- SQLite can't add a foreign key to an existing table, so the service checks this one
+ALTER TABLE sessions ADD COLUMN series_id CHAR(36) NULL;
+ALTER TABLE sessions ADD COLUMN occurs_at ${TIMESTAMP} NULL;
+
+CREATE INDEX idx_sessions_series_id ON sessions (series_id);
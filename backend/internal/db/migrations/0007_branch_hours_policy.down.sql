@@ -0,0 +1 @@
+ALTER TABLE branches DROP COLUMN hours_policy;
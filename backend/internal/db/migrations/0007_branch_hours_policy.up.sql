@@ -0,0 +1,2 @@
+-- Whether sessions outside a branch's operating hours are rejected or only warned about
+ALTER TABLE branches ADD COLUMN hours_policy VARCHAR(20) NOT NULL DEFAULT 'enforce';
@@ -0,0 +1,4 @@
+DROP INDEX idx_sessions_closure_id ON sessions;
+ALTER TABLE sessions DROP COLUMN closure_id;
+
+DROP TABLE branch_closures;
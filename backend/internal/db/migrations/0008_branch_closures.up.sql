@@ -0,0 +1,19 @@
+-- Dated closures on top of the weekly operating hours. Sessions falling on one are
+-- flagged until they're rescheduled or cancelled.
+
+CREATE TABLE branch_closures (
+    id ${AUTO_ID},
+    branch_id INT NOT NULL,
+    start_date VARCHAR(10) NOT NULL,
+    end_date VARCHAR(10) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    holiday BOOLEAN NOT NULL DEFAULT FALSE,
+    CONSTRAINT fk_branch_closures_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_branch_closures_branch_id ON branch_closures (branch_id);
+
+-- SQLite can't add a foreign key to an existing table, so deleting a closure clears this one
+ALTER TABLE sessions ADD COLUMN closure_id INT NULL;
+
+CREATE INDEX idx_sessions_closure_id ON sessions (closure_id);
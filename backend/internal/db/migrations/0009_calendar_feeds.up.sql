@@ -0,0 +1,20 @@
+-- Calendar feeds authenticate with a token in their URL, stored hashed so it can be
+-- looked up but not read back. Revoked feeds are kept to show when they were revoked.
+
+CREATE TABLE calendar_feeds (
+    id ${AUTO_ID},
+    token_hash CHAR(64) NOT NULL,
+    staff_id CHAR(36) NULL,
+    patient_id CHAR(36) NULL,
+    created_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    last_used_at ${TIMESTAMP} NULL,
+    revoked_at ${TIMESTAMP} NULL,
+    CONSTRAINT fk_calendar_feeds_staff FOREIGN KEY (staff_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_calendar_feeds_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_calendar_feeds_created_by FOREIGN KEY (created_by_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX idx_calendar_feeds_token_hash ON calendar_feeds (token_hash);
+CREATE INDEX idx_calendar_feeds_staff_id ON calendar_feeds (staff_id);
+CREATE INDEX idx_calendar_feeds_patient_id ON calendar_feeds (patient_id);
@@ -0,0 +1,3 @@
+DROP TABLE payments;
+DROP TABLE invoice_lines;
+DROP TABLE invoices;
@@ -0,0 +1,53 @@
+-- Invoices bill guardians for completed sessions, and payments settle them in full or in part.
+-- Amounts are in paise. sessions.payment_received is now derived from the invoices; values
+-- ticked by hand before are kept, since those sessions were settled outside invoicing.
+
+CREATE TABLE invoices (
+    id ${AUTO_ID},
+    patient_id CHAR(36) NOT NULL,
+    guardian_id CHAR(36) NOT NULL,
+    status VARCHAR(10) NOT NULL,
+    issue_date VARCHAR(10) NOT NULL,
+    due_date VARCHAR(10) NOT NULL,
+    total BIGINT NOT NULL DEFAULT 0,
+    paid BIGINT NOT NULL DEFAULT 0,
+    created_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    updated_at ${TIMESTAMP} NOT NULL,
+    voided_at ${TIMESTAMP} NULL,
+    CONSTRAINT fk_invoices_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_invoices_guardian FOREIGN KEY (guardian_id) REFERENCES guardians (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_invoices_patient_id ON invoices (patient_id);
+CREATE INDEX idx_invoices_guardian_id ON invoices (guardian_id);
+CREATE INDEX idx_invoices_status ON invoices (status);
+
+CREATE TABLE invoice_lines (
+    id ${AUTO_ID},
+    invoice_id INT NOT NULL,
+    session_id CHAR(36) NULL,
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    minutes INT NOT NULL,
+    unit_price BIGINT NOT NULL,
+    amount BIGINT NOT NULL,
+    CONSTRAINT fk_invoices_lines FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_invoice_lines_session FOREIGN KEY (session_id) REFERENCES sessions (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_invoice_lines_invoice_id ON invoice_lines (invoice_id);
+CREATE INDEX idx_invoice_lines_session_id ON invoice_lines (session_id);
+
+CREATE TABLE payments (
+    id ${AUTO_ID},
+    invoice_id INT NOT NULL,
+    amount BIGINT NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    received_at ${TIMESTAMP} NOT NULL,
+    received_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_invoices_payments FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_payments_invoice_id ON payments (invoice_id);
@@ -0,0 +1,9 @@
+UPDATE invoice_lines SET unit_price = ROUND(amount * 60.0 / minutes) WHERE minutes > 0;
+
+ALTER TABLE invoice_lines DROP COLUMN discount;
+ALTER TABLE invoice_lines DROP COLUMN discount_rule_id;
+ALTER TABLE invoice_lines DROP COLUMN rate_card_id;
+
+DROP TABLE patient_discounts;
+DROP TABLE discount_rules;
+DROP TABLE rate_cards;
@@ -0,0 +1,41 @@
+-- Session prices by therapy type, branch, length and date, and the discounts taken off them.
+-- Invoice lines now hold the session's price instead of an hourly rate.
+
+CREATE TABLE rate_cards (
+    id ${AUTO_ID},
+    therapy_type VARCHAR(50) NOT NULL,
+    branch_id INT NULL,
+    min_minutes INT NOT NULL,
+    max_minutes INT NOT NULL,
+    price BIGINT NOT NULL,
+    effective_from VARCHAR(10) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_rate_cards_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_rate_cards_therapy_type ON rate_cards (therapy_type);
+
+CREATE TABLE discount_rules (
+    id ${AUTO_ID},
+    name VARCHAR(100) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    percent INT NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at ${TIMESTAMP} NOT NULL
+);
+
+CREATE TABLE patient_discounts (
+    patient_id CHAR(36) NOT NULL,
+    discount_rule_id INT NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    PRIMARY KEY (patient_id),
+    CONSTRAINT fk_patient_discounts_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_patient_discounts_discount_rule FOREIGN KEY (discount_rule_id) REFERENCES discount_rules (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+-- SQLite can't add a foreign key to an existing table, so rate cards used on invoices are kept
+ALTER TABLE invoice_lines ADD COLUMN rate_card_id INT NULL;
+ALTER TABLE invoice_lines ADD COLUMN discount_rule_id INT NULL;
+ALTER TABLE invoice_lines ADD COLUMN discount BIGINT NOT NULL DEFAULT 0;
+
+UPDATE invoice_lines SET unit_price = amount;
@@ -0,0 +1,2 @@
+DROP TABLE tax_invoices;
+DROP TABLE tax_invoice_sequences;
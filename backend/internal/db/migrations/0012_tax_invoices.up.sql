@@ -0,0 +1,36 @@
+-- GST invoice documents for paid invoices, numbered without gaps per branch and financial year.
+-- Numbers are taken from tax_invoice_sequences in the same transaction as the document is saved.
+
+CREATE TABLE tax_invoice_sequences (
+    branch_id INT NOT NULL,
+    financial_year VARCHAR(7) NOT NULL,
+    last_number INT NOT NULL,
+    PRIMARY KEY (branch_id, financial_year),
+    CONSTRAINT fk_tax_invoice_sequences_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE TABLE tax_invoices (
+    id ${AUTO_ID},
+    invoice_id INT NOT NULL,
+    branch_id INT NOT NULL,
+    financial_year VARCHAR(7) NOT NULL,
+    sequence_number INT NOT NULL,
+    number VARCHAR(16) NOT NULL,
+    issue_date VARCHAR(10) NOT NULL,
+    taxable BIGINT NOT NULL,
+    cgst BIGINT NOT NULL,
+    sgst BIGINT NOT NULL,
+    total BIGINT NOT NULL,
+    details TEXT NOT NULL,
+    pdf ${BLOB} NOT NULL,
+    reprints INT NOT NULL DEFAULT 0,
+    last_reprinted_at ${TIMESTAMP} NULL,
+    created_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_tax_invoices_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_tax_invoices_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_tax_invoices_invoice_id ON tax_invoices (invoice_id);
+CREATE UNIQUE INDEX idx_tax_invoices_sequence ON tax_invoices (branch_id, financial_year, sequence_number);
+CREATE UNIQUE INDEX idx_tax_invoices_number ON tax_invoices (number);
@@ -0,0 +1,6 @@
+DROP INDEX idx_activities_target_id ON activities;
+ALTER TABLE activities DROP COLUMN target_id;
+
+DROP TABLE treatment_targets;
+DROP TABLE treatment_goals;
+DROP TABLE treatment_plans;
@@ -0,0 +1,55 @@
+-- Treatment plans with long-term goals and the short-term targets activities practise.
+
+CREATE TABLE treatment_plans (
+    id ${AUTO_ID},
+    patient_id CHAR(36) NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    start_date VARCHAR(10) NOT NULL,
+    review_date VARCHAR(10) NOT NULL,
+    last_reviewed_on VARCHAR(10) NULL,
+    notes TEXT NULL,
+    created_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    updated_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_treatment_plans_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_treatment_plans_patient_id ON treatment_plans (patient_id);
+
+CREATE TABLE treatment_goals (
+    id ${AUTO_ID},
+    plan_id INT NOT NULL,
+    description TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    created_at ${TIMESTAMP} NOT NULL,
+    updated_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_treatment_goals_plan FOREIGN KEY (plan_id) REFERENCES treatment_plans (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_treatment_goals_plan_id ON treatment_goals (plan_id);
+
+CREATE TABLE treatment_targets (
+    id ${AUTO_ID},
+    plan_id INT NOT NULL,
+    goal_id INT NOT NULL,
+    description TEXT NOT NULL,
+    baseline_percent DOUBLE PRECISION NULL,
+    baseline_notes TEXT NULL,
+    mastery_percent DOUBLE PRECISION NOT NULL,
+    mastery_sessions INT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'in_progress',
+    mastered_at ${TIMESTAMP} NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    updated_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_treatment_targets_plan FOREIGN KEY (plan_id) REFERENCES treatment_plans (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_treatment_targets_goal FOREIGN KEY (goal_id) REFERENCES treatment_goals (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_treatment_targets_plan_id ON treatment_targets (plan_id);
+CREATE INDEX idx_treatment_targets_goal_id ON treatment_targets (goal_id);
+
+-- SQLite can't add a foreign key to an existing table, so the service checks this one
+ALTER TABLE activities ADD COLUMN target_id INT NULL;
+
+CREATE INDEX idx_activities_target_id ON activities (target_id);
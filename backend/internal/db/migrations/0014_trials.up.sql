@@ -0,0 +1,14 @@
+-- Discrete trials recorded during activities, scored towards their target's mastery criteria.
+
+CREATE TABLE trials (
+    id ${AUTO_ID},
+    activity_id CHAR(36) NOT NULL,
+    number INT NOT NULL,
+    outcome VARCHAR(20) NOT NULL,
+    prompt_level VARCHAR(20) NULL,
+    recorded_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_trials_activity FOREIGN KEY (activity_id) REFERENCES activities (id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_trials_activity_id ON trials (activity_id);
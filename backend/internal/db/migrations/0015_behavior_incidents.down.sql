@@ -0,0 +1 @@
+DROP TABLE behavior_incidents;
@@ -0,0 +1,25 @@
+-- Antecedent-Behavior-Consequence records of challenging behaviors during sessions.
+
+CREATE TABLE behavior_incidents (
+    id ${AUTO_ID},
+    patient_id CHAR(36) NOT NULL,
+    session_id CHAR(36) NOT NULL,
+    occurred_at ${TIMESTAMP} NOT NULL,
+    behavior_type VARCHAR(100) NOT NULL,
+    intensity VARCHAR(20) NOT NULL,
+    duration_seconds INT NULL,
+    setting VARCHAR(100) NOT NULL DEFAULT '',
+    antecedent TEXT NOT NULL,
+    behavior TEXT NOT NULL,
+    consequence TEXT NOT NULL,
+    staff_response TEXT NOT NULL,
+    function_hypothesis VARCHAR(20) NOT NULL DEFAULT 'unknown',
+    recorded_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    updated_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_behavior_incidents_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_behavior_incidents_session FOREIGN KEY (session_id) REFERENCES sessions (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_behavior_incidents_patient_id ON behavior_incidents (patient_id, occurred_at);
+CREATE INDEX idx_behavior_incidents_session_id ON behavior_incidents (session_id);
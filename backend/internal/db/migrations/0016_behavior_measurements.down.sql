@@ -0,0 +1,2 @@
+DROP TABLE measurement_data_points;
+DROP TABLE behavior_measurements;
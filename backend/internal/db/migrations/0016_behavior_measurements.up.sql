@@ -0,0 +1,36 @@
+-- Frequency, duration and interval measurements of patients' behaviors, with a data point per session.
+
+CREATE TABLE behavior_measurements (
+    id ${AUTO_ID},
+    patient_id CHAR(36) NOT NULL,
+    behavior VARCHAR(100) NOT NULL,
+    definition TEXT NULL,
+    method VARCHAR(20) NOT NULL,
+    interval_seconds INT NULL,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    updated_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_behavior_measurements_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE INDEX idx_behavior_measurements_patient_id ON behavior_measurements (patient_id);
+
+CREATE TABLE measurement_data_points (
+    id ${AUTO_ID},
+    measurement_id INT NOT NULL,
+    session_id CHAR(36) NOT NULL,
+    observation_seconds INT NOT NULL,
+    count INT NULL,
+    duration_seconds INT NULL,
+    intervals INT NULL,
+    intervals_scored INT NULL,
+    recorded_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    updated_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_measurement_data_points_measurement FOREIGN KEY (measurement_id) REFERENCES behavior_measurements (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_measurement_data_points_session FOREIGN KEY (session_id) REFERENCES sessions (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_measurement_data_points_session ON measurement_data_points (measurement_id, session_id);
+CREATE INDEX idx_measurement_data_points_session_id ON measurement_data_points (session_id);
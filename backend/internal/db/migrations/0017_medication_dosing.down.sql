@@ -0,0 +1,9 @@
+DROP TABLE medication_administrations;
+
+ALTER TABLE medicines DROP COLUMN end_date;
+ALTER TABLE medicines DROP COLUMN start_date;
+ALTER TABLE medicines DROP COLUMN dose_times;
+ALTER TABLE medicines DROP COLUMN frequency;
+ALTER TABLE medicines DROP COLUMN route;
+ALTER TABLE medicines DROP COLUMN dose_unit;
+ALTER TABLE medicines DROP COLUMN dose_amount;
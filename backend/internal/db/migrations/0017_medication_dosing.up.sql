@@ -0,0 +1,30 @@
+-- Structured dosing for medicines, and the medication administration record of doses given at the clinic.
+
+ALTER TABLE medicines ADD COLUMN dose_amount DOUBLE PRECISION NULL;
+ALTER TABLE medicines ADD COLUMN dose_unit VARCHAR(20) NULL;
+ALTER TABLE medicines ADD COLUMN route VARCHAR(20) NULL;
+ALTER TABLE medicines ADD COLUMN frequency VARCHAR(20) NULL;
+ALTER TABLE medicines ADD COLUMN dose_times VARCHAR(50) NULL;
+ALTER TABLE medicines ADD COLUMN start_date VARCHAR(10) NULL;
+ALTER TABLE medicines ADD COLUMN end_date VARCHAR(10) NULL;
+
+CREATE TABLE medication_administrations (
+    id ${AUTO_ID},
+    medicine_id CHAR(36) NOT NULL,
+    patient_id CHAR(36) NOT NULL,
+    branch_id INT NOT NULL,
+    due_at ${TIMESTAMP} NULL,
+    status VARCHAR(20) NOT NULL,
+    dose_amount DOUBLE PRECISION NULL,
+    dose_unit VARCHAR(20) NULL,
+    administered_at ${TIMESTAMP} NOT NULL,
+    notes TEXT NULL,
+    recorded_by_id CHAR(36) NOT NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_medication_administrations_medicine FOREIGN KEY (medicine_id) REFERENCES medicines (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_medication_administrations_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_medication_administrations_branch FOREIGN KEY (branch_id) REFERENCES branches (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_medication_administrations_dose ON medication_administrations (medicine_id, due_at);
+CREATE INDEX idx_medication_administrations_patient_id ON medication_administrations (patient_id, administered_at);
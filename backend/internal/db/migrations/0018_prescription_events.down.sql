@@ -0,0 +1,5 @@
+DROP TABLE prescription_events;
+
+ALTER TABLE medicines DROP COLUMN active;
+ALTER TABLE medicines DROP COLUMN renewal_date;
+ALTER TABLE medicines DROP COLUMN refill_date;
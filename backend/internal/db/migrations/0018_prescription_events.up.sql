@@ -0,0 +1,32 @@
+-- Refill and renewal dates for medicines, and the prescription events that version each change to them.
+
+ALTER TABLE medicines ADD COLUMN refill_date VARCHAR(10) NULL;
+ALTER TABLE medicines ADD COLUMN renewal_date VARCHAR(10) NULL;
+ALTER TABLE medicines ADD COLUMN active BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE TABLE prescription_events (
+    id ${AUTO_ID},
+    medicine_id CHAR(36) NOT NULL,
+    version INT NOT NULL,
+    patient_id CHAR(36) NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    reason TEXT NULL,
+    prescriber_id CHAR(36) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    brand_name VARCHAR(255) NULL,
+    dosage VARCHAR(255) NULL,
+    dose_amount DOUBLE PRECISION NULL,
+    dose_unit VARCHAR(20) NULL,
+    route VARCHAR(20) NULL,
+    frequency VARCHAR(20) NULL,
+    dose_times VARCHAR(50) NULL,
+    start_date VARCHAR(10) NULL,
+    end_date VARCHAR(10) NULL,
+    created_at ${TIMESTAMP} NOT NULL,
+    CONSTRAINT fk_prescription_events_medicine FOREIGN KEY (medicine_id) REFERENCES medicines (id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_prescription_events_patient FOREIGN KEY (patient_id) REFERENCES patients (id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_prescription_events_prescriber FOREIGN KEY (prescriber_id) REFERENCES staffs (id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+
+CREATE UNIQUE INDEX idx_prescription_events_version ON prescription_events (medicine_id, version);
+CREATE INDEX idx_prescription_events_patient_id ON prescription_events (patient_id, created_at);
@@ -0,0 +1,4 @@
+-- When each session last changed, so calendar feeds can tell apps an event was updated.
+-- Sessions from before this are left without one.
+
+ALTER TABLE sessions ADD COLUMN updated_at ${TIMESTAMP} NULL;
@@ -0,0 +1,33 @@
+// Package holidays bundles the public holidays branches can import as closures.
+package holidays
+
+// backend/internal/holidays/holidays.go
+
+import (
+	_ "embed"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed india.yaml
+var india []byte
+
+// Holiday is a public holiday on a date in the clinic's timezone
+type Holiday struct {
+	Date string `yaml:"date" json:"date"` // 2006-01-02
+	Name string `yaml:"name" json:"name"`
+}
+
+// India lists the national public holidays of a year, earliest first
+func India(year int) ([]Holiday, error) {
+	var years map[int][]Holiday
+	if err := yaml.Unmarshal(india, &years); err != nil {
+		return nil, fmt.Errorf("reading bundled holidays: %w", err)
+	}
+	holidays, ok := years[year]
+	if !ok {
+		return nil, fmt.Errorf("no public holidays are bundled for %d", year)
+	}
+	return holidays, nil
+}
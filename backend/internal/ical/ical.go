@@ -0,0 +1,114 @@
+// Package ical writes iCalendar (RFC 5545) feeds that calendar apps can subscribe to.
+package ical
+
+// backend/internal/ical/ical.go
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ContentType is the media type of a feed
+const ContentType = "text/calendar; charset=utf-8"
+
+const timestamp = "20060102T150405Z"
+
+// sequenceEpoch is when event sequence numbers start counting seconds from
+var sequenceEpoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// Event is a scheduled event. Calendar apps match events by UID when they refresh a feed,
+// so an event keeps its UID when it moves and disappears when it's removed from the feed.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Location    string
+	Description string
+	Tentative   bool
+	Modified    time.Time // When the event last changed, if known
+}
+
+// Calendar is a named feed of events
+type Calendar struct {
+	Name   string
+	Events []Event
+}
+
+// Bytes renders the calendar as an iCalendar document
+func (c *Calendar) Bytes(now time.Time) []byte {
+	var b bytes.Buffer
+	line := func(name, value string) {
+		fold(&b, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//Paalam//Sessions//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", escape(c.Name))
+	for _, event := range c.Events {
+		line("BEGIN", "VEVENT")
+		line("UID", escape(event.UID))
+		line("DTSTAMP", now.UTC().Format(timestamp))
+		line("DTSTART", event.Start.UTC().Format(timestamp))
+		line("DTEND", event.End.UTC().Format(timestamp))
+		if !event.Modified.IsZero() {
+			line("LAST-MODIFIED", event.Modified.UTC().Format(timestamp))
+			line("SEQUENCE", strconv.FormatInt(sequence(event.Modified), 10))
+		}
+		line("SUMMARY", escape(event.Summary))
+		if event.Location != "" {
+			line("LOCATION", escape(event.Location))
+		}
+		if event.Description != "" {
+			line("DESCRIPTION", escape(event.Description))
+		}
+		if event.Tentative {
+			line("STATUS", "TENTATIVE")
+		} else {
+			line("STATUS", "CONFIRMED")
+		}
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return b.Bytes()
+}
+
+// sequence numbers a revision of an event by the seconds from sequenceEpoch to when it
+// changed, so every change gets a higher number without counting changes
+func sequence(modified time.Time) int64 {
+	return max(0, int64(modified.Sub(sequenceEpoch)/time.Second))
+}
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// escape escapes a TEXT value
+func escape(value string) string {
+	return escaper.Replace(value)
+}
+
+// fold writes a content line, folding it into lines of at most 75 octets without
+// splitting a UTF-8 character
+func fold(b *bytes.Buffer, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8Start(line[cut]) {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		limit = 74 // Continuation lines start with a space
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+func utf8Start(c byte) bool {
+	return c&0xC0 != 0x80
+}
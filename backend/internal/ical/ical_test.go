@@ -0,0 +1,127 @@
+package ical
+
+// backend/internal/ical/ical_test.go
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"Speech therapy", "Speech therapy"},
+		{"Room 2, first floor; east wing", `Room 2\, first floor\; east wing`},
+		{`C:\notes`, `C:\\notes`},
+		{"line one\nline two\r\nline three", `line one\nline two\nline three`},
+	}
+	for _, tt := range tests {
+		if got := escape(tt.value); got != tt.want {
+			t.Errorf("escape(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+	}{
+		{"short", "SUMMARY:Speech therapy"},
+		{"exactly 75 octets", "DESCRIPTION:" + strings.Repeat("a", 63)},
+		{"long ASCII", "DESCRIPTION:" + strings.Repeat("abcdefghij", 20)},
+		{"multi-byte characters", "DESCRIPTION:" + strings.Repeat("ஒலி சிகிச்சை ", 15)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b bytes.Buffer
+			fold(&b, tt.line)
+			folded := b.String()
+			if !strings.HasSuffix(folded, "\r\n") {
+				t.Fatalf("folded line %q doesn't end with CRLF", folded)
+			}
+			lines := strings.Split(strings.TrimSuffix(folded, "\r\n"), "\r\n")
+			for i, line := range lines {
+				if len(line) > 75 {
+					t.Errorf("line %d is %d octets long", i, len(line))
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("continuation line %d doesn't start with a space", i)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("line %d splits a UTF-8 character", i)
+				}
+			}
+			if unfolded := strings.ReplaceAll(folded, "\r\n ", ""); unfolded != tt.line+"\r\n" {
+				t.Errorf("unfolding gives %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
+
+func TestCalendarBytes(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	calendar := &Calendar{
+		Name: "Sessions, Asha",
+		Events: []Event{
+			{UID: "a@paalam", Start: time.Date(2026, 3, 2, 10, 0, 0, 0, kolkata), End: time.Date(2026, 3, 2, 11, 0, 0, 0, kolkata), Summary: "Session", Location: "Main branch",
+				Modified: time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)},
+			{UID: "b@paalam", Start: time.Date(2026, 3, 3, 10, 0, 0, 0, kolkata), End: time.Date(2026, 3, 3, 11, 0, 0, 0, kolkata), Summary: "Session", Tentative: true},
+		},
+	}
+	document := string(calendar.Bytes(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"X-WR-CALNAME:Sessions\\, Asha\r\n",
+		"DTSTAMP:20260301T000000Z\r\n",
+		"DTSTART:20260302T043000Z\r\n",
+		"DTEND:20260302T053000Z\r\n",
+		"LOCATION:Main branch\r\n",
+		"LAST-MODIFIED:20260201T000000Z\r\n",
+		"SEQUENCE:2678400\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"STATUS:TENTATIVE\r\n",
+	} {
+		if !strings.Contains(document, want) {
+			t.Errorf("calendar is missing %q", want)
+		}
+	}
+	if got := strings.Count(document, "BEGIN:VEVENT\r\n"); got != 2 {
+		t.Errorf("calendar has %d events, want 2", got)
+	}
+	if strings.Count(document, "LOCATION:") != 1 || strings.Contains(document, "DESCRIPTION:") {
+		t.Error("empty locations and descriptions should be left out")
+	}
+	if strings.Count(document, "SEQUENCE:") != 1 {
+		t.Error("events without a modified time should be left without a sequence")
+	}
+	if !strings.HasSuffix(document, "END:VCALENDAR\r\n") {
+		t.Error("calendar doesn't end with END:VCALENDAR")
+	}
+}
+
+func TestSequence(t *testing.T) {
+	tests := []struct {
+		modified time.Time
+		want     int64
+	}{
+		{time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), 0},
+		{time.Date(2026, 1, 1, 0, 0, 1, 500, time.UTC), 1},
+		{time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC), 86400},
+	}
+	for _, tt := range tests {
+		if got := sequence(tt.modified); got != tt.want {
+			t.Errorf("sequence(%v) = %d, want %d", tt.modified, got, tt.want)
+		}
+	}
+}
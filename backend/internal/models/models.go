@@ -6,24 +6,27 @@ import (
 )
 
 type Activity struct {
-	ID              string  `gorm:"primaryKey;type:uuid"`
+	ID              string  `gorm:"primaryKey;type:char(36)"`
 	Description     *string `gorm:"type:text"`
 	DurationMinutes *float64
-	SessionID       *string `gorm:"type:uuid"`
+	SessionID       *string `gorm:"type:char(36)"`
 	CreatedAt       time.Time
 	UpdatedAt       time.Time
 	ResponseLevel   *ResponseLevel `gorm:"type:text"`
+	TargetID        *int           `gorm:"index"` // The treatment plan target the activity practises
 
 	// Relationships
-	Session *Session `gorm:"foreignKey:SessionID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Session *Session         `gorm:"foreignKey:SessionID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Target  *TreatmentTarget `gorm:"foreignKey:TargetID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
 }
 
 type Patient struct {
-	ID              string `gorm:"primaryKey;type:uuid"`
+	ID              string `gorm:"primaryKey;type:char(36)"`
 	Name            string
 	Dob             string
 	Active          *bool
-	DoctorID        *string `gorm:"type:uuid"`
+	DoctorID        *string `gorm:"type:char(36)"`
+	StaffID         *string `gorm:"type:char(36)"` // The assigned therapist
 	PrimaryBranchID *int    `gorm:"type:int"`
 	TherapyTypes    *string
 	JoinDate        time.Time
@@ -36,7 +39,7 @@ type Patient struct {
 }
 
 type Guardian struct {
-	ID          string `gorm:"primaryKey;type:uuid"`
+	ID          string `gorm:"primaryKey;type:char(36)"`
 	Name        string
 	PhoneNumber *string
 	Email       *string
@@ -45,15 +48,76 @@ type Guardian struct {
 	Patients []*Patient `gorm:"many2many:patient_guardians;"`
 }
 
+// GuardianLoginCode is a one-time code sent to a guardian to sign in to the portal
+type GuardianLoginCode struct {
+	ID         int    `gorm:"primaryKey;autoIncrement"`
+	GuardianID string `gorm:"type:char(36);index"`
+	CodeHash   string
+	Channel    string `gorm:"type:varchar(10)"` // email or sms
+	Attempts   int
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+
+	// Relationships
+	Guardian Guardian `gorm:"foreignKey:GuardianID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// CalendarFeed lets a calendar app subscribe to a staff member's or patient's sessions. Only
+// a hash of its token is stored; the token itself is shown once, when the feed is created.
+type CalendarFeed struct {
+	ID          int     `gorm:"primaryKey;autoIncrement"`
+	TokenHash   string  `gorm:"type:char(64);uniqueIndex" json:"-"`
+	StaffID     *string `gorm:"type:char(36);index"` // Set for a staff member's feed
+	PatientID   *string `gorm:"type:char(36);index"` // Set for a patient's feed
+	CreatedByID string  `gorm:"type:char(36)"`       // Feeds read sessions as the staff member who created them
+	CreatedAt   time.Time
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+
+	// Relationships
+	Staff     *Staff   `gorm:"foreignKey:StaffID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Patient   *Patient `gorm:"foreignKey:PatientID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	CreatedBy Staff    `gorm:"foreignKey:CreatedByID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
 type StaffRole string
 
+const (
+	RoleAdmin             StaffRole = "admin"
+	RoleTherapist         StaffRole = "therapist"
+	RoleDoctor            StaffRole = "doctor"
+	RoleBehavioralAnalyst StaffRole = "behavioral_analyst"
+)
+
+// RoleGuardian is carried by tokens issued to guardians through the portal. It isn't a staff role.
+const RoleGuardian StaffRole = "guardian"
+
+// Viewer is the staff member or guardian a repository reads on behalf of. Patient,
+// session and activity queries made for a viewer only return rows inside their caseload.
+type Viewer struct {
+	StaffID string
+	Role    StaffRole
+}
+
+// OwnStaffID is the staff member the viewer's sessions must be run by. Therapists only book
+// and move their own sessions; everyone else can book them for any staff member.
+func (v *Viewer) OwnStaffID() string {
+	if v != nil && v.Role == RoleTherapist {
+		return v.StaffID
+	}
+	return ""
+}
+
 type Staff struct {
-	ID              string `gorm:"primaryKey;type:uuid"`
+	ID              string `gorm:"primaryKey;type:char(36)"`
 	Name            string
 	JoinDate        time.Time
 	ExpectedHours   int
 	Role            StaffRole `gorm:"type:varchar(50)"`
 	PrimaryBranchID *int      `gorm:"type:int"`
+	Email           *string   `gorm:"type:varchar(255);uniqueIndex"`
+	PasswordHash    string    `json:"-"`
 
 	// Relationships
 	Patients  []Patient  `gorm:"foreignKey:StaffID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
@@ -62,13 +126,51 @@ type Staff struct {
 	Branch    Branch     `gorm:"foreignKey:PrimaryBranchID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 }
 
+// MedicationRoute is how a medicine is taken
+type MedicationRoute string
+
+const (
+	RouteOral       MedicationRoute = "oral"
+	RouteSublingual MedicationRoute = "sublingual"
+	RouteTopical    MedicationRoute = "topical"
+	RouteInhaled    MedicationRoute = "inhaled"
+	RouteNasal      MedicationRoute = "nasal"
+	RouteRectal     MedicationRoute = "rectal"
+	RouteInjection  MedicationRoute = "injection"
+)
+
+// DoseFrequency is how often a medicine is taken. Scheduled frequencies have as many dose
+// times a day as they say; medicines taken as needed have none.
+type DoseFrequency string
+
+const (
+	FrequencyOnceDaily       DoseFrequency = "once_daily"
+	FrequencyTwiceDaily      DoseFrequency = "twice_daily"
+	FrequencyThreeTimesDaily DoseFrequency = "three_times_daily"
+	FrequencyFourTimesDaily  DoseFrequency = "four_times_daily"
+	FrequencyAsNeeded        DoseFrequency = "as_needed"
+)
+
+// Medicine is a medicine prescribed to a patient. Dosage is free text; prescriptions with
+// structured dosing also set the amount, unit, route, frequency and start date. Each change
+// to the prescription is kept as a PrescriptionEvent.
 type Medicine struct {
-	ID           string `gorm:"primaryKey;type:uuid"`
+	ID           string `gorm:"primaryKey;type:char(36)"`
 	Name         string
 	BrandName    *string
 	Dosage       *string
-	PatientID    string `gorm:"type:uuid"`
-	PrescriberID string `gorm:"type:uuid"` // Refers to Staff (Doctor)
+	PatientID    string           `gorm:"type:char(36)"`
+	PrescriberID string           `gorm:"type:char(36)"` // Refers to Staff (Doctor)
+	DoseAmount   *float64         // Per dose, in DoseUnit
+	DoseUnit     *string          `gorm:"type:varchar(20)"` // Such as mg, ml or tablet
+	Route        *MedicationRoute `gorm:"type:varchar(20)"`
+	Frequency    *DoseFrequency   `gorm:"type:varchar(20)"`
+	DoseTimes    *string          `gorm:"type:varchar(50)"` // Local times doses are due, such as 09:00,14:00
+	StartDate    *string          `gorm:"type:varchar(10)"` // 2006-01-02
+	EndDate      *string          `gorm:"type:varchar(10)"` // Last day of the course, included
+	RefillDate   *string          `gorm:"type:varchar(10)"` // When the current supply runs out
+	RenewalDate  *string          `gorm:"type:varchar(10)"` // When the prescription expires unless renewed
+	Active       bool             `gorm:"default:true"`     // False once discontinued
 
 	// Relationships
 	Patient    Patient `gorm:"foreignKey:PatientID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
@@ -86,28 +188,55 @@ type OperatingHours struct {
 	Branch Branch `gorm:"foreignKey:BranchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
+// HoursPolicy says what happens to sessions booked outside a branch's operating hours
+type HoursPolicy string
+
+const (
+	HoursEnforce HoursPolicy = "enforce" // Such sessions are rejected
+	HoursWarn    HoursPolicy = "warn"    // Such sessions are saved with a warning
+)
+
 type Branch struct {
 	ID          int `gorm:"primaryKey;autoIncrement"`
 	Location    *string
 	OpeningDate time.Time
 	Active      bool
+	HoursPolicy HoursPolicy `gorm:"type:varchar(20);default:enforce"`
 
 	// Relationships
 	OperatingHours []OperatingHours `gorm:"foreignKey:BranchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
+// BranchClosure closes a branch for whole days, such as Diwali or an unplanned closure.
+// Dates are in the clinic's timezone and both ends are included.
+type BranchClosure struct {
+	ID        int    `gorm:"primaryKey;autoIncrement"`
+	BranchID  int    `gorm:"index"`
+	StartDate string `gorm:"type:varchar(10)"` // 2006-01-02
+	EndDate   string `gorm:"type:varchar(10)"`
+	Name      string
+	Holiday   bool // Imported from the bundled public holidays
+
+	Branch Branch `gorm:"foreignKey:BranchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
 type ResponseLevel string // low medium high
 
 type Session struct {
-	ID              string `gorm:"primaryKey;type:uuid"`
-	PatientID       string `gorm:"type:uuid"`
-	StaffID         string `gorm:"type:uuid"`
+	ID              string `gorm:"primaryKey;type:char(36)"`
+	PatientID       string `gorm:"type:char(36)"`
+	StaffID         string `gorm:"type:char(36)"`
 	BranchID        *int   `gorm:"type:int"`
 	StartTime       time.Time
 	EndTime         time.Time
 	Description     string
+	Shareable       bool          // Whether guardians can read the description in the portal
 	Response        ResponseLevel `gorm:"type:varchar(50)"`
-	PaymentReceived *bool
+	PaymentReceived *bool         // Derived from invoices: false while invoiced and unpaid, true once paid
+	SeriesID        *string       `gorm:"type:char(36);index"` // Recurring series the session was generated from
+	OccursAt        *time.Time    // The series occurrence the session fills, kept when only this session is moved
+	ClosureID       *int          `gorm:"index"` // Branch closure the session falls on, until it's rescheduled
+	UpdatedAt       time.Time     // Zero for sessions last changed before it was recorded
 
 	// Relationships
 	Patient        Patient          `gorm:"foreignKey:PatientID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
@@ -117,29 +246,560 @@ type Session struct {
 	OperatingHours []OperatingHours `gorm:"foreignKey:BranchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
 
+// InvoiceStatus is where an invoice is in its life. Open invoices have a balance left to pay.
+type InvoiceStatus string
+
+const (
+	InvoiceOpen InvoiceStatus = "open"
+	InvoicePaid InvoiceStatus = "paid"
+	InvoiceVoid InvoiceStatus = "void" // Cancelled; its sessions can be invoiced again
+)
+
+// Invoice bills a guardian for a patient's completed sessions. Amounts are in paise.
+type Invoice struct {
+	ID          int           `gorm:"primaryKey;autoIncrement"`
+	PatientID   string        `gorm:"type:char(36);index"`
+	GuardianID  string        `gorm:"type:char(36);index"` // Who the invoice is billed to
+	Status      InvoiceStatus `gorm:"type:varchar(10);index"`
+	IssueDate   string        `gorm:"type:varchar(10)"` // 2006-01-02
+	DueDate     string        `gorm:"type:varchar(10)"`
+	Total       int64
+	Paid        int64
+	CreatedByID string `gorm:"type:char(36)"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	VoidedAt    *time.Time
+
+	// Relationships
+	Lines    []InvoiceLine `gorm:"foreignKey:InvoiceID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Payments []Payment     `gorm:"foreignKey:InvoiceID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Patient  Patient       `gorm:"foreignKey:PatientID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Guardian Guardian      `gorm:"foreignKey:GuardianID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+}
+
+// Balance is what's left to pay
+func (i *Invoice) Balance() int64 {
+	return i.Total - i.Paid
+}
+
+// InvoiceLine charges for one session
+type InvoiceLine struct {
+	ID             int     `gorm:"primaryKey;autoIncrement"`
+	InvoiceID      int     `gorm:"index"`
+	SessionID      *string `gorm:"type:char(36);index"`
+	Description    string
+	Minutes        int
+	RateCardID     *int  // The rate card the session was priced from
+	UnitPrice      int64 // Before the discount
+	DiscountRuleID *int
+	Discount       int64
+	Amount         int64
+
+	// Relationships
+	Session *Session `gorm:"foreignKey:SessionID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+}
+
+// TaxInvoice is the GST invoice issued for a paid invoice, numbered without gaps within its
+// branch and financial year. It's never changed once issued: the PDF is kept as first
+// rendered, and reprints are rendered from the same details and marked as reprints.
+type TaxInvoice struct {
+	ID              int    `gorm:"primaryKey;autoIncrement"`
+	InvoiceID       int    `gorm:"uniqueIndex"`
+	BranchID        int    `gorm:"uniqueIndex:idx_tax_invoices_sequence"`
+	FinancialYear   string `gorm:"type:varchar(7);uniqueIndex:idx_tax_invoices_sequence"` // 2026-27, April to March
+	SequenceNumber  int    `gorm:"uniqueIndex:idx_tax_invoices_sequence"`
+	Number          string `gorm:"type:varchar(16);unique"` // B1/26-27/00001
+	IssueDate       string `gorm:"type:varchar(10)"`
+	Taxable         int64  // Paise
+	CGST            int64
+	SGST            int64
+	Total           int64
+	Details         string `gorm:"type:text" json:"-"` // JSON of everything printed on the document
+	PDF             []byte `json:"-"`
+	Reprints        int    // How many times it's been reprinted
+	LastReprintedAt *time.Time
+	CreatedByID     string `gorm:"type:char(36)"`
+	CreatedAt       time.Time
+
+	// Relationships
+	Invoice Invoice `gorm:"foreignKey:InvoiceID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Branch  Branch  `gorm:"foreignKey:BranchID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+}
+
+// TaxInvoiceSequence is the last number used in a branch's tax invoices for a financial year
+type TaxInvoiceSequence struct {
+	BranchID      int    `gorm:"primaryKey;autoIncrement:false"`
+	FinancialYear string `gorm:"primaryKey;type:varchar(7)"`
+	LastNumber    int
+}
+
+// RateCard prices sessions of a therapy type whose length falls in a band, from a date until
+// a card for the same band with a later date takes over. Cards without a branch apply at
+// branches with no card of their own for the band.
+type RateCard struct {
+	ID            int    `gorm:"primaryKey;autoIncrement"`
+	TherapyType   string `gorm:"type:varchar(50);index"` // A Patient.TherapyTypes value such as TRM
+	BranchID      *int   `gorm:"type:int"`
+	MinMinutes    int    // Shortest session the card prices
+	MaxMinutes    int    // Longest session the card prices, included
+	Price         int64  // Per session, in paise
+	EffectiveFrom string `gorm:"type:varchar(10)"` // 2006-01-02
+	CreatedAt     time.Time
+
+	// Relationships
+	Branch *Branch `gorm:"foreignKey:BranchID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type DiscountKind string
+
+const (
+	DiscountSibling  DiscountKind = "sibling"  // Applies by itself to patients who share a guardian with another active patient
+	DiscountHardship DiscountKind = "hardship" // Applies to the patients it's given to; several make a sliding scale
+)
+
+// DiscountRule takes a percentage off session prices. Only the largest discount a
+// patient qualifies for applies.
+type DiscountRule struct {
+	ID        int          `gorm:"primaryKey;autoIncrement"`
+	Name      string       `gorm:"type:varchar(100)"`
+	Kind      DiscountKind `gorm:"type:varchar(20)"`
+	Percent   int
+	Active    bool
+	CreatedAt time.Time
+}
+
+// PatientDiscount gives a patient a hardship discount
+type PatientDiscount struct {
+	PatientID      string `gorm:"primaryKey;type:char(36)"`
+	DiscountRuleID int
+	CreatedAt      time.Time
+
+	// Relationships
+	DiscountRule DiscountRule `gorm:"foreignKey:DiscountRuleID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+}
+
+type PaymentMethod string
+
+const (
+	PaymentCash PaymentMethod = "cash"
+	PaymentUPI  PaymentMethod = "upi"
+	PaymentCard PaymentMethod = "card"
+)
+
+// Payment is money received against an invoice, in full or in part
+type Payment struct {
+	ID           int `gorm:"primaryKey;autoIncrement"`
+	InvoiceID    int `gorm:"index"`
+	Amount       int64
+	Method       PaymentMethod `gorm:"type:varchar(10)"`
+	Reference    string        // UPI transaction ID, card slip number or receipt number
+	ReceivedAt   time.Time
+	ReceivedByID string `gorm:"type:char(36)"` // The staff member who recorded the payment
+	CreatedAt    time.Time
+}
+
+// SessionSeries is a recurring slot, such as weekly on Monday, Wednesday and Friday from 10:00
+// to 11:00. Its sessions are generated ahead of time and can then be edited like any other.
+type SessionSeries struct {
+	ID             string    `gorm:"primaryKey;type:char(36)"`
+	PatientID      string    `gorm:"type:char(36);index"`
+	StaffID        string    `gorm:"type:char(36)"`
+	BranchID       *int      `gorm:"type:int"`
+	Recurrence     string    `gorm:"type:varchar(255)"` // RRULE such as FREQ=WEEKLY;BYDAY=MO,WE,FR;UNTIL=20261231
+	StartTime      time.Time // Start of the first occurrence
+	EndTime        time.Time // End of the first occurrence
+	Description    string
+	Shareable      bool
+	GeneratedUntil time.Time // Sessions exist for every occurrence before this
+
+	Patient    Patient                  `gorm:"foreignKey:PatientID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Staff      Staff                    `gorm:"foreignKey:StaffID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Branch     *Branch                  `gorm:"foreignKey:BranchID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
+	Exceptions []SessionSeriesException `gorm:"foreignKey:SeriesID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// SessionSeriesException is an occurrence cancelled on its own, which is never generated again
+type SessionSeriesException struct {
+	ID       int    `gorm:"primaryKey;autoIncrement"`
+	SeriesID string `gorm:"type:char(36);index"`
+	OccursAt time.Time
+}
+
+type TreatmentPlanStatus string
+
+const (
+	PlanDraft        TreatmentPlanStatus = "draft"
+	PlanActive       TreatmentPlanStatus = "active" // A patient has at most one active plan
+	PlanCompleted    TreatmentPlanStatus = "completed"
+	PlanDiscontinued TreatmentPlanStatus = "discontinued"
+)
+
+// TreatmentPlan is a patient's individualized ABA or IBT plan: long-term goals, each broken
+// down into the short-term targets practised in sessions. Plans are reviewed by ReviewDate.
+type TreatmentPlan struct {
+	ID             int                 `gorm:"primaryKey;autoIncrement"`
+	PatientID      string              `gorm:"type:char(36);index"`
+	Title          string              `gorm:"type:varchar(200)"`
+	Status         TreatmentPlanStatus `gorm:"type:varchar(20);default:draft"`
+	StartDate      string              `gorm:"type:varchar(10)"` // 2006-01-02
+	ReviewDate     string              `gorm:"type:varchar(10)"` // When the next review is due
+	LastReviewedOn *string             `gorm:"type:varchar(10)"`
+	Notes          *string             `gorm:"type:text"`
+	CreatedByID    string              `gorm:"type:char(36)"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// Relationships
+	Goals []TreatmentGoal `gorm:"foreignKey:PlanID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type GoalStatus string
+
+const (
+	GoalActive       GoalStatus = "active"
+	GoalMet          GoalStatus = "met"
+	GoalDiscontinued GoalStatus = "discontinued"
+)
+
+// TreatmentGoal is a long-term goal of a treatment plan, such as requesting preferred items
+// with two-word phrases
+type TreatmentGoal struct {
+	ID          int        `gorm:"primaryKey;autoIncrement"`
+	PlanID      int        `gorm:"index"`
+	Description string     `gorm:"type:text"`
+	Status      GoalStatus `gorm:"type:varchar(20);default:active"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+
+	// Relationships
+	Targets []TreatmentTarget `gorm:"foreignKey:GoalID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+type TargetStatus string
+
+const (
+	TargetInProgress   TargetStatus = "in_progress"
+	TargetMastered     TargetStatus = "mastered"
+	TargetOnHold       TargetStatus = "on_hold"
+	TargetDiscontinued TargetStatus = "discontinued"
+)
+
+// TreatmentTarget is a short-term target working towards a goal. It's mastered once the patient
+// scores at least MasteryPercent in MasterySessions consecutive sessions, such as 80% across 3.
+type TreatmentTarget struct {
+	ID              int          `gorm:"primaryKey;autoIncrement"`
+	PlanID          int          `gorm:"index"` // Kept with the goal's, so targets resolve to their patient in one step
+	GoalID          int          `gorm:"index"`
+	Description     string       `gorm:"type:text"`
+	BaselinePercent *float64     // How the patient scored before teaching started
+	BaselineNotes   *string      `gorm:"type:text"`
+	MasteryPercent  float64      // Lowest score that counts towards mastery
+	MasterySessions int          // Consecutive sessions at or above MasteryPercent
+	Status          TargetStatus `gorm:"type:varchar(20);default:in_progress"`
+	MasteredAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type TrialOutcome string
+
+const (
+	TrialCorrect   TrialOutcome = "correct" // Independent, without a prompt
+	TrialIncorrect TrialOutcome = "incorrect"
+	TrialPrompted  TrialOutcome = "prompted"
+)
+
+// PromptLevel is how much help a trial's response needed, from most to least
+type PromptLevel string
+
+const (
+	PromptFullPhysical    PromptLevel = "full_physical"
+	PromptPartialPhysical PromptLevel = "partial_physical"
+	PromptGestural        PromptLevel = "gestural"
+	PromptIndependent     PromptLevel = "independent"
+)
+
+// Trial is one discrete trial run during an activity. Correct trials are independent, prompted
+// ones say which prompt was needed, and incorrect ones have no prompt level.
+type Trial struct {
+	ID           int          `gorm:"primaryKey;autoIncrement"`
+	ActivityID   string       `gorm:"type:char(36);index"`
+	Number       int          // Order of the trial within the activity, from 1
+	Outcome      TrialOutcome `gorm:"type:varchar(20)"`
+	PromptLevel  *PromptLevel `gorm:"type:varchar(20)"`
+	RecordedByID string       `gorm:"type:char(36)"`
+	CreatedAt    time.Time
+
+	// Relationships
+	Activity *Activity `gorm:"foreignKey:ActivityID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"-"`
+}
+
+type BehaviorIntensity string
+
+const (
+	IntensityMild     BehaviorIntensity = "mild"
+	IntensityModerate BehaviorIntensity = "moderate"
+	IntensitySevere   BehaviorIntensity = "severe"
+)
+
+// BehaviorFunction is the analyst's hypothesis of what a behavior gets the patient
+type BehaviorFunction string
+
+const (
+	FunctionEscape    BehaviorFunction = "escape"    // Avoiding a demand or situation
+	FunctionAttention BehaviorFunction = "attention" // From adults or peers
+	FunctionTangible  BehaviorFunction = "tangible"  // Access to an item or activity
+	FunctionAutomatic BehaviorFunction = "automatic" // Sensory, reinforcing in itself
+	FunctionUnknown   BehaviorFunction = "unknown"
+)
+
+// BehaviorIncident is an Antecedent-Behavior-Consequence record of a challenging behavior
+// during a session: what happened just before, the behavior, and what followed it.
+type BehaviorIncident struct {
+	ID                 int    `gorm:"primaryKey;autoIncrement"`
+	PatientID          string `gorm:"type:char(36);index"` // The session's patient
+	SessionID          string `gorm:"type:char(36);index"`
+	OccurredAt         time.Time
+	BehaviorType       string            `gorm:"type:varchar(100)"` // Such as aggression, self-injury or elopement
+	Intensity          BehaviorIntensity `gorm:"type:varchar(20)"`
+	DurationSeconds    *int
+	Setting            string           `gorm:"type:varchar(100)"` // Where it happened, such as the therapy room
+	Antecedent         string           `gorm:"type:text"`
+	Behavior           string           `gorm:"type:text"` // What was observed
+	Consequence        string           `gorm:"type:text"`
+	StaffResponse      string           `gorm:"type:text"`
+	FunctionHypothesis BehaviorFunction `gorm:"type:varchar(20)"`
+	RecordedByID       string           `gorm:"type:char(36)"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+type MeasurementMethod string
+
+const (
+	MeasureFrequency       MeasurementMethod = "frequency"        // How many times the behavior happened
+	MeasureDuration        MeasurementMethod = "duration"         // How long it lasted altogether
+	MeasurePartialInterval MeasurementMethod = "partial_interval" // Intervals it happened at any point in
+	MeasureWholeInterval   MeasurementMethod = "whole_interval"   // Intervals it lasted throughout
+)
+
+// BehaviorMeasurement defines how one of a patient's behaviors is measured during sessions.
+// Inactive measurements keep their data points but take no new ones.
+type BehaviorMeasurement struct {
+	ID              int               `gorm:"primaryKey;autoIncrement"`
+	PatientID       string            `gorm:"type:char(36);index"`
+	Behavior        string            `gorm:"type:varchar(100)"` // Such as hand flapping
+	Definition      *string           `gorm:"type:text"`         // Operational definition observers score against
+	Method          MeasurementMethod `gorm:"type:varchar(20)"`
+	IntervalSeconds *int              // Length of each interval, for interval recording only
+	Active          bool
+	CreatedByID     string `gorm:"type:char(36)"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// MeasurementDataPoint is what was observed of a measured behavior in one session. Only the
+// fields of its measurement's method are set.
+type MeasurementDataPoint struct {
+	ID                 int    `gorm:"primaryKey;autoIncrement"`
+	MeasurementID      int    `gorm:"uniqueIndex:idx_measurement_data_points_session"`
+	SessionID          string `gorm:"type:char(36);uniqueIndex:idx_measurement_data_points_session"`
+	ObservationSeconds int    // How long the behavior was watched for
+	Count              *int   // Frequency: times the behavior happened
+	DurationSeconds    *int   // Duration: how long it lasted altogether
+	Intervals          *int   // Interval recording: intervals observed
+	IntervalsScored    *int   // Interval recording: intervals the behavior was scored in
+	RecordedByID       string `gorm:"type:char(36)"`
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	// Relationships
+	Session *Session `gorm:"foreignKey:SessionID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;" json:"-"`
+}
+
+type DoseStatus string
+
+const (
+	DoseGiven   DoseStatus = "given"
+	DoseRefused DoseStatus = "refused"
+)
+
+// MedicationAdministration is an entry of a patient's medication administration record: a
+// dose given at the clinic, or noted as refused
+type MedicationAdministration struct {
+	ID             int        `gorm:"primaryKey;autoIncrement"`
+	MedicineID     string     `gorm:"type:char(36);uniqueIndex:idx_medication_administrations_dose"`
+	PatientID      string     `gorm:"type:char(36);index"` // The medicine's patient
+	BranchID       int        // Where it was given
+	DueAt          *time.Time `gorm:"uniqueIndex:idx_medication_administrations_dose"` // The scheduled dose it records, unset for doses taken as needed
+	Status         DoseStatus `gorm:"type:varchar(20)"`
+	DoseAmount     *float64   // What was given, in DoseUnit
+	DoseUnit       *string    `gorm:"type:varchar(20)"`
+	AdministeredAt time.Time  // When it was given or refused
+	Notes          *string    `gorm:"type:text"` // Such as why it was refused
+	RecordedByID   string     `gorm:"type:char(36)"`
+	CreatedAt      time.Time
+}
+
+// PrescriptionChange is what a prescription event did to a medicine
+type PrescriptionChange string
+
+const (
+	PrescriptionStarted      PrescriptionChange = "started"
+	PrescriptionAdjusted     PrescriptionChange = "adjusted"
+	PrescriptionDiscontinued PrescriptionChange = "discontinued"
+)
+
+// PrescriptionEvent is a version of a medicine's prescription, recorded when it's started,
+// adjusted or discontinued. It keeps the prescription as it stood after the change.
+type PrescriptionEvent struct {
+	ID           int                `gorm:"primaryKey;autoIncrement"`
+	MedicineID   string             `gorm:"type:char(36);uniqueIndex:idx_prescription_events_version"`
+	Version      int                `gorm:"uniqueIndex:idx_prescription_events_version"` // 1 for the first event
+	PatientID    string             `gorm:"type:char(36);index"`
+	Kind         PrescriptionChange `gorm:"type:varchar(20)"`
+	Reason       *string            `gorm:"type:text"`
+	PrescriberID string             `gorm:"type:char(36)"` // The doctor who made the change
+	Name         string
+	BrandName    *string
+	Dosage       *string
+	DoseAmount   *float64
+	DoseUnit     *string          `gorm:"type:varchar(20)"`
+	Route        *MedicationRoute `gorm:"type:varchar(20)"`
+	Frequency    *DoseFrequency   `gorm:"type:varchar(20)"`
+	DoseTimes    *string          `gorm:"type:varchar(50)"`
+	StartDate    *string          `gorm:"type:varchar(10)"`
+	EndDate      *string          `gorm:"type:varchar(10)"`
+	CreatedAt    time.Time
+}
+
+// AssessmentScoring describes how an assessment's answers are scored. It's read from the
+// assessment's catalog file and stored with the assessment as JSON.
+type AssessmentScoring struct {
+	Scale      []ScoreLevel      `json:"scale,omitempty" yaml:"scale"`
+	Milestones *MilestoneScoring `json:"milestones,omitempty" yaml:"milestones"`
+}
+
+type ScoreLevel struct {
+	Value float64 `json:"value" yaml:"value"`
+	Label string  `json:"label" yaml:"label"`
+}
+
+// MilestoneScoring places a patient at a milestone level in each domain, as VB-MAPP does.
+// A level is passed when the domain's questions at that level score at least Mastery of
+// their maximum, and the patient's level is the last one passed without a gap.
+type MilestoneScoring struct {
+	Mastery float64          `json:"mastery" yaml:"mastery"`
+	Levels  []MilestoneLevel `json:"levels" yaml:"levels"`
+}
+
+type MilestoneLevel struct {
+	Level int    `json:"level" yaml:"level"`
+	Label string `json:"label" yaml:"label"`
+}
+
+type AnswerType string // score yes_no text
+
+const (
+	AnswerScore AnswerType = "score"
+	AnswerYesNo AnswerType = "yes_no"
+	AnswerText  AnswerType = "text"
+)
+
 type Assessment struct {
-	ID   int    `gorm:"primaryKey;autoIncrement"`
-	Name string `gorm:"type:varchar(100);not null;unique"`
+	ID          int     `gorm:"primaryKey;autoIncrement"`
+	Name        string  `gorm:"type:varchar(100);not null;unique"`
+	Description *string `gorm:"type:text"`
+	Version     int     // Version of the catalog file last imported
+	Scoring     *string `gorm:"type:text"` // JSON scoring metadata from the catalog
 }
 
 type OnboardingQuestion struct {
-	Text         string `gorm:"primaryKey;type:varchar(100);not null;unique"`
-	Group        int
-	AssessmentID int
+	ID             int        `gorm:"primaryKey;autoIncrement"`
+	AssessmentID   int        `gorm:"uniqueIndex:idx_onboarding_questions_code"`
+	Code           string     `gorm:"type:varchar(100);uniqueIndex:idx_onboarding_questions_code"` // Stable identifier within the assessment
+	Text           string     `gorm:"type:text"`
+	Group          int        `gorm:"column:group_number"`
+	GroupName      *string    `gorm:"type:varchar(255)"`
+	Position       int        // Order within the assessment
+	MaxScore       *float64   // Overrides the assessment's highest score
+	AnswerType     AnswerType `gorm:"type:varchar(10);default:score"`
+	Domain         *string    `gorm:"type:varchar(100)"` // Skill area the question counts towards for milestones
+	MilestoneLevel *int       // Milestone level the question belongs to within its domain
+	Retired        bool       // Removed from the catalog but kept for past responses
+
+	Assessment Assessment `gorm:"foreignKey:AssessmentID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+}
+
+type AdministrationStatus string
+
+const (
+	AdministrationInProgress AdministrationStatus = "in_progress"
+	AdministrationCompleted  AdministrationStatus = "completed"
+)
+
+// AssessmentAdministration is one sitting of an assessment by a patient. Clinics
+// re-run assessments every few months, and comparing sittings shows progress.
+type AssessmentAdministration struct {
+	ID             int    `gorm:"primaryKey;autoIncrement"`
+	PatientID      string `gorm:"type:char(36);index:idx_administrations_patient"`
+	AssessmentID   int    `gorm:"index:idx_administrations_patient"`
+	AdministeredBy string `gorm:"type:char(36)"` // Refers to Staff
+	AdministeredAt time.Time
+	Status         AdministrationStatus `gorm:"type:varchar(20);default:in_progress"`
+	Notes          *string              `gorm:"type:text"`
 
+	Patient    Patient    `gorm:"foreignKey:PatientID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
 	Assessment Assessment `gorm:"foreignKey:AssessmentID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
+	Staff      Staff      `gorm:"foreignKey:AdministeredBy;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
 }
 
 type OnboardingResponse struct {
-	ID           int `gorm:"primaryKey;autoIncrement"`
-	QuestionText string
-	PatientID    string    `gorm:"type:uuid"`
-	StaffID      string    `gorm:"type:uuid"`
-	SessionID    *string   `gorm:"type:uuid"`
-	ResponseDate time.Time `gorm:"autoCreateTime"`
+	ID               int  `gorm:"primaryKey;autoIncrement"`
+	AdministrationID *int `gorm:"index"`
+	QuestionID       int
+	PatientID        string    `gorm:"type:char(36)"`
+	StaffID          string    `gorm:"type:char(36)"`
+	SessionID        *string   `gorm:"type:char(36)"`
+	ResponseDate     time.Time `gorm:"autoCreateTime"`
+	Score            *float64  // Score given, or the full score for a yes and 0 for a no. Free text answers aren't scored.
+	Answer           *string   `gorm:"type:text"` // Free text, or yes or no
+	Notes            *string   `gorm:"type:text"`
 
 	Patient            Patient            `gorm:"foreignKey:PatientID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
 	Staff              Staff              `gorm:"foreignKey:StaffID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
-	OnboardingQuestion OnboardingQuestion `gorm:"foreignKey:QuestionText;constraint:OnUpdate:RESTRICT,OnDelete:RESTRICT;"`
+	OnboardingQuestion OnboardingQuestion `gorm:"foreignKey:QuestionID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT;"`
 	Session            *Session           `gorm:"foreignKey:SessionID;constraint:OnUpdate:CASCADE,OnDelete:SET NULL;"`
 }
+
+type AuditAction string
+
+const (
+	AuditView   AuditAction = "view"
+	AuditCreate AuditAction = "create"
+	AuditUpdate AuditAction = "update"
+	AuditDelete AuditAction = "delete"
+)
+
+// AuditLog records who viewed or changed patient data. Rows are only ever appended.
+type AuditLog struct {
+	ID         int         `gorm:"primaryKey;autoIncrement"`
+	ActorID    string      `gorm:"type:char(36);index"`
+	ActorRole  StaffRole   `gorm:"type:varchar(50)"`
+	Action     AuditAction `gorm:"type:varchar(10)"`
+	EntityType string      `gorm:"type:varchar(50);index:idx_audit_entity"`
+	EntityID   string      `gorm:"type:varchar(64);index:idx_audit_entity"`
+	PatientID  *string     `gorm:"type:char(36);index"`
+	Changes    *string     `gorm:"type:text"` // JSON object of column to before and after values
+	RequestID  string      `gorm:"type:varchar(64)"`
+	IP         string      `gorm:"type:varchar(45)"`
+	CreatedAt  time.Time   `gorm:"index"`
+}
+
+// AuditLogFilter narrows an audit log query. Empty fields don't filter.
+type AuditLogFilter struct {
+	PatientID string
+	ActorID   string
+	From      *time.Time
+	To        *time.Time
+}
@@ -0,0 +1,181 @@
+// Package pdf writes simple A4 documents of text and rules in the standard Helvetica
+// fonts, which every PDF reader has, so nothing needs embedding.
+package pdf
+
+// backend/internal/pdf/pdf.go
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ContentType is the media type of a document
+const ContentType = "application/pdf"
+
+// The size of an A4 page in points
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Document is a PDF of one or more pages
+type Document struct {
+	Title string
+	pages []*Page
+}
+
+// Page is drawn on in points from its top left corner
+type Page struct {
+	content bytes.Buffer
+}
+
+// AddPage starts a new page at the end of the document
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Text writes s with its baseline at y, starting from x
+func (p *Page) Text(x, y, size float64, bold bool, s string) {
+	font := "F1"
+	if bold {
+		font = "F2"
+	}
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, num(size), num(x), num(PageHeight-y), escape(encode(s)))
+}
+
+// TextRight writes s with its baseline at y, ending at x
+func (p *Page) TextRight(x, y, size float64, bold bool, s string) {
+	p.Text(x-Width(s, size, bold), y, size, bold, s)
+}
+
+// Line draws a rule from x1, y1 to x2, y2
+func (p *Page) Line(x1, y1, x2, y2, thickness float64) {
+	fmt.Fprintf(&p.content, "%s w %s %s m %s %s l S\n", num(thickness), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// Width is how wide s is in points when written at size
+func Width(s string, size float64, bold bool) float64 {
+	widths := regular
+	if bold {
+		widths = boldWidths
+	}
+	var total int
+	for _, c := range encode(s) {
+		if c >= 32 && c <= 126 {
+			total += widths[c-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// Wrap breaks s into lines no wider than width, between words
+func Wrap(s string, size float64, bold bool, width float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(s, "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			if line != "" && Width(line+" "+word, size, bold) > width {
+				lines = append(lines, line)
+				line = word
+				continue
+			}
+			if line != "" {
+				line += " "
+			}
+			line += word
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Bytes renders the document
+func (d *Document) Bytes() []byte {
+	var b bytes.Buffer
+	var offsets []int
+	object := func(body string) {
+		offsets = append(offsets, b.Len())
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	b.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	// Objects 1 to 5 are fixed; each page then takes two, itself and its content
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	object("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	object(fmt.Sprintf("<< /Title (%s) /Producer (Paalam) >>", escape(encode(d.Title))))
+	for i, page := range d.pages {
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), 7+2*i))
+		object(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", page.content.Len(), page.content.String()))
+	}
+
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return b.Bytes()
+}
+
+// encode converts s to the fonts' WinAnsi encoding. Latin-1 letters keep their code and
+// anything else, such as the rupee sign or Malayalam script, becomes a question mark.
+func encode(s string) []byte {
+	encoded := make([]byte, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= 32 && r <= 126, r >= 0xA0 && r <= 0xFF:
+			encoded = append(encoded, byte(r))
+		case r == '\t':
+			encoded = append(encoded, ' ')
+		default:
+			encoded = append(encoded, '?')
+		}
+	}
+	return encoded
+}
+
+func escape(s []byte) string {
+	var b strings.Builder
+	for _, c := range s {
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func num(f float64) string {
+	return strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", f), "0"), ".")
+}
+
+// Character widths of the printable ASCII characters, from space to tilde, in thousandths of the font size
+var regular = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var boldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
@@ -0,0 +1,109 @@
+package pdf
+
+// backend/internal/pdf/pdf_test.go
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestWidth(t *testing.T) {
+	tests := []struct {
+		s    string
+		size float64
+		bold bool
+		want float64
+	}{
+		{"", 10, false, 0},
+		{"a", 10, false, 5.56},
+		{"a", 10, true, 5.56},
+		{"Il", 10, false, 5},   // 278 + 222
+		{"Il", 10, true, 5.56}, // 278 + 278
+		{"₹", 10, false, 5.56}, // Encoded as a question mark
+	}
+	for _, tt := range tests {
+		if got := Width(tt.s, tt.size, tt.bold); fmt.Sprintf("%.2f", got) != fmt.Sprintf("%.2f", tt.want) {
+			t.Errorf("Width(%q, %v, %v) = %v, want %v", tt.s, tt.size, tt.bold, got, tt.want)
+		}
+	}
+}
+
+func TestWrap(t *testing.T) {
+	// At size 10 every digit is 5.56 points wide and a space 2.78
+	tests := []struct {
+		name  string
+		s     string
+		width float64
+		want  []string
+	}{
+		{"fits", "12 34", 100, []string{"12 34"}},
+		{"breaks between words", "12 34 56", 30, []string{"12 34", "56"}},
+		{"long word on its own line", "1234567890 12", 20, []string{"1234567890", "12"}},
+		{"keeps paragraphs", "12\n\n34", 100, []string{"12", "", "34"}},
+		{"collapses spaces", "  12   34  ", 100, []string{"12 34"}},
+		{"empty", "", 100, []string{""}},
+	}
+	for _, tt := range tests {
+		if got := Wrap(tt.s, 10, false, tt.width); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Wrap(%q) = %q, want %q", tt.name, tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"Invoice (copy)", `Invoice \(copy\)`},
+		{`a\b`, `a\\b`},
+		{"café\tbill", "caf\xe9 bill"},
+		{"₹ 500", "? 500"},
+	}
+	for _, tt := range tests {
+		if got := escape(encode(tt.s)); got != tt.want {
+			t.Errorf("escape(encode(%q)) = %q, want %q", tt.s, got, tt.want)
+		}
+	}
+}
+
+func TestBytesCrossReferences(t *testing.T) {
+	document := &Document{Title: "Tax invoice"}
+	for i := 0; i < 3; i++ {
+		page := document.AddPage()
+		page.Text(40, 40, 12, i == 0, fmt.Sprintf("Page %d", i+1))
+		page.Line(40, 50, 555, 50, 0.5)
+	}
+	output := document.Bytes()
+
+	if !bytes.HasPrefix(output, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(output, []byte("%%EOF\n")) {
+		t.Fatal("document lacks the PDF header or end marker")
+	}
+	if !bytes.Contains(output, []byte("/Count 3")) {
+		t.Error("page tree doesn't count 3 pages")
+	}
+
+	// Every object's offset in the cross-reference table must point at the object
+	match := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(output)
+	if match == nil {
+		t.Fatal("document has no startxref")
+	}
+	xref, _ := strconv.Atoi(string(match[1]))
+	if !bytes.HasPrefix(output[xref:], []byte("xref\n")) {
+		t.Fatalf("startxref %d doesn't point at the cross-reference table", xref)
+	}
+	offsets := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllSubmatch(output[xref:], -1)
+	if len(offsets) != 5+2*3 {
+		t.Fatalf("cross-reference table has %d objects, want %d", len(offsets), 5+2*3)
+	}
+	for i, offset := range offsets {
+		at, _ := strconv.Atoi(string(offset[1]))
+		if want := fmt.Sprintf("%d 0 obj\n", i+1); !bytes.HasPrefix(output[at:], []byte(want)) {
+			t.Errorf("object %d isn't at offset %d", i+1, at)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Package recurrence expands the subset of iCalendar RRULEs used for recurring sessions.
+package recurrence
+
+// backend/internal/recurrence/rule.go
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency string
+
+const (
+	Daily  Frequency = "DAILY"
+	Weekly Frequency = "WEEKLY"
+)
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+// Rule is a parsed RRULE such as FREQ=WEEKLY;BYDAY=MO,WE,FR;UNTIL=20261231. Occurrences
+// keep the local time of day of the series' first occurrence, and weeks start on Monday.
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	ByDay    []time.Weekday
+	Until    *time.Time // Last moment an occurrence may start
+}
+
+// Parse reads an RRULE, with or without the RRULE: prefix. A date-only UNTIL lasts
+// until the end of that day in loc.
+func Parse(value string, loc *time.Location) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, fmt.Errorf("recurrence is required")
+	}
+
+	rule := &Rule{Interval: 1}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("recurrence part %q must look like KEY=VALUE", part)
+		}
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			switch Frequency(strings.ToUpper(val)) {
+			case Daily, Weekly:
+				rule.Freq = Frequency(strings.ToUpper(val))
+			default:
+				return nil, fmt.Errorf("recurrence FREQ must be DAILY or WEEKLY")
+			}
+		case "INTERVAL":
+			interval, err := strconv.Atoi(val)
+			if err != nil || interval < 1 {
+				return nil, fmt.Errorf("recurrence INTERVAL must be a positive number")
+			}
+			rule.Interval = interval
+		case "BYDAY":
+			seen := map[time.Weekday]bool{}
+			for _, day := range strings.Split(val, ",") {
+				weekday, ok := weekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, fmt.Errorf("recurrence BYDAY has unknown day %q, use MO, TU, WE, TH, FR, SA or SU", day)
+				}
+				if !seen[weekday] {
+					seen[weekday] = true
+					rule.ByDay = append(rule.ByDay, weekday)
+				}
+			}
+			sort.Slice(rule.ByDay, func(i, j int) bool {
+				return mondayFirst(rule.ByDay[i]) < mondayFirst(rule.ByDay[j])
+			})
+		case "UNTIL":
+			until, err := parseUntil(val, loc)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "COUNT":
+			return nil, fmt.Errorf("recurrence COUNT isn't supported, use UNTIL")
+		case "WKST":
+			if strings.ToUpper(val) != "MO" {
+				return nil, fmt.Errorf("recurrence weeks start on Monday")
+			}
+		default:
+			return nil, fmt.Errorf("recurrence %s isn't supported", key)
+		}
+	}
+	if rule.Freq == "" {
+		return nil, fmt.Errorf("recurrence FREQ is required")
+	}
+	return rule, nil
+}
+
+func parseUntil(value string, loc *time.Location) (time.Time, error) {
+	if until, err := time.Parse("20060102T150405Z", value); err == nil {
+		return until, nil
+	}
+	if until, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return until, nil
+	}
+	day, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("recurrence UNTIL must be a date like 20261231")
+	}
+	return day.AddDate(0, 0, 1).Add(-time.Second), nil
+}
+
+// String writes the rule back as an RRULE, with UNTIL in UTC
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + string(r.Freq)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := make([]string, 0, len(r.ByDay))
+		for _, weekday := range r.ByDay {
+			for code, day := range weekdays {
+				if day == weekday {
+					days = append(days, code)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Occurrences lists the start of every occurrence in [from, to) of a series whose first
+// occurrence is at or after start. Times are calculated on the calendar of start's location,
+// so a 10:00 slot stays at 10:00 local time.
+func (r *Rule) Occurrences(start, from, to time.Time) []time.Time {
+	loc := start.Location()
+	if r.Until != nil && r.Until.Before(to) {
+		to = r.Until.Add(time.Second)
+	}
+	if from.Before(start) {
+		from = start
+	}
+	if !from.Before(to) {
+		return nil
+	}
+
+	byDay := map[time.Weekday]bool{}
+	for _, weekday := range r.ByDay {
+		byDay[weekday] = true
+	}
+	if len(byDay) == 0 && r.Freq == Weekly {
+		byDay[start.Weekday()] = true
+	}
+
+	first := date(start.In(loc))
+	firstMonday := first.AddDate(0, 0, -mondayFirst(first.Weekday()))
+
+	var occurrences []time.Time
+	last := date(to.In(loc))
+	for day := date(from.In(loc)); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if len(byDay) > 0 && !byDay[day.Weekday()] {
+			continue
+		}
+		switch r.Freq {
+		case Daily:
+			if days(first, day)%r.Interval != 0 {
+				continue
+			}
+		case Weekly:
+			if (days(firstMonday, day)/7)%r.Interval != 0 {
+				continue
+			}
+		}
+
+		occurrence := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, loc)
+		if occurrence.Before(from) || !occurrence.Before(to) {
+			continue
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences
+}
+
+// First finds the first occurrence at or after start, if the rule has any
+func (r *Rule) First(start time.Time) (time.Time, bool) {
+	// Every day of a week is tried before skipping the weeks in between
+	occurrences := r.Occurrences(start, start, start.AddDate(0, 0, 7*r.Interval+1))
+	if len(occurrences) == 0 {
+		return time.Time{}, false
+	}
+	return occurrences[0], true
+}
+
+// date drops the time of day, keeping the calendar date in UTC so days can be counted
+func date(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func days(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func mondayFirst(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
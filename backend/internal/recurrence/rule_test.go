@@ -0,0 +1,171 @@
+package recurrence
+
+// backend/internal/recurrence/rule_test.go
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		value   string
+		want    string // The rule written back, with UNTIL in UTC
+		wantErr bool
+	}{
+		{"FREQ=DAILY", "FREQ=DAILY", false},
+		{"RRULE:FREQ=WEEKLY;BYDAY=FR,MO,WE", "FREQ=WEEKLY;BYDAY=MO,WE,FR", false},
+		{"freq=weekly;byday=su,mo,su", "FREQ=WEEKLY;BYDAY=MO,SU", false},
+		{"FREQ=WEEKLY;INTERVAL=2;WKST=MO", "FREQ=WEEKLY;INTERVAL=2", false},
+		{"FREQ=DAILY;INTERVAL=1", "FREQ=DAILY", false},
+		{"FREQ=DAILY;UNTIL=20261231", "FREQ=DAILY;UNTIL=20261231T182959Z", false},
+		{"FREQ=DAILY;UNTIL=20261231T100000Z", "FREQ=DAILY;UNTIL=20261231T100000Z", false},
+		{"FREQ=DAILY;UNTIL=20261231T100000", "FREQ=DAILY;UNTIL=20261231T043000Z", false},
+		{"", "", true},
+		{"FREQ=MONTHLY", "", true},
+		{"BYDAY=MO", "", true},
+		{"FREQ=WEEKLY;BYDAY=XX", "", true},
+		{"FREQ=DAILY;INTERVAL=0", "", true},
+		{"FREQ=DAILY;COUNT=10", "", true},
+		{"FREQ=DAILY;WKST=SU", "", true},
+		{"FREQ=DAILY;UNTIL=tomorrow", "", true},
+		{"FREQ", "", true},
+	}
+	for _, tt := range tests {
+		rule, err := Parse(tt.value, kolkata)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if err == nil && rule.String() != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.value, rule.String(), tt.want)
+		}
+	}
+}
+
+func TestOccurrences(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Monday 2 March 2026 at 10:00
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, london)
+	tests := []struct {
+		name     string
+		rule     string
+		from, to time.Time
+		want     []string
+	}{
+		{
+			name: "weekly on the day of the first occurrence",
+			rule: "FREQ=WEEKLY",
+			from: start, to: start.AddDate(0, 0, 21),
+			want: []string{"2026-03-02 10:00", "2026-03-09 10:00", "2026-03-16 10:00"},
+		},
+		{
+			name: "several days a week",
+			rule: "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			from: start, to: start.AddDate(0, 0, 7),
+			want: []string{"2026-03-02 10:00", "2026-03-04 10:00", "2026-03-06 10:00"},
+		},
+		{
+			name: "every other week",
+			rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU",
+			from: start, to: start.AddDate(0, 0, 28),
+			want: []string{"2026-03-03 10:00", "2026-03-17 10:00"},
+		},
+		{
+			name: "every third day",
+			rule: "FREQ=DAILY;INTERVAL=3",
+			from: start, to: start.AddDate(0, 0, 10),
+			want: []string{"2026-03-02 10:00", "2026-03-05 10:00", "2026-03-08 10:00", "2026-03-11 10:00"},
+		},
+		{
+			name: "daily on weekdays only",
+			rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			from: start.AddDate(0, 0, 3), to: start.AddDate(0, 0, 8),
+			want: []string{"2026-03-05 10:00", "2026-03-06 10:00", "2026-03-09 10:00"},
+		},
+		{
+			name: "until the end of a day",
+			rule: "FREQ=DAILY;UNTIL=20260304",
+			from: start, to: start.AddDate(0, 0, 30),
+			want: []string{"2026-03-02 10:00", "2026-03-03 10:00", "2026-03-04 10:00"},
+		},
+		{
+			name: "from before the first occurrence",
+			rule: "FREQ=DAILY",
+			from: start.AddDate(0, 0, -5), to: start.AddDate(0, 0, 2),
+			want: []string{"2026-03-02 10:00", "2026-03-03 10:00"},
+		},
+		{
+			name: "keeps the local time across a clock change",
+			rule: "FREQ=WEEKLY;BYDAY=MO",
+			from: start.AddDate(0, 0, 21), to: start.AddDate(0, 0, 35),
+			want: []string{"2026-03-23 10:00", "2026-03-30 10:00"},
+		},
+		{
+			name: "empty range",
+			rule: "FREQ=DAILY",
+			from: start.AddDate(0, 0, 1), to: start.AddDate(0, 0, 1),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := Parse(tt.rule, london)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, occurrence := range rule.Occurrences(start, tt.from, tt.to) {
+				if occurrence.Location() != london {
+					t.Errorf("occurrence %v isn't in the series' location", occurrence)
+				}
+				got = append(got, occurrence.Format("2006-01-02 15:04"))
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Occurrences() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Occurrences() = %v, want %v", got, tt.want)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestFirst(t *testing.T) {
+	// Thursday 5 March 2026 at 16:00
+	start := time.Date(2026, 3, 5, 16, 0, 0, 0, time.UTC)
+	tests := []struct {
+		rule   string
+		want   string
+		wantOK bool
+	}{
+		{"FREQ=DAILY", "2026-03-05 16:00", true},
+		{"FREQ=WEEKLY;BYDAY=MO", "2026-03-09 16:00", true},
+		{"FREQ=WEEKLY;INTERVAL=3;BYDAY=TU", "2026-03-24 16:00", true},
+		{"FREQ=WEEKLY;BYDAY=MO;UNTIL=20260308", "", false},
+	}
+	for _, tt := range tests {
+		rule, err := Parse(tt.rule, time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		first, ok := rule.First(start)
+		if ok != tt.wantOK {
+			t.Errorf("First() for %s ok = %v, want %v", tt.rule, ok, tt.wantOK)
+			continue
+		}
+		if ok && first.Format("2006-01-02 15:04") != tt.want {
+			t.Errorf("First() for %s = %s, want %s", tt.rule, first.Format("2006-01-02 15:04"), tt.want)
+		}
+	}
+}
@@ -8,13 +8,29 @@ import (
 )
 
 type ActivityRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	viewer *models.Viewer
 }
 
 func NewActivityRepository(db *gorm.DB) *ActivityRepository {
 	return &ActivityRepository{db: db}
 }
 
+// WithViewer returns a copy of the repository that only sees activities of the viewer's caseload
+func (r *ActivityRepository) WithViewer(viewer *models.Viewer) *ActivityRepository {
+	return &ActivityRepository{db: r.db, viewer: viewer}
+}
+
+// scoped starts a query limited to activities from sessions of the viewer's caseload
+func (r *ActivityRepository) scoped() *gorm.DB {
+	subQuery, unrestricted := caseload(r.db, r.viewer)
+	if unrestricted {
+		return r.db
+	}
+	sessions := r.db.Model(&models.Session{}).Select("id").Where("patient_id IN (?)", subQuery)
+	return r.db.Where("activities.session_id IN (?)", sessions)
+}
+
 // Create a new activity
 func (r *ActivityRepository) Create(activity *models.Activity) error {
 	return r.db.Create(activity).Error
@@ -23,7 +39,7 @@ func (r *ActivityRepository) Create(activity *models.Activity) error {
 // Find an activity by ID
 func (r *ActivityRepository) FindByID(id string) (*models.Activity, error) {
 	var activity models.Activity
-	if err := r.db.First(&activity, "id = ?", id).Error; err != nil {
+	if err := r.scoped().First(&activity, "activities.id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &activity, nil
@@ -32,18 +48,59 @@ func (r *ActivityRepository) FindByID(id string) (*models.Activity, error) {
 // Find activities by SessionID
 func (r *ActivityRepository) FindBySessionID(sessionID string) ([]*models.Activity, error) {
 	var activities []*models.Activity
-	if err := r.db.Where("session_id = ?", sessionID).Find(&activities).Error; err != nil {
+	if err := r.scoped().Where("session_id = ?", sessionID).Find(&activities).Error; err != nil {
 		return nil, err
 	}
 	return activities, nil
 }
 
+// Find the activities recorded in any of the sessions
+func (r *ActivityRepository) FindBySessionIDs(sessionIDs []string) ([]*models.Activity, error) {
+	var activities []*models.Activity
+	if len(sessionIDs) == 0 {
+		return activities, nil
+	}
+	if err := r.scoped().Where("session_id IN ?", sessionIDs).Order("created_at").Find(&activities).Error; err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// Count the activities recorded in each of the sessions. Sessions without activities are left out.
+func (r *ActivityRepository) CountBySessionIDs(sessionIDs []string) (map[string]int64, error) {
+	counts := map[string]int64{}
+	if len(sessionIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		SessionID string
+		Count     int64
+	}
+	if err := r.scoped().Model(&models.Activity{}).
+		Select("session_id, COUNT(*) AS count").
+		Where("session_id IN ?", sessionIDs).
+		Group("session_id").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.SessionID] = row.Count
+	}
+	return counts, nil
+}
+
 // Update an activity
 func (r *ActivityRepository) Update(id string, updates map[string]interface{}) error {
+	if _, err := r.FindByID(id); err != nil {
+		return err
+	}
 	return r.db.Model(&models.Activity{}).Where("id = ?", id).Updates(updates).Error
 }
 
 // Delete an activity
 func (r *ActivityRepository) Delete(id string) error {
+	if _, err := r.FindByID(id); err != nil {
+		return err
+	}
 	return r.db.Delete(&models.Activity{}, "id = ?", id).Error
 }
@@ -0,0 +1,49 @@
+package impl
+
+// backend/internal/repository/impl/assessment_administration.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type AssessmentAdministrationRepository struct {
+	db *gorm.DB
+}
+
+func NewAssessmentAdministrationRepository(db *gorm.DB) *AssessmentAdministrationRepository {
+	return &AssessmentAdministrationRepository{db: db}
+}
+
+// Create a new assessment administration
+func (r *AssessmentAdministrationRepository) Create(administration *models.AssessmentAdministration) error {
+	return r.db.Create(administration).Error
+}
+
+// Find an assessment administration by ID
+func (r *AssessmentAdministrationRepository) FindByID(id int) (*models.AssessmentAdministration, error) {
+	var administration models.AssessmentAdministration
+	if err := r.db.First(&administration, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &administration, nil
+}
+
+// Find a patient's administrations, of a single assessment unless assessmentID is 0, newest first
+func (r *AssessmentAdministrationRepository) FindByPatientID(patientID string, assessmentID int) ([]*models.AssessmentAdministration, error) {
+	var administrations []*models.AssessmentAdministration
+	query := r.db.Where("patient_id = ?", patientID)
+	if assessmentID != 0 {
+		query = query.Where("assessment_id = ?", assessmentID)
+	}
+	if err := query.Order("administered_at DESC, id DESC").Find(&administrations).Error; err != nil {
+		return nil, err
+	}
+	return administrations, nil
+}
+
+// Update an assessment administration
+func (r *AssessmentAdministrationRepository) Update(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.AssessmentAdministration{}).Where("id = ?", id).Updates(updates).Error
+}
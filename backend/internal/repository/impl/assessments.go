@@ -42,7 +42,7 @@ func (r *AssessmentRepository) FindByName(name string) (*models.Assessment, erro
 // Get all assessments
 func (r *AssessmentRepository) GetAll() ([]*models.Assessment, error) {
 	var assessments []*models.Assessment
-	if err := r.db.Find(&assessments).Error; err != nil {
+	if err := r.db.Order("name").Find(&assessments).Error; err != nil {
 		return nil, err
 	}
 	return assessments, nil
@@ -57,28 +57,3 @@ func (r *AssessmentRepository) Update(id int, updates map[string]interface{}) er
 func (r *AssessmentRepository) Delete(id int) error {
 	return r.db.Delete(&models.Assessment{}, "id = ?", id).Error
 }
-
-// EnsureDefaultAssessments creates default assessments if they don't exist
-func (r *AssessmentRepository) EnsureDefaultAssessments() error {
-	defaultAssessments := []string{
-		"VB-MAPP", // Verbal Behavior Milestones Assessment and Placement Program
-		"ESFLS",   // Essential for Living Skills
-		"ABLLS-R", // Assessment of Basic Language and Learning Skills-Revised
-	}
-
-	for _, name := range defaultAssessments {
-		var count int64
-		r.db.Model(&models.Assessment{}).Where("name = ?", name).Count(&count)
-
-		if count == 0 {
-			assessment := models.Assessment{
-				Name: name,
-			}
-			if err := r.db.Create(&assessment).Error; err != nil {
-				return err
-			}
-		}
-	}
-
-	return nil
-}
@@ -0,0 +1,46 @@
+package impl
+
+// backend/internal/repository/impl/audit_log.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// AuditLogRepository only reads the audit log. Entries are written by the audit callbacks.
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditLogRepository(db *gorm.DB) *AuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// List audit log entries matching the filter, newest first, returning the page and the total count
+func (r *AuditLogRepository) List(filter models.AuditLogFilter, limit, offset int) ([]*models.AuditLog, int64, error) {
+	query := r.db.Model(&models.AuditLog{})
+	if filter.PatientID != "" {
+		query = query.Where("patient_id = ?", filter.PatientID)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entries []*models.AuditLog
+	if err := query.Order("created_at DESC, id DESC").Limit(limit).Offset(offset).Find(&entries).Error; err != nil {
+		return nil, 0, err
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,63 @@
+package impl
+
+// backend/internal/repository/impl/behavior_incident.go
+
+import (
+	"time"
+
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BehaviorIncidentRepository struct {
+	db *gorm.DB
+}
+
+func NewBehaviorIncidentRepository(db *gorm.DB) *BehaviorIncidentRepository {
+	return &BehaviorIncidentRepository{db: db}
+}
+
+// Create a new behavior incident
+func (r *BehaviorIncidentRepository) Create(incident *models.BehaviorIncident) error {
+	return r.db.Create(incident).Error
+}
+
+// Find a behavior incident by ID
+func (r *BehaviorIncidentRepository) FindByID(id int) (*models.BehaviorIncident, error) {
+	var incident models.BehaviorIncident
+	if err := r.db.First(&incident, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
+
+// Find a patient's incidents that occurred from from until to, earliest first. Zero times
+// leave that end of the range open, and an empty behavior type matches every type.
+func (r *BehaviorIncidentRepository) FindByPatientID(patientID string, from, to time.Time, behaviorType string) ([]*models.BehaviorIncident, error) {
+	var incidents []*models.BehaviorIncident
+	query := r.db.Where("patient_id = ?", patientID)
+	if !from.IsZero() {
+		query = query.Where("occurred_at >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("occurred_at < ?", to)
+	}
+	if behaviorType != "" {
+		query = query.Where("LOWER(behavior_type) = LOWER(?)", behaviorType)
+	}
+	if err := query.Order("occurred_at, id").Find(&incidents).Error; err != nil {
+		return nil, err
+	}
+	return incidents, nil
+}
+
+// Update a behavior incident
+func (r *BehaviorIncidentRepository) Update(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.BehaviorIncident{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Delete a behavior incident
+func (r *BehaviorIncidentRepository) Delete(id int) error {
+	return r.db.Delete(&models.BehaviorIncident{}, "id = ?", id).Error
+}
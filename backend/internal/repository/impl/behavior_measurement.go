@@ -0,0 +1,120 @@
+package impl
+
+// backend/internal/repository/impl/behavior_measurement.go
+
+import (
+	"time"
+
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BehaviorMeasurementRepository struct {
+	db *gorm.DB
+}
+
+func NewBehaviorMeasurementRepository(db *gorm.DB) *BehaviorMeasurementRepository {
+	return &BehaviorMeasurementRepository{db: db}
+}
+
+// Create a new behavior measurement
+func (r *BehaviorMeasurementRepository) Create(measurement *models.BehaviorMeasurement) error {
+	return r.db.Create(measurement).Error
+}
+
+// Find a behavior measurement by ID
+func (r *BehaviorMeasurementRepository) FindByID(id int) (*models.BehaviorMeasurement, error) {
+	var measurement models.BehaviorMeasurement
+	if err := r.db.First(&measurement, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &measurement, nil
+}
+
+// Find a patient's behavior measurements, active ones first
+func (r *BehaviorMeasurementRepository) FindByPatientID(patientID string) ([]*models.BehaviorMeasurement, error) {
+	var measurements []*models.BehaviorMeasurement
+	if err := r.db.Where("patient_id = ?", patientID).Order("active DESC, behavior, id").Find(&measurements).Error; err != nil {
+		return nil, err
+	}
+	return measurements, nil
+}
+
+// Update a behavior measurement
+func (r *BehaviorMeasurementRepository) Update(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.BehaviorMeasurement{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Delete a behavior measurement
+func (r *BehaviorMeasurementRepository) Delete(id int) error {
+	return r.db.Delete(&models.BehaviorMeasurement{}, "id = ?", id).Error
+}
+
+// HasDataPoints reports whether any session recorded the measurement
+func (r *BehaviorMeasurementRepository) HasDataPoints(id int) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.MeasurementDataPoint{}).Where("measurement_id = ?", id).Count(&count).Error
+	return count > 0, err
+}
+
+// Create a new data point
+func (r *BehaviorMeasurementRepository) CreateDataPoint(point *models.MeasurementDataPoint) error {
+	return r.db.Omit("Session").Create(point).Error
+}
+
+// Find a data point by ID, with its session
+func (r *BehaviorMeasurementRepository) FindDataPointByID(id int) (*models.MeasurementDataPoint, error) {
+	var point models.MeasurementDataPoint
+	if err := r.db.Preload("Session").First(&point, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &point, nil
+}
+
+// Find the data point a session recorded for a measurement
+func (r *BehaviorMeasurementRepository) FindDataPoint(measurementID int, sessionID string) (*models.MeasurementDataPoint, error) {
+	var point models.MeasurementDataPoint
+	if err := r.db.First(&point, "measurement_id = ? AND session_id = ?", measurementID, sessionID).Error; err != nil {
+		return nil, err
+	}
+	return &point, nil
+}
+
+// Find a session's data points with their session
+func (r *BehaviorMeasurementRepository) FindDataPointsBySessionID(sessionID string) ([]*models.MeasurementDataPoint, error) {
+	var points []*models.MeasurementDataPoint
+	if err := r.db.Preload("Session").Where("session_id = ?", sessionID).Order("measurement_id").Find(&points).Error; err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// Find a measurement's data points with their session, in the order the sessions started.
+// Zero times leave that end of the range open.
+func (r *BehaviorMeasurementRepository) FindDataPointsByMeasurementID(measurementID int, from, to time.Time) ([]*models.MeasurementDataPoint, error) {
+	var points []*models.MeasurementDataPoint
+	query := r.db.Preload("Session").
+		Joins("JOIN sessions ON sessions.id = measurement_data_points.session_id").
+		Where("measurement_data_points.measurement_id = ?", measurementID)
+	if !from.IsZero() {
+		query = query.Where("sessions.start_time >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("sessions.start_time < ?", to)
+	}
+	if err := query.Order("sessions.start_time, measurement_data_points.id").Find(&points).Error; err != nil {
+		return nil, err
+	}
+	return points, nil
+}
+
+// Update a data point
+func (r *BehaviorMeasurementRepository) UpdateDataPoint(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.MeasurementDataPoint{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Delete a data point
+func (r *BehaviorMeasurementRepository) DeleteDataPoint(id int) error {
+	return r.db.Delete(&models.MeasurementDataPoint{}, "id = ?", id).Error
+}
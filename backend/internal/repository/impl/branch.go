@@ -28,26 +28,32 @@ func (r *BranchRepository) Create(branch *models.Branch) error {
 	return r.db.Create(branch).Error
 }
 
-func (r *BranchRepository) Update(id string, updates map[string]interface{}) error {
+func (r *BranchRepository) Update(id int, updates map[string]interface{}) error {
 	return r.db.Model(&models.Branch{}).Where("id = ?", id).Updates(updates).Error
 }
 
-func (r *BranchRepository) GetBranchByID(id string) (*models.Branch, error) {
+func (r *BranchRepository) GetBranchByID(id int) (*models.Branch, error) {
 	var branch models.Branch
 	err := r.db.Where("id = ?", id).First(&branch).Error
 	return &branch, err
 }
 
-func (r *BranchRepository) ListBranches() (*models.Branch, error) {
-	var branch models.Branch
-	err := r.db.Find(&branch).Error
-	return &branch, err
+func (r *BranchRepository) ListBranches() ([]*models.Branch, error) {
+	var branches []*models.Branch
+	err := r.db.Find(&branches).Error
+	return branches, err
 }
 
-func (r *BranchRepository) DeleteBranch(id string) (*models.Branch, error) {
+// DeleteBranch deletes a branch and returns the deleted row
+func (r *BranchRepository) DeleteBranch(id int) (*models.Branch, error) {
 	var branch models.Branch
-	err := r.db.Where("id = ?", id).First(&branch).Error
-	return &branch, err
+	if err := r.db.Where("id = ?", id).First(&branch).Error; err != nil {
+		return nil, err
+	}
+	if err := r.db.Delete(&branch).Error; err != nil {
+		return nil, err
+	}
+	return &branch, nil
 }
 
 // Create new operating hours
@@ -67,7 +73,7 @@ func (r *OperatingHoursRepository) FindByBranchAndDay(branchID int, dayOfWeek in
 // Find all operating hours for a branch
 func (r *OperatingHoursRepository) FindByBranch(branchID int) ([]*models.OperatingHours, error) {
 	var hours []*models.OperatingHours
-	if err := r.db.Where("branch_id = ?", branchID).Find(&hours).Error; err != nil {
+	if err := r.db.Where("branch_id = ?", branchID).Order("day_of_week").Find(&hours).Error; err != nil {
 		return nil, err
 	}
 	return hours, nil
@@ -0,0 +1,53 @@
+package impl
+
+// backend/internal/repository/impl/branch_closure.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type BranchClosureRepository struct {
+	db *gorm.DB
+}
+
+func NewBranchClosureRepository(db *gorm.DB) *BranchClosureRepository {
+	return &BranchClosureRepository{db: db}
+}
+
+// Create a new branch closure
+func (r *BranchClosureRepository) Create(closure *models.BranchClosure) error {
+	return r.db.Create(closure).Error
+}
+
+// Find a branch closure by ID
+func (r *BranchClosureRepository) FindByID(id int) (*models.BranchClosure, error) {
+	var closure models.BranchClosure
+	if err := r.db.First(&closure, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &closure, nil
+}
+
+// Find a branch's closures overlapping the dates from and to, both included, earliest first.
+// Empty dates leave that end open.
+func (r *BranchClosureRepository) FindByBranch(branchID int, from, to string) ([]*models.BranchClosure, error) {
+	var closures []*models.BranchClosure
+	query := r.db.Where("branch_id = ?", branchID)
+	if from != "" {
+		query = query.Where("end_date >= ?", from)
+	}
+	if to != "" {
+		query = query.Where("start_date <= ?", to)
+	}
+	if err := query.Order("start_date, id").Find(&closures).Error; err != nil {
+		return nil, err
+	}
+	return closures, nil
+}
+
+// Delete a branch closure
+func (r *BranchClosureRepository) Delete(id int) error {
+	return r.db.Delete(&models.BranchClosure{}, "id = ?", id).Error
+}
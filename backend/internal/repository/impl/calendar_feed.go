@@ -0,0 +1,63 @@
+package impl
+
+// backend/internal/repository/impl/calendar_feed.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type CalendarFeedRepository struct {
+	db *gorm.DB
+}
+
+func NewCalendarFeedRepository(db *gorm.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+// Create a new calendar feed
+func (r *CalendarFeedRepository) Create(feed *models.CalendarFeed) error {
+	return r.db.Create(feed).Error
+}
+
+// Find a calendar feed by ID
+func (r *CalendarFeedRepository) FindByID(id int) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	if err := r.db.First(&feed, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// Find a calendar feed by the hash of its token, with the staff member who created it
+func (r *CalendarFeedRepository) FindByTokenHash(hash string) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	if err := r.db.Preload("CreatedBy").First(&feed, "token_hash = ?", hash).Error; err != nil {
+		return nil, err
+	}
+	return &feed, nil
+}
+
+// Find the calendar feeds of a staff member, newest first
+func (r *CalendarFeedRepository) FindByStaffID(staffID string) ([]*models.CalendarFeed, error) {
+	var feeds []*models.CalendarFeed
+	if err := r.db.Where("staff_id = ?", staffID).Order("created_at DESC").Find(&feeds).Error; err != nil {
+		return nil, err
+	}
+	return feeds, nil
+}
+
+// Find the calendar feeds of a patient, newest first
+func (r *CalendarFeedRepository) FindByPatientID(patientID string) ([]*models.CalendarFeed, error) {
+	var feeds []*models.CalendarFeed
+	if err := r.db.Where("patient_id = ?", patientID).Order("created_at DESC").Find(&feeds).Error; err != nil {
+		return nil, err
+	}
+	return feeds, nil
+}
+
+// Update a calendar feed
+func (r *CalendarFeedRepository) Update(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.CalendarFeed{}).Where("id = ?", id).Updates(updates).Error
+}
@@ -0,0 +1,58 @@
+package impl
+
+// backend/internal/repository/impl/caseload.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+// caseload returns a subquery selecting the IDs of the patients a viewer may see.
+// Admins and behavioral analysts supervise every patient, so unrestricted is true for them.
+func caseload(db *gorm.DB, viewer *models.Viewer) (subQuery *gorm.DB, unrestricted bool) {
+	if viewer == nil {
+		return nil, true
+	}
+
+	switch viewer.Role {
+	case models.RoleAdmin, models.RoleBehavioralAnalyst:
+		return nil, true
+	case models.RoleTherapist:
+		// Patients assigned to the therapist or that they have had a session with
+		sessions := db.Model(&models.Session{}).Select("patient_id").Where("staff_id = ?", viewer.StaffID)
+		return db.Model(&models.Patient{}).Select("id").
+			Where("staff_id = ? OR id IN (?)", viewer.StaffID, sessions), false
+	case models.RoleDoctor:
+		return db.Model(&models.Patient{}).Select("id").Where("doctor_id = ?", viewer.StaffID), false
+	case models.RoleGuardian:
+		// A guardian viewer's StaffID is their guardian ID
+		return db.Table("patient_guardians").Select("patient_id").Where("guardian_id = ?", viewer.StaffID), false
+	default:
+		// Unknown roles see nothing
+		return db.Model(&models.Patient{}).Select("id").Where("1 = 0"), false
+	}
+}
+
+// checkCaseload returns gorm.ErrRecordNotFound unless the patient is inside the viewer's caseload,
+// so rows can't be written for patients the viewer can't see
+func checkCaseload(db *gorm.DB, viewer *models.Viewer, patientID string) error {
+	var count int64
+	if err := scopeToCaseload(db, viewer, "patients.id").Model(&models.Patient{}).
+		Where("patients.id = ?", patientID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// scopeToCaseload limits a query to rows whose patient column is inside the viewer's caseload
+func scopeToCaseload(db *gorm.DB, viewer *models.Viewer, patientColumn string) *gorm.DB {
+	subQuery, unrestricted := caseload(db, viewer)
+	if unrestricted {
+		return db
+	}
+	return db.Where(patientColumn+" IN (?)", subQuery)
+}
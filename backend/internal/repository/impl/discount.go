@@ -0,0 +1,73 @@
+package impl
+
+// backend/internal/repository/impl/discount.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type DiscountRepository struct {
+	db *gorm.DB
+}
+
+func NewDiscountRepository(db *gorm.DB) *DiscountRepository {
+	return &DiscountRepository{db: db}
+}
+
+// Create a new discount rule
+func (r *DiscountRepository) CreateRule(rule *models.DiscountRule) error {
+	return r.db.Create(rule).Error
+}
+
+// Find a discount rule by ID
+func (r *DiscountRepository) FindRuleByID(id int) (*models.DiscountRule, error) {
+	var rule models.DiscountRule
+	if err := r.db.First(&rule, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// Find every discount rule, or only those of a kind when it isn't empty
+func (r *DiscountRepository) FindRules(kind models.DiscountKind) ([]*models.DiscountRule, error) {
+	var rules []*models.DiscountRule
+	query := r.db
+	if kind != "" {
+		query = query.Where("kind = ?", kind)
+	}
+	if err := query.Order("kind, percent, id").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// Update a discount rule
+func (r *DiscountRepository) UpdateRule(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.DiscountRule{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Find the discount given to a patient, with its rule
+func (r *DiscountRepository) FindByPatientID(patientID string) (*models.PatientDiscount, error) {
+	var discount models.PatientDiscount
+	if err := r.db.Preload("DiscountRule").First(&discount, "patient_id = ?", patientID).Error; err != nil {
+		return nil, err
+	}
+	return &discount, nil
+}
+
+// Give a patient a discount, replacing the one they had
+func (r *DiscountRepository) SetForPatient(discount *models.PatientDiscount) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&models.PatientDiscount{}, "patient_id = ?", discount.PatientID).Error; err != nil {
+			return err
+		}
+		return tx.Create(discount).Error
+	})
+}
+
+// Remove a patient's discount
+func (r *DiscountRepository) DeleteForPatient(patientID string) error {
+	return r.db.Delete(&models.PatientDiscount{}, "patient_id = ?", patientID).Error
+}
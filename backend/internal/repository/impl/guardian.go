@@ -24,9 +24,9 @@ func (r *GuardianRepository) Create(guardian *models.Guardian) error {
 // Find all guardians of a patient
 func (r *GuardianRepository) FindByPatient(patientID string) (*[]models.Guardian, error) {
 	var guardians []models.Guardian
-	subQuery := r.db.Model(&models.Patient{}).Select("guardian_id").Where("id = ?", patientID)
+	subQuery := r.db.Table("patient_guardians").Select("guardian_id").Where("patient_id = ?", patientID)
 
-	err := r.db.Where("id = (?)", subQuery).Find(&guardians).Error
+	err := r.db.Where("id IN (?)", subQuery).Find(&guardians).Error
 	return &guardians, err
 }
 
@@ -36,6 +36,44 @@ func (r *GuardianRepository) FindByID(id string) (*models.Guardian, error) {
 	return &guardian, err
 }
 
+// Find a guardian by email address
+func (r *GuardianRepository) FindByEmail(email string) (*models.Guardian, error) {
+	var guardian models.Guardian
+	if err := r.db.Where("email = ?", email).First(&guardian).Error; err != nil {
+		return nil, err
+	}
+	return &guardian, nil
+}
+
+// Find a guardian by phone number
+func (r *GuardianRepository) FindByPhoneNumber(phoneNumber string) (*models.Guardian, error) {
+	var guardian models.Guardian
+	if err := r.db.Where("phone_number = ?", phoneNumber).First(&guardian).Error; err != nil {
+		return nil, err
+	}
+	return &guardian, nil
+}
+
+// Find all patients a guardian is linked to
+func (r *GuardianRepository) FindChildren(guardianID string) ([]*models.Patient, error) {
+	var patients []*models.Patient
+	subQuery := r.db.Table("patient_guardians").Select("patient_id").Where("guardian_id = ?", guardianID)
+
+	if err := r.db.Where("id IN (?)", subQuery).Order("name").Find(&patients).Error; err != nil {
+		return nil, err
+	}
+	return patients, nil
+}
+
+// IsGuardianOf reports whether a guardian is linked to a patient
+func (r *GuardianRepository) IsGuardianOf(guardianID, patientID string) (bool, error) {
+	var count int64
+	err := r.db.Table("patient_guardians").
+		Where("guardian_id = ? AND patient_id = ?", guardianID, patientID).
+		Count(&count).Error
+	return count > 0, err
+}
+
 // Update guardian information
 func (r *GuardianRepository) Update(id string, updates map[string]interface{}) error {
 	return r.db.Model(&models.Guardian{}).Where("id = ?", id).Updates(updates).Error
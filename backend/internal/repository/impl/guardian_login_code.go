@@ -0,0 +1,75 @@
+package impl
+
+// backend/internal/repository/impl/guardian_login_code.go
+
+import (
+	"time"
+
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type GuardianLoginCodeRepository struct {
+	db *gorm.DB
+}
+
+func NewGuardianLoginCodeRepository(db *gorm.DB) *GuardianLoginCodeRepository {
+	return &GuardianLoginCodeRepository{db: db}
+}
+
+// Create a new login code
+func (r *GuardianLoginCodeRepository) Create(code *models.GuardianLoginCode) error {
+	return r.db.Create(code).Error
+}
+
+// Find the newest unused, unexpired code issued to a guardian
+func (r *GuardianLoginCodeRepository) FindLatestActive(guardianID string) (*models.GuardianLoginCode, error) {
+	var code models.GuardianLoginCode
+	err := r.db.Where("guardian_id = ? AND consumed_at IS NULL AND expires_at > ?", guardianID, time.Now()).
+		Order("created_at DESC").
+		First(&code).Error
+	if err != nil {
+		return nil, err
+	}
+	return &code, nil
+}
+
+// Count the codes issued to a guardian since a time
+func (r *GuardianLoginCodeRepository) CountSince(guardianID string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.GuardianLoginCode{}).
+		Where("guardian_id = ? AND created_at >= ?", guardianID, since).
+		Count(&count).Error
+	return count, err
+}
+
+// Total the failed attempts on the codes issued to a guardian since a time
+func (r *GuardianLoginCodeRepository) SumAttemptsSince(guardianID string, since time.Time) (int, error) {
+	var total int
+	err := r.db.Model(&models.GuardianLoginCode{}).
+		Where("guardian_id = ? AND created_at >= ?", guardianID, since).
+		Select("COALESCE(SUM(attempts), 0)").
+		Scan(&total).Error
+	return total, err
+}
+
+// Record a failed attempt to use a code
+func (r *GuardianLoginCodeRepository) IncrementAttempts(id int) error {
+	return r.db.Model(&models.GuardianLoginCode{}).Where("id = ?", id).
+		UpdateColumn("attempts", gorm.Expr("attempts + 1")).Error
+}
+
+// Mark a code as used so it can't be used again. Returns gorm.ErrRecordNotFound when
+// the code was already used, so only one of two concurrent sign-ins gets it.
+func (r *GuardianLoginCodeRepository) Consume(id int) error {
+	result := r.db.Model(&models.GuardianLoginCode{}).Where("id = ? AND consumed_at IS NULL", id).
+		Update("consumed_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
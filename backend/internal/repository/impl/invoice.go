@@ -0,0 +1,96 @@
+package impl
+
+// backend/internal/repository/impl/invoice.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type InvoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewInvoiceRepository(db *gorm.DB) *InvoiceRepository {
+	return &InvoiceRepository{db: db}
+}
+
+// Create a new invoice with its lines
+func (r *InvoiceRepository) Create(invoice *models.Invoice) error {
+	return r.db.Create(invoice).Error
+}
+
+// Find an invoice by ID, with its lines and payments
+func (r *InvoiceRepository) FindByID(id int) (*models.Invoice, error) {
+	var invoice models.Invoice
+	err := r.db.
+		Preload("Lines", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Payments", func(db *gorm.DB) *gorm.DB { return db.Order("received_at, id") }).
+		First(&invoice, "id = ?", id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// FindForUpdate finds an invoice like FindByID and locks its row until the transaction ends,
+// so payments against it, and voiding it, happen one at a time
+func (r *InvoiceRepository) FindForUpdate(id int) (*models.Invoice, error) {
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").First(&models.Invoice{}, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return r.FindByID(id)
+}
+
+// Find a patient's invoices, newest first
+func (r *InvoiceRepository) FindByPatientID(patientID string) ([]*models.Invoice, error) {
+	var invoices []*models.Invoice
+	if err := r.db.Where("patient_id = ?", patientID).Order("issue_date DESC, id DESC").Find(&invoices).Error; err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// Find the invoices billed to a guardian with one of the statuses, oldest first
+func (r *InvoiceRepository) FindByGuardianID(guardianID string, statuses ...models.InvoiceStatus) ([]*models.Invoice, error) {
+	var invoices []*models.Invoice
+	if err := r.db.Where("guardian_id = ? AND status IN ?", guardianID, statuses).Order("due_date, id").Find(&invoices).Error; err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// Find every invoice with one of the statuses, oldest first
+func (r *InvoiceRepository) FindByStatus(statuses ...models.InvoiceStatus) ([]*models.Invoice, error) {
+	var invoices []*models.Invoice
+	if err := r.db.Where("status IN ?", statuses).Order("due_date, id").Find(&invoices).Error; err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// Find which of the sessions are already on an invoice that isn't void
+func (r *InvoiceRepository) FindInvoicedSessionIDs(sessionIDs []string) ([]string, error) {
+	var invoiced []string
+	if len(sessionIDs) == 0 {
+		return invoiced, nil
+	}
+	err := r.db.Model(&models.InvoiceLine{}).
+		Joins("JOIN invoices ON invoices.id = invoice_lines.invoice_id").
+		Where("invoice_lines.session_id IN ? AND invoices.status <> ?", sessionIDs, models.InvoiceVoid).
+		Pluck("invoice_lines.session_id", &invoiced).Error
+	return invoiced, err
+}
+
+// Update an invoice
+func (r *InvoiceRepository) Update(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.Invoice{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Record a payment against an invoice
+func (r *InvoiceRepository) AddPayment(payment *models.Payment) error {
+	return r.db.Create(payment).Error
+}
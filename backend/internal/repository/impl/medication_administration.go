@@ -0,0 +1,69 @@
+package impl
+
+// backend/internal/repository/impl/medication_administration.go
+
+import (
+	"time"
+
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type MedicationAdministrationRepository struct {
+	db *gorm.DB
+}
+
+func NewMedicationAdministrationRepository(db *gorm.DB) *MedicationAdministrationRepository {
+	return &MedicationAdministrationRepository{db: db}
+}
+
+// Create a new medication administration
+func (r *MedicationAdministrationRepository) Create(administration *models.MedicationAdministration) error {
+	return r.db.Create(administration).Error
+}
+
+// Find a patient's doses given or refused from from until to, earliest first. Zero times
+// leave that end of the range open.
+func (r *MedicationAdministrationRepository) FindByPatientID(patientID string, from, to time.Time) ([]*models.MedicationAdministration, error) {
+	var administrations []*models.MedicationAdministration
+	query := r.db.Where("patient_id = ?", patientID)
+	if !from.IsZero() {
+		query = query.Where("administered_at >= ?", from)
+	}
+	if !to.IsZero() {
+		query = query.Where("administered_at < ?", to)
+	}
+	if err := query.Order("administered_at, id").Find(&administrations).Error; err != nil {
+		return nil, err
+	}
+	return administrations, nil
+}
+
+// Find what was recorded for a medicine's scheduled dose
+func (r *MedicationAdministrationRepository) FindDose(medicineID string, dueAt time.Time) (*models.MedicationAdministration, error) {
+	var administration models.MedicationAdministration
+	if err := r.db.First(&administration, "medicine_id = ? AND due_at = ?", medicineID, dueAt).Error; err != nil {
+		return nil, err
+	}
+	return &administration, nil
+}
+
+// Find what was recorded for the medicines' scheduled doses due from from until to
+func (r *MedicationAdministrationRepository) FindDueBetween(medicineIDs []string, from, to time.Time) ([]*models.MedicationAdministration, error) {
+	var administrations []*models.MedicationAdministration
+	if len(medicineIDs) == 0 {
+		return administrations, nil
+	}
+	if err := r.db.Where("medicine_id IN ? AND due_at >= ? AND due_at < ?", medicineIDs, from, to).Find(&administrations).Error; err != nil {
+		return nil, err
+	}
+	return administrations, nil
+}
+
+// Count the doses given or refused of a medicine
+func (r *MedicationAdministrationRepository) CountByMedicineID(medicineID string) (int64, error) {
+	var count int64
+	err := r.db.Model(&models.MedicationAdministration{}).Where("medicine_id = ?", medicineID).Count(&count).Error
+	return count, err
+}
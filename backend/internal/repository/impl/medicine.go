@@ -48,6 +48,20 @@ func (r *MedicineRepository) FindByPrescriberID(prescriberID string) ([]*models.
 	return medicines, nil
 }
 
+// Find the active medicines of the patients that are taken on a schedule, rather than as needed
+func (r *MedicineRepository) FindScheduled(patientIDs []string) ([]*models.Medicine, error) {
+	var medicines []*models.Medicine
+	if len(patientIDs) == 0 {
+		return medicines, nil
+	}
+	if err := r.db.Where("patient_id IN ? AND active = ? AND frequency IS NOT NULL AND frequency <> ?", patientIDs, true, models.FrequencyAsNeeded).
+		Order("name, id").
+		Find(&medicines).Error; err != nil {
+		return nil, err
+	}
+	return medicines, nil
+}
+
 // Update a medicine
 func (r *MedicineRepository) Update(id string, updates map[string]interface{}) error {
 	return r.db.Model(&models.Medicine{}).Where("id = ?", id).Updates(updates).Error
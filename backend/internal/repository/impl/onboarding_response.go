@@ -43,10 +43,33 @@ func (r *OnboardingResponseRepository) FindByPatientID(patientID string) ([]*mod
 	return responses, nil
 }
 
-// Find onboarding responses by question text
-func (r *OnboardingResponseRepository) FindByPatientAndQuestion(patientID string, questionText string) ([]*models.OnboardingResponse, error) {
+// Find a patient's responses to a question
+func (r *OnboardingResponseRepository) FindByPatientAndQuestion(patientID string, questionID int) ([]*models.OnboardingResponse, error) {
 	var responses []*models.OnboardingResponse
-	if err := r.db.Where("patient_id = ? AND question_text = ?", patientID, questionText).Find(&responses).Error; err != nil {
+	if err := r.db.Where("patient_id = ? AND question_id = ?", patientID, questionID).Find(&responses).Error; err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// Find a patient's responses to the questions of an assessment, oldest first
+func (r *OnboardingResponseRepository) FindByPatientAndAssessment(patientID string, assessmentID int) ([]*models.OnboardingResponse, error) {
+	var responses []*models.OnboardingResponse
+	err := r.db.
+		Joins("JOIN onboarding_questions ON onboarding_questions.id = onboarding_responses.question_id").
+		Where("onboarding_responses.patient_id = ? AND onboarding_questions.assessment_id = ?", patientID, assessmentID).
+		Order("onboarding_responses.response_date, onboarding_responses.id").
+		Find(&responses).Error
+	if err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// Find the responses recorded during an administration, oldest first
+func (r *OnboardingResponseRepository) FindByAdministrationID(administrationID int) ([]*models.OnboardingResponse, error) {
+	var responses []*models.OnboardingResponse
+	if err := r.db.Where("administration_id = ?", administrationID).Order("response_date, id").Find(&responses).Error; err != nil {
 		return nil, err
 	}
 	return responses, nil
@@ -62,22 +85,23 @@ func (r *OnboardingResponseRepository) Delete(id int) error {
 	return r.db.Delete(&models.OnboardingResponse{}, "id = ?", id).Error
 }
 
-// CreateInitialOnboardingResponses creates initial placeholder responses for a new patient
-// This is useful when a new patient is registered and needs to go through the onboarding process
-func (r *OnboardingResponseRepository) CreateInitialOnboardingResponses(patientID string, staffID string, assessmentID int) error {
-	// Get all questions for the selected assessment
+// CreateInitialOnboardingResponses creates an empty response to every active question of the
+// assessment being administered, for staff to fill in as they go through it
+func (r *OnboardingResponseRepository) CreateInitialOnboardingResponses(administration *models.AssessmentAdministration) error {
+	// Get the active questions for the selected assessment
 	var questions []*models.OnboardingQuestion
-	if err := r.db.Where("assessment_id = ?", assessmentID).Find(&questions).Error; err != nil {
+	if err := r.db.Where("assessment_id = ? AND retired = ?", administration.AssessmentID, false).Order("position").Find(&questions).Error; err != nil {
 		return err
 	}
 
 	// Create a response entry for each question
 	for _, question := range questions {
 		response := models.OnboardingResponse{
-			QuestionText: question.Text,
-			PatientID:    patientID,
-			StaffID:      staffID,
-			ResponseDate: time.Now(),
+			AdministrationID: &administration.ID,
+			QuestionID:       question.ID,
+			PatientID:        administration.PatientID,
+			StaffID:          administration.AdministeredBy,
+			ResponseDate:     time.Now(),
 		}
 
 		if err := r.db.Create(&response).Error; err != nil {
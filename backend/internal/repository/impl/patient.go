@@ -6,25 +6,50 @@ import (
 	"palaam/internal/models"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PatientRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	viewer *models.Viewer
 }
 
 func NewPatientRepository(db *gorm.DB) *PatientRepository {
 	return &PatientRepository{db: db}
 }
 
+// WithViewer returns a copy of the repository that only sees the viewer's caseload
+func (r *PatientRepository) WithViewer(viewer *models.Viewer) *PatientRepository {
+	return &PatientRepository{db: r.db, viewer: viewer}
+}
+
+// scoped starts a query limited to the viewer's caseload
+func (r *PatientRepository) scoped() *gorm.DB {
+	return scopeToCaseload(r.db, r.viewer, "patients.id")
+}
+
 // Create a new patient
 func (r *PatientRepository) Create(patient *models.Patient) error {
 	return r.db.Create(patient).Error
 }
 
+// List patients with pagination, returning the page and the total count
+func (r *PatientRepository) List(limit, offset int) ([]*models.Patient, int64, error) {
+	var patients []*models.Patient
+	var total int64
+	if err := r.scoped().Model(&models.Patient{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := r.scoped().Order("name").Limit(limit).Offset(offset).Find(&patients).Error; err != nil {
+		return nil, 0, err
+	}
+	return patients, total, nil
+}
+
 // Find a patient by ID
 func (r *PatientRepository) FindByID(id string) (*models.Patient, error) {
 	var patient models.Patient
-	if err := r.db.First(&patient, "id = ?", id).Error; err != nil {
+	if err := r.scoped().First(&patient, "patients.id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &patient, nil
@@ -33,18 +58,32 @@ func (r *PatientRepository) FindByID(id string) (*models.Patient, error) {
 // FindByName finds patients by name (partial match)
 func (r *PatientRepository) FindByName(name string) ([]*models.Patient, error) {
 	var patients []*models.Patient
-	if err := r.db.Where("name LIKE ?", "%"+name+"%").Find(&patients).Error; err != nil {
+	if err := r.scoped().Where(ilike("name"), containsPattern(name)).Find(&patients).Error; err != nil {
 		return nil, err
 	}
 	return patients, nil
 }
 
+// Lock a patient's row until the transaction ends, so writes that depend on what the patient
+// already has, such as invoicing their sessions, happen one at a time
+func (r *PatientRepository) Lock(id string) error {
+	return r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id").First(&models.Patient{}, "id = ?", id).Error
+}
+
 // Update a patient
 func (r *PatientRepository) Update(id string, updates map[string]interface{}) error {
+	// Check visibility first; MySQL can't update a table filtered by a subquery on itself
+	if _, err := r.FindByID(id); err != nil {
+		return err
+	}
 	return r.db.Model(&models.Patient{}).Where("id = ?", id).Updates(updates).Error
 }
 
 // Delete a patient
 func (r *PatientRepository) Delete(id string) error {
+	if _, err := r.FindByID(id); err != nil {
+		return err
+	}
 	return r.db.Delete(&models.Patient{}, "id = ?", id).Error
 }
@@ -0,0 +1,45 @@
+package impl
+
+// backend/internal/repository/impl/prescription_event.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type PrescriptionEventRepository struct {
+	db *gorm.DB
+}
+
+func NewPrescriptionEventRepository(db *gorm.DB) *PrescriptionEventRepository {
+	return &PrescriptionEventRepository{db: db}
+}
+
+// Create a new prescription event
+func (r *PrescriptionEventRepository) Create(event *models.PrescriptionEvent) error {
+	return r.db.Create(event).Error
+}
+
+// Find the latest event of a medicine
+func (r *PrescriptionEventRepository) FindLatest(medicineID string) (*models.PrescriptionEvent, error) {
+	var event models.PrescriptionEvent
+	if err := r.db.Where("medicine_id = ?", medicineID).Order("version DESC").First(&event).Error; err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
+
+// Find the events of all of a patient's medicines, earliest first
+func (r *PrescriptionEventRepository) FindByPatientID(patientID string) ([]*models.PrescriptionEvent, error) {
+	var events []*models.PrescriptionEvent
+	if err := r.db.Where("patient_id = ?", patientID).Order("created_at, id").Find(&events).Error; err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// Delete the events of a medicine
+func (r *PrescriptionEventRepository) DeleteByMedicineID(medicineID string) error {
+	return r.db.Delete(&models.PrescriptionEvent{}, "medicine_id = ?", medicineID).Error
+}
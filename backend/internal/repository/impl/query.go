@@ -0,0 +1,20 @@
+package impl
+
+// backend/internal/repository/impl/query.go
+
+import "strings"
+
+// likeEscaper escapes LIKE wildcards with '!', which needs no escaping itself in
+// any supported database's string literals, unlike a backslash in MySQL
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// containsPattern returns a LIKE pattern matching values that contain text
+func containsPattern(text string) string {
+	return "%" + likeEscaper.Replace(text) + "%"
+}
+
+// ilike returns a case-insensitive LIKE condition on column. MySQL's default collation
+// ignores case, but PostgreSQL's LIKE doesn't and SQLite's only does for ASCII.
+func ilike(column string) string {
+	return "LOWER(" + column + ") LIKE LOWER(?) ESCAPE '!'"
+}
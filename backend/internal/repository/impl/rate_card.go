@@ -0,0 +1,56 @@
+package impl
+
+// backend/internal/repository/impl/rate_card.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type RateCardRepository struct {
+	db *gorm.DB
+}
+
+func NewRateCardRepository(db *gorm.DB) *RateCardRepository {
+	return &RateCardRepository{db: db}
+}
+
+// Create a new rate card
+func (r *RateCardRepository) Create(card *models.RateCard) error {
+	return r.db.Create(card).Error
+}
+
+// Find a rate card by ID
+func (r *RateCardRepository) FindByID(id int) (*models.RateCard, error) {
+	var card models.RateCard
+	if err := r.db.First(&card, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+// Find every rate card, or only a therapy type's when it isn't empty
+func (r *RateCardRepository) FindAll(therapyType string) ([]*models.RateCard, error) {
+	var cards []*models.RateCard
+	query := r.db
+	if therapyType != "" {
+		query = query.Where("therapy_type = ?", therapyType)
+	}
+	if err := query.Order("therapy_type, branch_id, min_minutes, effective_from").Find(&cards).Error; err != nil {
+		return nil, err
+	}
+	return cards, nil
+}
+
+// IsInvoiced reports whether a rate card priced a line on any invoice
+func (r *RateCardRepository) IsInvoiced(id int) (bool, error) {
+	var count int64
+	err := r.db.Model(&models.InvoiceLine{}).Where("rate_card_id = ?", id).Count(&count).Error
+	return count > 0, err
+}
+
+// Delete a rate card
+func (r *RateCardRepository) Delete(id int) error {
+	return r.db.Delete(&models.RateCard{}, "id = ?", id).Error
+}
@@ -11,7 +11,8 @@ import (
 )
 
 type SessionRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	viewer *models.Viewer
 }
 
 // NewSessionRepository creates a new instance of SessionRepository
@@ -19,15 +20,44 @@ func NewSessionRepository(db *gorm.DB) *SessionRepository {
 	return &SessionRepository{db: db}
 }
 
-// Create a new session
+// WithViewer returns a copy of the repository that only sees sessions of the viewer's caseload
+func (r *SessionRepository) WithViewer(viewer *models.Viewer) *SessionRepository {
+	return &SessionRepository{db: r.db, viewer: viewer}
+}
+
+// scoped starts a query limited to sessions of the viewer's caseload
+func (r *SessionRepository) scoped() *gorm.DB {
+	return scopeToCaseload(r.db, r.viewer, "sessions.patient_id")
+}
+
+// Create a new session for a patient of the viewer's caseload. Therapists' sessions are their own.
 func (r *SessionRepository) Create(session *models.Session) error {
+	if err := checkCaseload(r.db, r.viewer, session.PatientID); err != nil {
+		return err
+	}
+	if staffID := r.viewer.OwnStaffID(); staffID != "" {
+		session.StaffID = staffID
+	}
 	return r.db.Create(session).Error
 }
 
+// List sessions with pagination, newest first, returning the page and the total count
+func (r *SessionRepository) List(limit, offset int) ([]*models.Session, int64, error) {
+	var sessions []*models.Session
+	var total int64
+	if err := r.scoped().Model(&models.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := r.scoped().Order("start_time DESC").Limit(limit).Offset(offset).Find(&sessions).Error; err != nil {
+		return nil, 0, err
+	}
+	return sessions, total, nil
+}
+
 // Find a session by ID
 func (r *SessionRepository) FindByID(id string) (*models.Session, error) {
 	var session models.Session
-	if err := r.db.First(&session, "id = ?", id).Error; err != nil {
+	if err := r.scoped().First(&session, "sessions.id = ?", id).Error; err != nil {
 		return nil, err
 	}
 	return &session, nil
@@ -36,7 +66,32 @@ func (r *SessionRepository) FindByID(id string) (*models.Session, error) {
 // Find sessions by date range
 func (r *SessionRepository) FindByDateRange(branchId int, startDate, endDate time.Time) ([]*models.Session, error) {
 	var sessions []*models.Session
-	if err := r.db.Where("branch_id = ? AND start_time >= ? AND end_time <= ?", branchId, startDate, endDate).Find(&sessions).Error; err != nil {
+	if err := r.scoped().Where("branch_id = ? AND start_time >= ? AND end_time <= ?", branchId, startDate, endDate).Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Find the sessions starting from from until before to, earliest first. An empty staffID finds every staff member's.
+func (r *SessionRepository) FindStartingBetween(staffID string, from, to time.Time) ([]*models.Session, error) {
+	var sessions []*models.Session
+	query := r.scoped().Where("start_time >= ? AND start_time < ?", from, to)
+	if staffID != "" {
+		query = query.Where("staff_id = ?", staffID)
+	}
+	if err := query.Order("start_time").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Find a patient's sessions starting from from until to, earliest first
+func (r *SessionRepository) FindByPatientBetween(patientID string, from, to time.Time) ([]*models.Session, error) {
+	var sessions []*models.Session
+	if err := r.scoped().
+		Where("patient_id = ? AND start_time >= ? AND start_time < ?", patientID, from, to).
+		Order("start_time").
+		Find(&sessions).Error; err != nil {
 		return nil, err
 	}
 	return sessions, nil
@@ -45,23 +100,80 @@ func (r *SessionRepository) FindByDateRange(branchId int, startDate, endDate tim
 // Find sessions by PatientID
 func (r *SessionRepository) FindByPatientID(patientID string) ([]*models.Session, error) {
 	var sessions []*models.Session
-	if err := r.db.Where("patient_id = ?", patientID).Find(&sessions).Error; err != nil {
+	if err := r.scoped().Where("patient_id = ?", patientID).Find(&sessions).Error; err != nil {
 		return nil, err
 	}
 	return sessions, nil
 }
 
+// Find the sessions generated from a series, earliest occurrence first
+func (r *SessionRepository) FindBySeriesID(seriesID string) ([]*models.Session, error) {
+	var sessions []*models.Session
+	if err := r.scoped().Where("series_id = ?", seriesID).Order("occurs_at, start_time").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// Find the sessions flagged by a branch closure, earliest first
+func (r *SessionRepository) FindByClosureID(closureID int) ([]*models.Session, error) {
+	var sessions []*models.Session
+	if err := r.scoped().Where("closure_id = ?", closureID).Order("start_time").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// FlagClosure flags the sessions of a branch overlapping from to to with a closure.
+// Every session of the branch is flagged, whatever the viewer's caseload.
+func (r *SessionRepository) FlagClosure(closureID, branchID int, from, to time.Time) error {
+	return r.db.Model(&models.Session{}).
+		Where("branch_id = ? AND start_time < ? AND end_time > ?", branchID, to, from).
+		Update("closure_id", closureID).Error
+}
+
+// ClearClosure removes a closure's flag from its sessions
+func (r *SessionRepository) ClearClosure(closureID int) error {
+	return r.db.Model(&models.Session{}).Where("closure_id = ?", closureID).Update("closure_id", nil).Error
+}
+
+// SetPaymentReceived sets whether the sessions are paid, or clears it when paid is nil.
+// It's derived from invoices, so it's set whatever the viewer's caseload.
+func (r *SessionRepository) SetPaymentReceived(sessionIDs []string, paid *bool) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+	return r.db.Model(&models.Session{}).Where("id IN ?", sessionIDs).Update("payment_received", paid).Error
+}
+
 // Find sessions by StaffID
 func (r *SessionRepository) FindByStaffID(staffID string) ([]*models.Session, error) {
 	var sessions []*models.Session
-	if err := r.db.Where("staff_id = ?", staffID).Find(&sessions).Error; err != nil {
+	if err := r.scoped().Where("staff_id = ?", staffID).Find(&sessions).Error; err != nil {
 		return nil, err
 	}
 	return sessions, nil
 }
 
-// Update a session
+// Update a session. It can only be moved to a patient of the viewer's caseload, and
+// therapists can't hand their sessions to someone else.
 func (r *SessionRepository) Update(id string, updates map[string]interface{}) (*models.Session, error) {
+	// Check visibility first; MySQL can't update a table filtered by a subquery on itself
+	if _, err := r.FindByID(id); err != nil {
+		return nil, err
+	}
+	if patientID, ok := updates["patient_id"]; ok {
+		patientID, _ := patientID.(string)
+		if err := checkCaseload(r.db, r.viewer, patientID); err != nil {
+			return nil, err
+		}
+	}
+	if staffID := r.viewer.OwnStaffID(); staffID != "" {
+		if _, ok := updates["staff_id"]; ok {
+			updates["staff_id"] = staffID
+		}
+	}
+
 	var session models.Session
 	if err := r.db.Model(&session).Where("id = ?", id).Updates(updates).Error; err != nil {
 		return nil, err
@@ -71,10 +183,14 @@ func (r *SessionRepository) Update(id string, updates map[string]interface{}) (*
 
 // Delete a session
 func (r *SessionRepository) Delete(id string) error {
+	if _, err := r.FindByID(id); err != nil {
+		return err
+	}
 	return r.db.Delete(&models.Session{}, "id = ?", id).Error
 }
 
-// CheckOverlappingSessions checks if there are any sessions overlapping with the given time range for a specific staff
+// CheckOverlappingSessions checks if there are any sessions overlapping with the given time range for a specific staff.
+// It deliberately ignores the viewer's caseload so conflicts with other patients are still found.
 func (r *SessionRepository) CheckOverlappingSessions(staffID string, startTime, endTime time.Time, excludeSessionID string) (bool, error) {
 	var count int64
 	query := r.db.Model(&models.Session{}).
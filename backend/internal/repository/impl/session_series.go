@@ -0,0 +1,94 @@
+package impl
+
+// backend/internal/repository/impl/session_series.go
+
+import (
+	"time"
+
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SessionSeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionSeriesRepository(db *gorm.DB) *SessionSeriesRepository {
+	return &SessionSeriesRepository{db: db}
+}
+
+// Create a new session series
+func (r *SessionSeriesRepository) Create(series *models.SessionSeries) error {
+	return r.db.Create(series).Error
+}
+
+// Find a session series by ID
+func (r *SessionSeriesRepository) FindByID(id string) (*models.SessionSeries, error) {
+	var series models.SessionSeries
+	if err := r.db.First(&series, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// FindForUpdate finds a session series like FindByID and locks its row until the transaction
+// ends, so two servers extending it don't both generate its sessions
+func (r *SessionSeriesRepository) FindForUpdate(id string) (*models.SessionSeries, error) {
+	var series models.SessionSeries
+	if err := r.db.Clauses(clause.Locking{Strength: "UPDATE"}).First(&series, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// Find a patient's session series, earliest first
+func (r *SessionSeriesRepository) FindByPatientID(patientID string) ([]*models.SessionSeries, error) {
+	var series []*models.SessionSeries
+	if err := r.db.Where("patient_id = ?", patientID).Order("start_time").Find(&series).Error; err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// Find the series whose sessions haven't been generated up to the given time
+func (r *SessionSeriesRepository) FindGeneratedBefore(until time.Time) ([]*models.SessionSeries, error) {
+	var series []*models.SessionSeries
+	if err := r.db.Where("generated_until < ?", until).Find(&series).Error; err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// Update a session series
+func (r *SessionSeriesRepository) Update(id string, updates map[string]interface{}) error {
+	return r.db.Model(&models.SessionSeries{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Delete a session series along with its exceptions
+func (r *SessionSeriesRepository) Delete(id string) error {
+	if err := r.db.Delete(&models.SessionSeriesException{}, "series_id = ?", id).Error; err != nil {
+		return err
+	}
+	return r.db.Delete(&models.SessionSeries{}, "id = ?", id).Error
+}
+
+// AddException records an occurrence that was cancelled on its own
+func (r *SessionSeriesRepository) AddException(exception *models.SessionSeriesException) error {
+	return r.db.Create(exception).Error
+}
+
+// Find the occurrences of a series that were cancelled on their own
+func (r *SessionSeriesRepository) FindExceptions(seriesID string) ([]*models.SessionSeriesException, error) {
+	var exceptions []*models.SessionSeriesException
+	if err := r.db.Where("series_id = ?", seriesID).Order("occurs_at").Find(&exceptions).Error; err != nil {
+		return nil, err
+	}
+	return exceptions, nil
+}
+
+// Delete the exceptions of a series from the given occurrence on
+func (r *SessionSeriesRepository) DeleteExceptionsFrom(seriesID string, from time.Time) error {
+	return r.db.Delete(&models.SessionSeriesException{}, "series_id = ? AND occurs_at >= ?", seriesID, from).Error
+}
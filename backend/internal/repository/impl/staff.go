@@ -30,6 +30,24 @@ func (r *StaffRepository) FindByID(id string) (*models.Staff, error) {
 	return &staff, nil
 }
 
+// Find a staff member by sign-in email
+func (r *StaffRepository) FindByEmail(email string) (*models.Staff, error) {
+	var staff models.Staff
+	if err := r.db.First(&staff, "email = ?", email).Error; err != nil {
+		return nil, err
+	}
+	return &staff, nil
+}
+
+// Find every staff member, by name
+func (r *StaffRepository) FindAll() ([]*models.Staff, error) {
+	var staff []*models.Staff
+	if err := r.db.Order("name, id").Find(&staff).Error; err != nil {
+		return nil, err
+	}
+	return staff, nil
+}
+
 // Find staff members by role
 func (r *StaffRepository) FindByRole(role models.StaffRole) ([]*models.Staff, error) {
 	var staff []*models.Staff
@@ -0,0 +1,79 @@
+package impl
+
+// backend/internal/repository/impl/tax_invoice.go
+
+import (
+	"time"
+
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TaxInvoiceRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxInvoiceRepository(db *gorm.DB) *TaxInvoiceRepository {
+	return &TaxInvoiceRepository{db: db}
+}
+
+// Create a new tax invoice
+func (r *TaxInvoiceRepository) Create(taxInvoice *models.TaxInvoice) error {
+	return r.db.Create(taxInvoice).Error
+}
+
+// Find the tax invoice issued for an invoice, with its PDF
+func (r *TaxInvoiceRepository) FindByInvoiceID(invoiceID int) (*models.TaxInvoice, error) {
+	var taxInvoice models.TaxInvoice
+	if err := r.db.First(&taxInvoice, "invoice_id = ?", invoiceID).Error; err != nil {
+		return nil, err
+	}
+	return &taxInvoice, nil
+}
+
+// Find a branch's tax invoices for a financial year in number order, without their PDFs
+func (r *TaxInvoiceRepository) FindByBranch(branchID int, financialYear string) ([]*models.TaxInvoice, error) {
+	var taxInvoices []*models.TaxInvoice
+	err := r.db.Omit("pdf", "details").
+		Where("branch_id = ? AND financial_year = ?", branchID, financialYear).
+		Order("sequence_number").
+		Find(&taxInvoices).Error
+	if err != nil {
+		return nil, err
+	}
+	return taxInvoices, nil
+}
+
+// NextNumber takes the next number in a branch's sequence for a financial year. It must run
+// in the transaction that saves the tax invoice, so a rollback gives the number back.
+func (r *TaxInvoiceRepository) NextNumber(branchID int, financialYear string) (int, error) {
+	// Start the sequence if it's the year's first number. Concurrent transactions starting it
+	// together insert one row between them, then wait for each other on the lock below.
+	err := r.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&models.TaxInvoiceSequence{BranchID: branchID, FinancialYear: financialYear}).Error
+	if err != nil {
+		return 0, err
+	}
+
+	var sequence models.TaxInvoiceSequence
+	err = r.db.Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&sequence, "branch_id = ? AND financial_year = ?", branchID, financialYear).Error
+	if err != nil {
+		return 0, err
+	}
+
+	err = r.db.Model(&models.TaxInvoiceSequence{}).
+		Where("branch_id = ? AND financial_year = ?", branchID, financialYear).
+		Update("last_number", sequence.LastNumber+1).Error
+	return sequence.LastNumber + 1, err
+}
+
+// RecordReprint counts a reprint of a tax invoice
+func (r *TaxInvoiceRepository) RecordReprint(id int, at time.Time) error {
+	return r.db.Model(&models.TaxInvoice{}).Where("id = ?", id).Updates(map[string]interface{}{
+		"reprints":          gorm.Expr("reprints + 1"),
+		"last_reprinted_at": at,
+	}).Error
+}
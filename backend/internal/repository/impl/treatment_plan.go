@@ -0,0 +1,134 @@
+package impl
+
+// backend/internal/repository/impl/treatment_plan.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TreatmentPlanRepository struct {
+	db *gorm.DB
+}
+
+func NewTreatmentPlanRepository(db *gorm.DB) *TreatmentPlanRepository {
+	return &TreatmentPlanRepository{db: db}
+}
+
+// withGoals loads plans with their goals and targets, oldest first
+func (r *TreatmentPlanRepository) withGoals() *gorm.DB {
+	return r.db.
+		Preload("Goals", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).
+		Preload("Goals.Targets", func(db *gorm.DB) *gorm.DB { return db.Order("id") })
+}
+
+// Create a new treatment plan, without its goals
+func (r *TreatmentPlanRepository) Create(plan *models.TreatmentPlan) error {
+	return r.db.Omit(clause.Associations).Create(plan).Error
+}
+
+// Find a treatment plan by ID, with its goals and targets
+func (r *TreatmentPlanRepository) FindByID(id int) (*models.TreatmentPlan, error) {
+	var plan models.TreatmentPlan
+	if err := r.withGoals().First(&plan, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// Find a patient's treatment plans with their goals and targets, newest first
+func (r *TreatmentPlanRepository) FindByPatientID(patientID string) ([]*models.TreatmentPlan, error) {
+	var plans []*models.TreatmentPlan
+	if err := r.withGoals().Where("patient_id = ?", patientID).Order("start_date DESC, id DESC").Find(&plans).Error; err != nil {
+		return nil, err
+	}
+	return plans, nil
+}
+
+// Find a patient's active treatment plan
+func (r *TreatmentPlanRepository) FindActive(patientID string) (*models.TreatmentPlan, error) {
+	var plan models.TreatmentPlan
+	if err := r.db.Where("patient_id = ? AND status = ?", patientID, models.PlanActive).First(&plan).Error; err != nil {
+		return nil, err
+	}
+	return &plan, nil
+}
+
+// Update a treatment plan
+func (r *TreatmentPlanRepository) Update(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.TreatmentPlan{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Delete a treatment plan with its goals and targets
+func (r *TreatmentPlanRepository) Delete(id int) error {
+	if err := r.db.Delete(&models.TreatmentTarget{}, "plan_id = ?", id).Error; err != nil {
+		return err
+	}
+	if err := r.db.Delete(&models.TreatmentGoal{}, "plan_id = ?", id).Error; err != nil {
+		return err
+	}
+	return r.db.Delete(&models.TreatmentPlan{}, "id = ?", id).Error
+}
+
+// Create a new goal, without its targets
+func (r *TreatmentPlanRepository) CreateGoal(goal *models.TreatmentGoal) error {
+	return r.db.Omit(clause.Associations).Create(goal).Error
+}
+
+// Find a goal by ID, with its targets
+func (r *TreatmentPlanRepository) FindGoalByID(id int) (*models.TreatmentGoal, error) {
+	var goal models.TreatmentGoal
+	if err := r.db.Preload("Targets", func(db *gorm.DB) *gorm.DB { return db.Order("id") }).First(&goal, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &goal, nil
+}
+
+// Update a goal
+func (r *TreatmentPlanRepository) UpdateGoal(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.TreatmentGoal{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Delete a goal with its targets
+func (r *TreatmentPlanRepository) DeleteGoal(id int) error {
+	if err := r.db.Delete(&models.TreatmentTarget{}, "goal_id = ?", id).Error; err != nil {
+		return err
+	}
+	return r.db.Delete(&models.TreatmentGoal{}, "id = ?", id).Error
+}
+
+// Create a new target
+func (r *TreatmentPlanRepository) CreateTarget(target *models.TreatmentTarget) error {
+	return r.db.Create(target).Error
+}
+
+// Find a target by ID
+func (r *TreatmentPlanRepository) FindTargetByID(id int) (*models.TreatmentTarget, error) {
+	var target models.TreatmentTarget
+	if err := r.db.First(&target, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &target, nil
+}
+
+// Update a target
+func (r *TreatmentPlanRepository) UpdateTarget(id int, updates map[string]interface{}) error {
+	return r.db.Model(&models.TreatmentTarget{}).Where("id = ?", id).Updates(updates).Error
+}
+
+// Delete a target
+func (r *TreatmentPlanRepository) DeleteTarget(id int) error {
+	return r.db.Delete(&models.TreatmentTarget{}, "id = ?", id).Error
+}
+
+// IsPractised reports whether any activity practised one of the targets
+func (r *TreatmentPlanRepository) IsPractised(targetIDs []int) (bool, error) {
+	if len(targetIDs) == 0 {
+		return false, nil
+	}
+	var count int64
+	err := r.db.Model(&models.Activity{}).Where("target_id IN ?", targetIDs).Count(&count).Error
+	return count > 0, err
+}
@@ -0,0 +1,65 @@
+package impl
+
+// backend/internal/repository/impl/trial.go
+
+import (
+	"palaam/internal/models"
+
+	"gorm.io/gorm"
+)
+
+type TrialRepository struct {
+	db *gorm.DB
+}
+
+func NewTrialRepository(db *gorm.DB) *TrialRepository {
+	return &TrialRepository{db: db}
+}
+
+// Create new trials
+func (r *TrialRepository) Create(trials []*models.Trial) error {
+	return r.db.Create(trials).Error
+}
+
+// Find a trial by ID
+func (r *TrialRepository) FindByID(id int) (*models.Trial, error) {
+	var trial models.Trial
+	if err := r.db.First(&trial, "id = ?", id).Error; err != nil {
+		return nil, err
+	}
+	return &trial, nil
+}
+
+// Find an activity's trials in the order they were run
+func (r *TrialRepository) FindByActivityID(activityID string) ([]*models.Trial, error) {
+	var trials []*models.Trial
+	if err := r.db.Where("activity_id = ?", activityID).Order("number").Find(&trials).Error; err != nil {
+		return nil, err
+	}
+	return trials, nil
+}
+
+// Find the trials of every activity that practised a target, with their activity and session
+func (r *TrialRepository) FindByTargetID(targetID int) ([]*models.Trial, error) {
+	var trials []*models.Trial
+	activities := r.db.Model(&models.Activity{}).Select("id").Where("target_id = ?", targetID)
+	if err := r.db.Preload("Activity.Session").
+		Where("activity_id IN (?)", activities).
+		Order("id").
+		Find(&trials).Error; err != nil {
+		return nil, err
+	}
+	return trials, nil
+}
+
+// LastNumber finds the number of an activity's last trial, or 0 if it has none
+func (r *TrialRepository) LastNumber(activityID string) (int, error) {
+	var number int
+	err := r.db.Model(&models.Trial{}).Select("COALESCE(MAX(number), 0)").Where("activity_id = ?", activityID).Scan(&number).Error
+	return number, err
+}
+
+// Delete a trial
+func (r *TrialRepository) Delete(id int) error {
+	return r.db.Delete(&models.Trial{}, "id = ?", id).Error
+}
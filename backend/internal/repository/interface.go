@@ -8,33 +8,49 @@ import (
 )
 
 type Repository struct {
-	Assessment         AssessmentRepository
-	OperatingHours     OperatingHoursRepository
-	Staff              StaffRepository
-	Activity           ActivityRepository
-	Session            SessionRepository
-	Patient            PatientRepository
-	Guardian           GuardianRepository
-	OnboardingQuestion OnboardingQuestionRepository
-	OnboardingResponse OnboardingResponseRepository
-	Medicine           MedicineRepository
-	Branch             BranchRepository
+	db     *gorm.DB
+	viewer *models.Viewer
+
+	Assessment               AssessmentRepository
+	AssessmentAdministration AssessmentAdministrationRepository
+	OperatingHours           OperatingHoursRepository
+	Staff                    StaffRepository
+	Activity                 ActivityRepository
+	Session                  SessionRepository
+	SessionSeries            SessionSeriesRepository
+	Patient                  PatientRepository
+	Guardian                 GuardianRepository
+	GuardianLoginCode        GuardianLoginCodeRepository
+	AuditLog                 AuditLogRepository
+	OnboardingQuestion       OnboardingQuestionRepository
+	OnboardingResponse       OnboardingResponseRepository
+	Medicine                 MedicineRepository
+	Branch                   BranchRepository
+	BranchClosure            BranchClosureRepository
+	CalendarFeed             CalendarFeedRepository
+	Invoice                  InvoiceRepository
+	RateCard                 RateCardRepository
+	Discount                 DiscountRepository
+	TaxInvoice               TaxInvoiceRepository
+	TreatmentPlan            TreatmentPlanRepository
+	Trial                    TrialRepository
+	BehaviorIncident         BehaviorIncidentRepository
+	BehaviorMeasurement      BehaviorMeasurementRepository
+	MedicationAdministration MedicationAdministrationRepository
+	PrescriptionEvent        PrescriptionEventRepository
 }
 
 // AssessmentRepository defines the interface for assessment repository operations
 type AssessmentRepository interface {
-	NewAssessmentRepository(db *gorm.DB) *AssessmentRepository
 	Create(assessment *models.Assessment) error
 	FindByID(id int) (*models.Assessment, error)
 	FindByName(name string) (*models.Assessment, error)
 	GetAll() ([]*models.Assessment, error)
 	Update(id int, updates map[string]interface{}) error
 	Delete(id int) error
-	EnsureDefaultAssessments() error
 }
 
 type OperatingHoursRepository interface {
-	NewOperatingHoursRepository(db *gorm.DB) *OperatingHoursRepository
 	Create(hours *models.OperatingHours) error
 	FindByBranchAndDay(branchID int, dayOfWeek int16) (*models.OperatingHours, error)
 	FindByBranch(branchID int) ([]*models.OperatingHours, error)
@@ -43,88 +59,255 @@ type OperatingHoursRepository interface {
 }
 
 type StaffRepository interface {
-	NewStaffRepository(db *gorm.DB) *StaffRepository
 	Create(staff *models.Staff) error
 	FindByID(id string) (*models.Staff, error)
+	FindByEmail(email string) (*models.Staff, error)
+	FindAll() ([]*models.Staff, error)
 	FindByRole(role models.StaffRole) ([]*models.Staff, error)
 	Update(id string, updates map[string]interface{}) error
 	Delete(id string) error
 }
 
 type ActivityRepository interface {
-	NewActivityRepository(db *gorm.DB) *ActivityRepository
 	Create(activity *models.Activity) error
 	FindByID(id string) (*models.Activity, error)
 	FindBySessionID(name string) ([]*models.Activity, error)
+	FindBySessionIDs(sessionIDs []string) ([]*models.Activity, error)
+	CountBySessionIDs(sessionIDs []string) (map[string]int64, error)
 	Update(id string, updates map[string]interface{}) error
 	Delete(id string) error
 }
 
 type SessionRepository interface {
 	Create(session *models.Session) error
+	List(limit, offset int) ([]*models.Session, int64, error)
 	FindByID(id string) (*models.Session, error)
 	FindByDateRange(branchID int, startDate, endDate time.Time) ([]*models.Session, error)
 	FindByPatientID(patientID string) ([]*models.Session, error)
 	FindByStaffID(staffID string) ([]*models.Session, error)
+	FindBySeriesID(seriesID string) ([]*models.Session, error)
+	FindStartingBetween(staffID string, from, to time.Time) ([]*models.Session, error)
+	FindByPatientBetween(patientID string, from, to time.Time) ([]*models.Session, error)
+	FindByClosureID(closureID int) ([]*models.Session, error)
+	FlagClosure(closureID, branchID int, from, to time.Time) error
+	ClearClosure(closureID int) error
+	SetPaymentReceived(sessionIDs []string, paid *bool) error
 	Update(id string, updates map[string]interface{}) (*models.Session, error)
 	Delete(id string) error
 	CheckOverlappingSessions(patientID string, startTime, endTime time.Time, excludeSessionId string) (bool, error)
 }
 
+type SessionSeriesRepository interface {
+	Create(series *models.SessionSeries) error
+	FindByID(id string) (*models.SessionSeries, error)
+	FindForUpdate(id string) (*models.SessionSeries, error)
+	FindByPatientID(patientID string) ([]*models.SessionSeries, error)
+	FindGeneratedBefore(until time.Time) ([]*models.SessionSeries, error)
+	Update(id string, updates map[string]interface{}) error
+	Delete(id string) error
+	AddException(exception *models.SessionSeriesException) error
+	FindExceptions(seriesID string) ([]*models.SessionSeriesException, error)
+	DeleteExceptionsFrom(seriesID string, from time.Time) error
+}
+
 type PatientRepository interface {
-	NewPatientRepository(db *gorm.DB) *PatientRepository
 	Create(patient *models.Patient) error
+	List(limit, offset int) ([]*models.Patient, int64, error)
 	FindByID(id string) (*models.Patient, error)
-	FindByName(name string) (*models.Patient, error)
+	FindByName(name string) ([]*models.Patient, error)
+	Lock(id string) error
 	Update(id string, updates map[string]interface{}) error
 	Delete(id string) error
 }
 
 type GuardianRepository interface {
-	NewGuardianRepository(db *gorm.DB) *GuardianRepository
 	Create(guardian *models.Guardian) error
 	FindByPatient(patientID string) (*[]models.Guardian, error)
 	FindByID(id string) (*models.Guardian, error)
+	FindByEmail(email string) (*models.Guardian, error)
+	FindByPhoneNumber(phoneNumber string) (*models.Guardian, error)
+	FindChildren(guardianID string) ([]*models.Patient, error)
+	IsGuardianOf(guardianID, patientID string) (bool, error)
 	Update(id string, updates map[string]interface{}) error
 	Delete(id string) error
 }
 
+type AuditLogRepository interface {
+	List(filter models.AuditLogFilter, limit, offset int) ([]*models.AuditLog, int64, error)
+}
+
+type GuardianLoginCodeRepository interface {
+	Create(code *models.GuardianLoginCode) error
+	FindLatestActive(guardianID string) (*models.GuardianLoginCode, error)
+	CountSince(guardianID string, since time.Time) (int64, error)
+	SumAttemptsSince(guardianID string, since time.Time) (int, error)
+	IncrementAttempts(id int) error
+	Consume(id int) error
+}
+
 type OnboardingQuestionRepository interface {
-	NewOnboardingQuestionRepository(db *gorm.DB) *OnboardingQuestionRepository
 	Create(question *models.OnboardingQuestion) error
-	FindByText(text string) (*models.OnboardingQuestion, error)
+	FindByID(id int) (*models.OnboardingQuestion, error)
 	FindByAssessmentID(assessmentID int) ([]*models.OnboardingQuestion, error)
+	FindAllByAssessmentID(assessmentID int) ([]*models.OnboardingQuestion, error)
 	GetAll() ([]*models.OnboardingQuestion, error)
-	Update(text string, updates map[string]interface{}) error
-	Delete(text string) error
-	CreateDefaultQuestionsForAssessment(assessmentID int, assessmentName string) error
+	Update(id int, updates map[string]interface{}) error
+	Delete(id int) error
+}
+
+type AssessmentAdministrationRepository interface {
+	Create(administration *models.AssessmentAdministration) error
+	FindByID(id int) (*models.AssessmentAdministration, error)
+	FindByPatientID(patientID string, assessmentID int) ([]*models.AssessmentAdministration, error)
+	Update(id int, updates map[string]interface{}) error
 }
 
 type OnboardingResponseRepository interface {
-	NewOnboardingResponseRepository(db *gorm.DB) *OnboardingResponseRepository
 	Create(response *models.OnboardingResponse) error
 	FindByID(id int) (*models.OnboardingResponse, error)
 	FindByPatientID(patientID string) ([]*models.OnboardingResponse, error)
-	FindByPatientAndQuestion(patientID string, questionText string) ([]*models.OnboardingResponse, error)
+	FindByPatientAndQuestion(patientID string, questionID int) ([]*models.OnboardingResponse, error)
+	FindByPatientAndAssessment(patientID string, assessmentID int) ([]*models.OnboardingResponse, error)
+	FindByAdministrationID(administrationID int) ([]*models.OnboardingResponse, error)
+	CreateInitialOnboardingResponses(administration *models.AssessmentAdministration) error
 	Update(id int, updates map[string]interface{}) error
 	Delete(id int) error
 }
 
 type MedicineRepository interface {
-	NewMedicineRepository(db *gorm.DB) *MedicineRepository
 	Create(medicine *models.Medicine) error
-	FindByID(id int) (*models.Medicine, error)
+	FindByID(id string) (*models.Medicine, error)
 	FindByPatientID(patientID string) ([]*models.Medicine, error)
 	FindByPrescriberID(prescriberID string) ([]*models.Medicine, error)
+	FindScheduled(patientIDs []string) ([]*models.Medicine, error)
+	Update(id string, updates map[string]interface{}) error
+	Delete(id string) error
+}
+
+type MedicationAdministrationRepository interface {
+	Create(administration *models.MedicationAdministration) error
+	FindByPatientID(patientID string, from, to time.Time) ([]*models.MedicationAdministration, error)
+	FindDose(medicineID string, dueAt time.Time) (*models.MedicationAdministration, error)
+	FindDueBetween(medicineIDs []string, from, to time.Time) ([]*models.MedicationAdministration, error)
+	CountByMedicineID(medicineID string) (int64, error)
+}
+
+type PrescriptionEventRepository interface {
+	Create(event *models.PrescriptionEvent) error
+	FindLatest(medicineID string) (*models.PrescriptionEvent, error)
+	FindByPatientID(patientID string) ([]*models.PrescriptionEvent, error)
+	DeleteByMedicineID(medicineID string) error
+}
+
+type BranchClosureRepository interface {
+	Create(closure *models.BranchClosure) error
+	FindByID(id int) (*models.BranchClosure, error)
+	FindByBranch(branchID int, from, to string) ([]*models.BranchClosure, error)
+	Delete(id int) error
+}
+
+type CalendarFeedRepository interface {
+	Create(feed *models.CalendarFeed) error
+	FindByID(id int) (*models.CalendarFeed, error)
+	FindByTokenHash(hash string) (*models.CalendarFeed, error)
+	FindByStaffID(staffID string) ([]*models.CalendarFeed, error)
+	FindByPatientID(patientID string) ([]*models.CalendarFeed, error)
 	Update(id int, updates map[string]interface{}) error
+}
+
+type InvoiceRepository interface {
+	Create(invoice *models.Invoice) error
+	FindByID(id int) (*models.Invoice, error)
+	FindForUpdate(id int) (*models.Invoice, error)
+	FindByPatientID(patientID string) ([]*models.Invoice, error)
+	FindByGuardianID(guardianID string, statuses ...models.InvoiceStatus) ([]*models.Invoice, error)
+	FindByStatus(statuses ...models.InvoiceStatus) ([]*models.Invoice, error)
+	FindInvoicedSessionIDs(sessionIDs []string) ([]string, error)
+	Update(id int, updates map[string]interface{}) error
+	AddPayment(payment *models.Payment) error
+}
+
+type TaxInvoiceRepository interface {
+	Create(taxInvoice *models.TaxInvoice) error
+	FindByInvoiceID(invoiceID int) (*models.TaxInvoice, error)
+	FindByBranch(branchID int, financialYear string) ([]*models.TaxInvoice, error)
+	NextNumber(branchID int, financialYear string) (int, error)
+	RecordReprint(id int, at time.Time) error
+}
+
+type RateCardRepository interface {
+	Create(card *models.RateCard) error
+	FindByID(id int) (*models.RateCard, error)
+	FindAll(therapyType string) ([]*models.RateCard, error)
+	IsInvoiced(id int) (bool, error)
 	Delete(id int) error
 }
 
+type DiscountRepository interface {
+	CreateRule(rule *models.DiscountRule) error
+	FindRuleByID(id int) (*models.DiscountRule, error)
+	FindRules(kind models.DiscountKind) ([]*models.DiscountRule, error)
+	UpdateRule(id int, updates map[string]interface{}) error
+	FindByPatientID(patientID string) (*models.PatientDiscount, error)
+	SetForPatient(discount *models.PatientDiscount) error
+	DeleteForPatient(patientID string) error
+}
+
+type TreatmentPlanRepository interface {
+	Create(plan *models.TreatmentPlan) error
+	FindByID(id int) (*models.TreatmentPlan, error)
+	FindByPatientID(patientID string) ([]*models.TreatmentPlan, error)
+	FindActive(patientID string) (*models.TreatmentPlan, error)
+	Update(id int, updates map[string]interface{}) error
+	Delete(id int) error
+	CreateGoal(goal *models.TreatmentGoal) error
+	FindGoalByID(id int) (*models.TreatmentGoal, error)
+	UpdateGoal(id int, updates map[string]interface{}) error
+	DeleteGoal(id int) error
+	CreateTarget(target *models.TreatmentTarget) error
+	FindTargetByID(id int) (*models.TreatmentTarget, error)
+	UpdateTarget(id int, updates map[string]interface{}) error
+	DeleteTarget(id int) error
+	IsPractised(targetIDs []int) (bool, error)
+}
+
+type TrialRepository interface {
+	Create(trials []*models.Trial) error
+	FindByID(id int) (*models.Trial, error)
+	FindByActivityID(activityID string) ([]*models.Trial, error)
+	FindByTargetID(targetID int) ([]*models.Trial, error)
+	LastNumber(activityID string) (int, error)
+	Delete(id int) error
+}
+
+type BehaviorIncidentRepository interface {
+	Create(incident *models.BehaviorIncident) error
+	FindByID(id int) (*models.BehaviorIncident, error)
+	FindByPatientID(patientID string, from, to time.Time, behaviorType string) ([]*models.BehaviorIncident, error)
+	Update(id int, updates map[string]interface{}) error
+	Delete(id int) error
+}
+
+type BehaviorMeasurementRepository interface {
+	Create(measurement *models.BehaviorMeasurement) error
+	FindByID(id int) (*models.BehaviorMeasurement, error)
+	FindByPatientID(patientID string) ([]*models.BehaviorMeasurement, error)
+	Update(id int, updates map[string]interface{}) error
+	Delete(id int) error
+	HasDataPoints(id int) (bool, error)
+	CreateDataPoint(point *models.MeasurementDataPoint) error
+	FindDataPointByID(id int) (*models.MeasurementDataPoint, error)
+	FindDataPoint(measurementID int, sessionID string) (*models.MeasurementDataPoint, error)
+	FindDataPointsBySessionID(sessionID string) ([]*models.MeasurementDataPoint, error)
+	FindDataPointsByMeasurementID(measurementID int, from, to time.Time) ([]*models.MeasurementDataPoint, error)
+	UpdateDataPoint(id int, updates map[string]interface{}) error
+	DeleteDataPoint(id int) error
+}
+
 type BranchRepository interface {
-	NewBranchRepository(db *gorm.DB) *BranchRepository
 	Create(branch *models.Branch) error
-	Update(id string, updates map[string]interface{}) error
-	GetBranchByID(id string) (*models.Branch, error)
+	Update(id int, updates map[string]interface{}) error
+	GetBranchByID(id int) (*models.Branch, error)
 	ListBranches() ([]*models.Branch, error)
-	DeleteBranch(id string) (*models.Branch, error)
+	DeleteBranch(id int) (*models.Branch, error)
 }
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+
+	"palaam/internal/models"
 	"palaam/internal/repository/impl"
 
 	"gorm.io/gorm"
@@ -8,9 +11,71 @@ import (
 
 func NewRepository(db *gorm.DB) *Repository {
 	return &Repository{
-		Session:  impl.NewSessionRepository(db),
-		Activity: impl.NewActivityRepository(db),
-		Patient:  impl.NewPatientRepository(db),
-		Staff:    impl.NewStaffRepository(db),
+		db:                       db,
+		Session:                  impl.NewSessionRepository(db),
+		SessionSeries:            impl.NewSessionSeriesRepository(db),
+		Activity:                 impl.NewActivityRepository(db),
+		Patient:                  impl.NewPatientRepository(db),
+		Staff:                    impl.NewStaffRepository(db),
+		Medicine:                 impl.NewMedicineRepository(db),
+		Branch:                   impl.NewBranchRepository(db),
+		OperatingHours:           impl.NewOperatingHoursRepository(db),
+		BranchClosure:            impl.NewBranchClosureRepository(db),
+		CalendarFeed:             impl.NewCalendarFeedRepository(db),
+		Invoice:                  impl.NewInvoiceRepository(db),
+		RateCard:                 impl.NewRateCardRepository(db),
+		Discount:                 impl.NewDiscountRepository(db),
+		TaxInvoice:               impl.NewTaxInvoiceRepository(db),
+		TreatmentPlan:            impl.NewTreatmentPlanRepository(db),
+		Trial:                    impl.NewTrialRepository(db),
+		BehaviorIncident:         impl.NewBehaviorIncidentRepository(db),
+		BehaviorMeasurement:      impl.NewBehaviorMeasurementRepository(db),
+		MedicationAdministration: impl.NewMedicationAdministrationRepository(db),
+		PrescriptionEvent:        impl.NewPrescriptionEventRepository(db),
+		Guardian:                 impl.NewGuardianRepository(db),
+		GuardianLoginCode:        impl.NewGuardianLoginCodeRepository(db),
+		AuditLog:                 impl.NewAuditLogRepository(db),
+		Assessment:               impl.NewAssessmentRepository(db),
+		AssessmentAdministration: impl.NewAssessmentAdministrationRepository(db),
+		OnboardingQuestion:       impl.NewOnboardingQuestionRepository(db),
+		OnboardingResponse:       impl.NewOnboardingResponseRepository(db),
+	}
+}
+
+// ForViewer returns a copy of the repository whose patient, session and activity
+// queries only return rows inside the viewer's caseload
+func (r *Repository) ForViewer(viewer *models.Viewer) *Repository {
+	scoped := *r
+	scoped.viewer = viewer
+	scoped.Session = impl.NewSessionRepository(r.db).WithViewer(viewer)
+	scoped.Activity = impl.NewActivityRepository(r.db).WithViewer(viewer)
+	scoped.Patient = impl.NewPatientRepository(r.db).WithViewer(viewer)
+	return &scoped
+}
+
+// Viewer is the caller whose caseload the repository is limited to, or nil when it isn't
+func (r *Repository) Viewer() *models.Viewer {
+	return r.viewer
+}
+
+// WithContext returns a copy of the repository whose queries carry ctx, and with it
+// the audit actor of the request, limited to the same caseload as r
+func (r *Repository) WithContext(ctx context.Context) *Repository {
+	repo := NewRepository(r.db.WithContext(ctx))
+	if r.viewer != nil {
+		repo = repo.ForViewer(r.viewer)
 	}
+	return repo
+}
+
+// Transaction runs fn with a copy of the repository whose queries share one transaction,
+// limited to the same caseload as r. Returning an error from fn rolls everything back.
+func (r *Repository) Transaction(fn func(repo *Repository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		repo := NewRepository(tx)
+		if r.viewer != nil {
+			repo = repo.ForViewer(r.viewer)
+		}
+		return fn(repo)
+	})
 }
@@ -0,0 +1,132 @@
+package service
+
+// backend/internal/service/activity_service.go
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+type ActivityServiceInterface interface {
+	GetBySessionAndStaff(staffID, sessionID string, limit, offset int) ([]*models.Activity, int64, error)
+	Create(staffID, sessionID string, activity *models.Activity) (*models.Activity, error)
+	GetSpecific(staffID, sessionID, id string) (*models.Activity, error)
+	Update(staffID, sessionID, id string, activity *models.Activity) (*models.Activity, error)
+	Delete(staffID, sessionID, id string) error
+}
+
+type ActivityService struct {
+	repo *repository.Repository
+}
+
+func NewActivityService(repo *repository.Repository) ActivityServiceInterface {
+	return &ActivityService{repo: repo}
+}
+
+// GetBySessionAndStaff lists the activities of a staff member's session
+func (s *ActivityService) GetBySessionAndStaff(staffID, sessionID string, limit, offset int) ([]*models.Activity, int64, error) {
+	if _, err := s.session(staffID, sessionID); err != nil {
+		return nil, 0, err
+	}
+	activities, err := s.repo.Activity.FindBySessionID(sessionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return page(activities, limit, offset), int64(len(activities)), nil
+}
+
+// Create records an activity in a staff member's session
+func (s *ActivityService) Create(staffID, sessionID string, activity *models.Activity) (*models.Activity, error) {
+	session, err := s.session(staffID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	activity.ID = uuid.NewString()
+	activity.SessionID = &session.ID
+	if err := s.repo.Activity.Create(activity); err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// GetSpecific gets an activity of a staff member's session
+func (s *ActivityService) GetSpecific(staffID, sessionID, id string) (*models.Activity, error) {
+	if _, err := s.session(staffID, sessionID); err != nil {
+		return nil, err
+	}
+	activity, err := s.getByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if activity.SessionID == nil || *activity.SessionID != sessionID {
+		return nil, errors.New("activity not found")
+	}
+	return activity, nil
+}
+
+// Update what an activity of a staff member's session records. Fields left out are kept.
+func (s *ActivityService) Update(staffID, sessionID, id string, activity *models.Activity) (*models.Activity, error) {
+	if _, err := s.GetSpecific(staffID, sessionID, id); err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if activity.Description != nil {
+		updates["description"] = activity.Description
+	}
+	if activity.DurationMinutes != nil {
+		updates["duration_minutes"] = activity.DurationMinutes
+	}
+	if activity.ResponseLevel != nil {
+		updates["response_level"] = activity.ResponseLevel
+	}
+	if activity.TargetID != nil {
+		updates["target_id"] = activity.TargetID
+	}
+	if len(updates) > 0 {
+		if err := s.repo.Activity.Update(id, updates); err != nil {
+			return nil, err
+		}
+	}
+	return s.getByID(id)
+}
+
+// Delete an activity of a staff member's session
+func (s *ActivityService) Delete(staffID, sessionID, id string) error {
+	if _, err := s.GetSpecific(staffID, sessionID, id); err != nil {
+		return err
+	}
+	return s.repo.Activity.Delete(id)
+}
+
+func (s *ActivityService) getByID(id string) (*models.Activity, error) {
+	activity, err := s.repo.Activity.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("activity not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return activity, nil
+}
+
+// session finds a session of the caller's caseload run by the staff member
+func (s *ActivityService) session(staffID, sessionID string) (*models.Session, error) {
+	session, err := s.repo.Session.FindByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("session not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if session.StaffID != staffID {
+		return nil, errors.New("session not found")
+	}
+	return session, nil
+}
@@ -0,0 +1,25 @@
+package service
+
+// backend/internal/service/audit_service.go
+
+import (
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+type AuditServiceInterface interface {
+	List(filter models.AuditLogFilter, limit, offset int) ([]*models.AuditLog, int64, error)
+}
+
+type AuditService struct {
+	repo *repository.Repository
+}
+
+func NewAuditService(repo *repository.Repository) AuditServiceInterface {
+	return &AuditService{repo: repo}
+}
+
+// List audit log entries matching the filter, newest first
+func (s *AuditService) List(filter models.AuditLogFilter, limit, offset int) ([]*models.AuditLog, int64, error) {
+	return s.repo.AuditLog.List(filter, limit, offset)
+}
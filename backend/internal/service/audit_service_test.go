@@ -0,0 +1,159 @@
+package service
+
+// backend/internal/service/audit_service_test.go
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"palaam/internal/audit"
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+func TestAuditRecordsActor(t *testing.T) {
+	repo := newTestRepository(t)
+	doctor := &models.Staff{ID: uuid.NewString(), Name: "Doctor", Role: models.RoleDoctor, JoinDate: time.Now()}
+	if err := repo.Staff.Create(doctor); err != nil {
+		t.Fatal(err)
+	}
+
+	actor := &audit.Actor{ID: doctor.ID, Role: doctor.Role, RequestID: "request-1", IP: "10.0.0.1"}
+	patients := NewPatientService(repo.WithContext(audit.WithActor(context.Background(), actor)))
+	patient, err := patients.Create(&models.Patient{Name: "Asha"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Reading the same patient again in a request is only logged once
+	for range 2 {
+		if _, err := patients.GetByID(patient.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := patients.Update(patient.ID, map[string]interface{}{"name": "Asha R"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := patients.Delete(patient.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// Nothing done without an actor is logged
+	if _, err := NewPatientService(repo).Create(&models.Patient{Name: "Unaudited"}); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, total, err := NewAuditService(repo).List(models.AuditLogFilter{}, 100, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		action  models.AuditAction
+		changed string // A column whose before and after values the entry must hold
+	}{
+		{models.AuditCreate, "name"},
+		{models.AuditView, ""},
+		{models.AuditUpdate, "name"},
+		{models.AuditDelete, "name"},
+	}
+	if total != int64(len(tests)) {
+		t.Fatalf("the audit log has %d entries, want %d", total, len(tests))
+	}
+	for i, tt := range tests {
+		// The log lists the newest entry first
+		entry := entries[len(entries)-1-i]
+		if entry.Action != tt.action {
+			t.Errorf("entry %d is a %s, want %s", i, entry.Action, tt.action)
+		}
+		if entry.ActorID != doctor.ID || entry.ActorRole != models.RoleDoctor {
+			t.Errorf("entry %d is by %s %s, want the doctor", i, entry.ActorRole, entry.ActorID)
+		}
+		if entry.RequestID != actor.RequestID || entry.IP != actor.IP {
+			t.Errorf("entry %d came from request %s at %s, want %s at %s", i, entry.RequestID, entry.IP, actor.RequestID, actor.IP)
+		}
+		if entry.EntityType != "patient" || entry.EntityID != patient.ID || entry.PatientID == nil || *entry.PatientID != patient.ID {
+			t.Errorf("entry %d is about %s %s, want patient %s", i, entry.EntityType, entry.EntityID, patient.ID)
+		}
+
+		if tt.changed == "" {
+			if entry.Changes != nil {
+				t.Errorf("entry %d records changes %s, want none", i, *entry.Changes)
+			}
+			continue
+		}
+		var changes map[string]json.RawMessage
+		if entry.Changes == nil || json.Unmarshal([]byte(*entry.Changes), &changes) != nil {
+			t.Errorf("entry %d has no readable changes", i)
+			continue
+		}
+		if _, ok := changes[tt.changed]; !ok {
+			t.Errorf("entry %d changes %s, want %s among them", i, *entry.Changes, tt.changed)
+		}
+	}
+}
+
+func TestAuditLogIsAppendOnly(t *testing.T) {
+	db := newTestDB(t)
+	repo := repository.NewRepository(db)
+	actor := &audit.Actor{ID: uuid.NewString(), Role: models.RoleAdmin}
+	if _, err := NewPatientService(repo.WithContext(audit.WithActor(context.Background(), actor))).Create(&models.Patient{Name: "Asha"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Model(&models.AuditLog{}).Where("1 = 1").Update("actor_id", "someone-else").Error; !errors.Is(err, audit.ErrAppendOnly) {
+		t.Errorf("updating the audit log error = %v, want %v", err, audit.ErrAppendOnly)
+	}
+	if err := db.Where("1 = 1").Delete(&models.AuditLog{}).Error; !errors.Is(err, audit.ErrAppendOnly) {
+		t.Errorf("deleting from the audit log error = %v, want %v", err, audit.ErrAppendOnly)
+	}
+}
+
+func TestClosureHandlersAuditTheCaller(t *testing.T) {
+	f := newClosureFixture(t)
+	impact, err := f.closures.Create(&models.BranchClosure{BranchID: f.branch.ID, StartDate: "2026-03-03", Name: "Festival"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Deleting the closure unflags its session through the handler, as the signed-in admin
+	admin := &audit.Actor{ID: uuid.NewString(), Role: models.RoleAdmin}
+	server := NewServer(f.repo, nil, config.Config{Scheduling: config.Scheduling{Timezone: "UTC"}})
+	app := fiber.New()
+	app.Delete("/closures/:id", func(c *fiber.Ctx) error {
+		c.SetUserContext(audit.WithActor(c.UserContext(), admin))
+		id, err := c.ParamsInt("id")
+		if err != nil {
+			return err
+		}
+		return server.DeleteClosuresId(c, id)
+	})
+	response, err := app.Test(httptest.NewRequest(fiber.MethodDelete, "/closures/"+strconv.Itoa(impact.Closure.ID), nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != fiber.StatusNoContent {
+		t.Fatalf("deleting the closure status = %d, want %d", response.StatusCode, fiber.StatusNoContent)
+	}
+
+	entries, _, err := NewAuditService(f.repo).List(models.AuditLogFilter{}, 100, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var unflagged bool
+	for _, entry := range entries {
+		if entry.Action == models.AuditUpdate && entry.EntityID == f.sessions["2026-03-03"].ID {
+			unflagged = entry.ActorID == admin.ID
+		}
+	}
+	if !unflagged {
+		t.Errorf("the audit log has no update of the unflagged session by the admin, got %d entries", len(entries))
+	}
+}
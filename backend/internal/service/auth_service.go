@@ -0,0 +1,83 @@
+package service
+
+// backend/internal/service/auth_service.go
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/auth"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var ErrInvalidCredentials = errors.New("invalid credentials")
+
+type AuthServiceInterface interface {
+	Login(email, password string) (string, *models.Staff, error)
+	EnsureAdmin(email, password string) error
+}
+
+type AuthService struct {
+	repo   *repository.Repository
+	tokens *auth.TokenManager
+}
+
+// NewAuthService creates an auth service that issues tokens with the given manager
+func NewAuthService(repo *repository.Repository, tokens *auth.TokenManager) AuthServiceInterface {
+	return &AuthService{repo: repo, tokens: tokens}
+}
+
+// Login checks a staff member's credentials and issues an access token
+func (s *AuthService) Login(email, password string) (string, *models.Staff, error) {
+	staff, err := s.repo.Staff.FindByEmail(email)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	if !auth.CheckPassword(staff.PasswordHash, password) {
+		return "", nil, ErrInvalidCredentials
+	}
+
+	token, err := s.tokens.Issue(staff)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, staff, nil
+}
+
+// EnsureAdmin creates the bootstrap admin account if no staff member uses its email yet.
+// Without it, a fresh install would have nobody able to sign in.
+func (s *AuthService) EnsureAdmin(email, password string) error {
+	if email == "" || password == "" {
+		return nil
+	}
+
+	_, err := s.repo.Staff.FindByEmail(email)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	return s.repo.Staff.Create(&models.Staff{
+		ID:           uuid.NewString(),
+		Name:         "Administrator",
+		JoinDate:     time.Now(),
+		Role:         models.RoleAdmin,
+		Email:        &email,
+		PasswordHash: hash,
+	})
+}
@@ -0,0 +1,345 @@
+package service
+
+// backend/internal/service/authorization.go
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"palaam/internal/auth"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// publicRoutes can be called without a bearer token or role. Calendar feeds check their own token.
+var publicRoutes = []string{
+	"/auth/login",
+	"/auth/guardian/code",
+	"/auth/guardian/verify",
+	"/calendar/staff/:id",
+	"/calendar/patients/:patient_id",
+}
+
+var allStaff = []models.StaffRole{
+	models.RoleAdmin,
+	models.RoleTherapist,
+	models.RoleDoctor,
+	models.RoleBehavioralAnalyst,
+}
+
+var sessionWriters = []models.StaffRole{
+	models.RoleAdmin,
+	models.RoleTherapist,
+	models.RoleBehavioralAnalyst,
+}
+
+// planWriters author treatment plans. Therapists follow them and record activities against their targets.
+var planWriters = []models.StaffRole{
+	models.RoleAdmin,
+	models.RoleBehavioralAnalyst,
+}
+
+// Policy maps every ServerInterface operation, keyed as "METHOD /route", to the
+// roles allowed to call it. Operations missing from the policy are denied.
+var Policy = map[string][]models.StaffRole{
+	// Branches
+	"GET /branches":           allStaff,
+	"POST /branches":          {models.RoleAdmin},
+	"GET /branches/:id":       allStaff,
+	"PUT /branches/:id":       {models.RoleAdmin},
+	"DELETE /branches/:id":    {models.RoleAdmin},
+	"GET /branches/:id/hours": allStaff,
+	"PUT /branches/:id/hours": {models.RoleAdmin},
+
+	// Branch closures
+	"GET /branches/:id/closures":           allStaff,
+	"POST /branches/:id/closures":          {models.RoleAdmin},
+	"POST /branches/:id/closures/holidays": {models.RoleAdmin},
+	"DELETE /closures/:id":                 {models.RoleAdmin},
+	"GET /closures/:id/sessions":           allStaff,
+	"POST /closures/:id/reschedule":        sessionWriters,
+	"POST /closures/:id/cancel":            sessionWriters,
+
+	// Patients
+	"GET /patients":        allStaff,
+	"POST /patients":       {models.RoleAdmin, models.RoleDoctor},
+	"GET /patients/:id":    allStaff,
+	"PUT /patients/:id":    {models.RoleAdmin, models.RoleDoctor},
+	"DELETE /patients/:id": {models.RoleAdmin},
+
+	// Treatment plans
+	"GET /patients/:patient_id/treatment-plans":  allStaff,
+	"POST /patients/:patient_id/treatment-plans": planWriters,
+	"GET /treatment-plans/:id":                   allStaff,
+	"PUT /treatment-plans/:id":                   planWriters,
+	"DELETE /treatment-plans/:id":                planWriters,
+	"POST /treatment-plans/:id/goals":            planWriters,
+	"PUT /treatment-goals/:id":                   planWriters,
+	"DELETE /treatment-goals/:id":                planWriters,
+	"POST /treatment-goals/:id/targets":          planWriters,
+	"PUT /treatment-targets/:id":                 planWriters,
+	"DELETE /treatment-targets/:id":              planWriters,
+	"GET /treatment-targets/:id/sessions":        allStaff,
+
+	// Medicines
+	"GET /patients/:patient_id/medicines":  allStaff,
+	"POST /patients/:patient_id/medicines": {models.RoleDoctor},
+	"GET /medicines/:id":                   allStaff,
+	"PUT /medicines/:id":                   {models.RoleDoctor},
+	"DELETE /medicines/:id":                {models.RoleDoctor},
+	"POST /medicines/:id/discontinue":      {models.RoleDoctor},
+
+	// Prescription history and reminders
+	"GET /patients/:patient_id/medication-timeline": allStaff,
+	"GET /staff/:id/prescription-reminders":         {models.RoleDoctor, models.RoleAdmin},
+
+	// Medication administration
+	"POST /medicines/:id/doses":                   allStaff,
+	"GET /patients/:patient_id/medication-record": allStaff,
+	"GET /branches/:id/doses-due":                 allStaff,
+
+	// Onboarding assessments
+	"GET /assessments":                                            allStaff,
+	"GET /assessments/:id/questions":                              allStaff,
+	"GET /patients/:patient_id/onboarding-responses":              allStaff,
+	"POST /patients/:patient_id/onboarding-responses":             sessionWriters,
+	"PUT /onboarding-responses/:id":                               sessionWriters,
+	"GET /patients/:patient_id/assessments/:assessment_id/scores": allStaff,
+	"GET /patients/:patient_id/administrations":                   allStaff,
+	"POST /patients/:patient_id/administrations":                  sessionWriters,
+	"GET /administrations/:id":                                    allStaff,
+	"PUT /administrations/:id":                                    sessionWriters,
+	"GET /administrations/:id/responses":                          allStaff,
+	"GET /administrations/:id/scores":                             allStaff,
+	"GET /administrations/:id/comparison":                         allStaff,
+
+	// Sessions
+	"GET /patients/:patient_id/sessions":             allStaff,
+	"GET /patients/:patient_id/sessions/:session_id": allStaff,
+	"GET /sessions":             allStaff,
+	"POST /sessions":            sessionWriters,
+	"GET /sessions/:id":         allStaff,
+	"PUT /sessions/:id":         sessionWriters,
+	"DELETE /sessions/:id":      {models.RoleAdmin},
+	"GET /sessions/:id/details": allStaff,
+
+	// Progress
+	"GET /patients/:patient_id/progress": allStaff,
+
+	// Recurring session series
+	"POST /session-series":                            sessionWriters,
+	"GET /session-series/:id":                         allStaff,
+	"GET /session-series/:id/sessions":                allStaff,
+	"PUT /session-series/:id/sessions/:session_id":    sessionWriters,
+	"DELETE /session-series/:id/sessions/:session_id": sessionWriters,
+	"GET /patients/:patient_id/session-series":        allStaff,
+
+	// Staff
+	"GET /staff":              allStaff,
+	"POST /staff":             {models.RoleAdmin},
+	"GET /staff/:id":          allStaff,
+	"PUT /staff/:id":          {models.RoleAdmin},
+	"DELETE /staff/:id":       {models.RoleAdmin},
+	"GET /staff/:id/sessions": allStaff,
+
+	// Activities
+	"GET /staff/:staff_id/sessions/:session_id/activities":        allStaff,
+	"POST /staff/:staff_id/sessions/:session_id/activities":       sessionWriters,
+	"GET /staff/:staff_id/sessions/:session_id/activities/:id":    allStaff,
+	"PUT /staff/:staff_id/sessions/:session_id/activities/:id":    sessionWriters,
+	"DELETE /staff/:staff_id/sessions/:session_id/activities/:id": sessionWriters,
+
+	// Discrete trials
+	"GET /activities/:id/trials":  allStaff,
+	"POST /activities/:id/trials": sessionWriters,
+	"DELETE /trials/:id":          sessionWriters,
+
+	// Behavior incidents
+	"POST /sessions/:id/behavior-incidents":        sessionWriters,
+	"GET /patients/:patient_id/behavior-incidents": allStaff,
+	"GET /patients/:patient_id/behavior-summary":   allStaff,
+	"GET /behavior-incidents/:id":                  allStaff,
+	"PUT /behavior-incidents/:id":                  sessionWriters,
+	"DELETE /behavior-incidents/:id":               sessionWriters,
+
+	// Behavior measurements
+	"GET /patients/:patient_id/behavior-measurements":  allStaff,
+	"POST /patients/:patient_id/behavior-measurements": planWriters,
+	"GET /behavior-measurements/:id":                   allStaff,
+	"PUT /behavior-measurements/:id":                   planWriters,
+	"DELETE /behavior-measurements/:id":                planWriters,
+	"GET /behavior-measurements/:id/data-points":       allStaff,
+	"GET /sessions/:id/data-points":                    allStaff,
+	"POST /sessions/:id/data-points":                   sessionWriters,
+	"PUT /data-points/:id":                             sessionWriters,
+	"DELETE /data-points/:id":                          sessionWriters,
+
+	// Calendar feeds
+	"GET /staff/:id/calendar-feeds":             allStaff,
+	"POST /staff/:id/calendar-feeds":            allStaff,
+	"GET /patients/:patient_id/calendar-feeds":  allStaff,
+	"POST /patients/:patient_id/calendar-feeds": allStaff,
+	"DELETE /calendar-feeds/:id":                allStaff,
+
+	// Timesheets
+	"GET /staff/:id/timesheet": allStaff,
+	"GET /timesheets":          {models.RoleAdmin},
+
+	// Invoices
+	"GET /patients/:patient_id/invoices":  {models.RoleAdmin},
+	"POST /patients/:patient_id/invoices": {models.RoleAdmin},
+	"GET /invoices/:id":                   {models.RoleAdmin},
+	"POST /invoices/:id/void":             {models.RoleAdmin},
+	"POST /invoices/:id/payments":         {models.RoleAdmin},
+	"GET /guardians/:id/balance":          {models.RoleAdmin},
+	"GET /receivables/aging":              {models.RoleAdmin},
+
+	// Tax invoices
+	"POST /invoices/:id/tax-invoice":         {models.RoleAdmin},
+	"GET /invoices/:id/tax-invoice":          {models.RoleAdmin},
+	"POST /invoices/:id/tax-invoice/reprint": {models.RoleAdmin},
+	"GET /branches/:id/tax-invoices":         {models.RoleAdmin},
+
+	// Pricing
+	"GET /rate-cards":                            allStaff,
+	"POST /rate-cards":                           {models.RoleAdmin},
+	"DELETE /rate-cards/:id":                     {models.RoleAdmin},
+	"GET /discount-rules":                        {models.RoleAdmin},
+	"POST /discount-rules":                       {models.RoleAdmin},
+	"PUT /discount-rules/:id":                    {models.RoleAdmin},
+	"PUT /patients/:patient_id/discount":         {models.RoleAdmin},
+	"DELETE /patients/:patient_id/discount":      {models.RoleAdmin},
+	"GET /patients/:patient_id/session-estimate": allStaff,
+
+	// Audit
+	"GET /audit-logs": {models.RoleAdmin},
+
+	// Guardian portal
+	"GET /guardian/children":                       {models.RoleGuardian},
+	"GET /guardian/children/:patient_id/sessions":  {models.RoleGuardian},
+	"GET /guardian/children/:patient_id/medicines": {models.RoleGuardian},
+	"GET /guardian/children/:patient_id/progress":  {models.RoleGuardian},
+}
+
+// ForbiddenError is returned when the caller is authenticated but not allowed to perform an operation
+type ForbiddenError struct {
+	Operation    string
+	Role         models.StaffRole
+	AllowedRoles []models.StaffRole
+	Reason       string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Reason
+}
+
+// respond writes the error as a structured 403
+func (e *ForbiddenError) respond(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+		"error":         e.Reason,
+		"operation":     e.Operation,
+		"role":          e.Role,
+		"allowed_roles": e.AllowedRoles,
+	})
+}
+
+// policyRouter registers every route behind a role check taken from the policy table
+type policyRouter struct {
+	fiber.Router
+	policy map[string][]models.StaffRole
+}
+
+func newPolicyRouter(router fiber.Router, policy map[string][]models.StaffRole) *policyRouter {
+	return &policyRouter{Router: router, policy: policy}
+}
+
+func (r *policyRouter) Get(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Get(path, r.guard(fiber.MethodGet, path, handlers)...)
+}
+
+func (r *policyRouter) Post(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Post(path, r.guard(fiber.MethodPost, path, handlers)...)
+}
+
+func (r *policyRouter) Put(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Put(path, r.guard(fiber.MethodPut, path, handlers)...)
+}
+
+func (r *policyRouter) Patch(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Patch(path, r.guard(fiber.MethodPatch, path, handlers)...)
+}
+
+func (r *policyRouter) Delete(path string, handlers ...fiber.Handler) fiber.Router {
+	return r.Router.Delete(path, r.guard(fiber.MethodDelete, path, handlers)...)
+}
+
+// guard prepends the role check for an operation to its handlers
+func (r *policyRouter) guard(method, path string, handlers []fiber.Handler) []fiber.Handler {
+	if slices.Contains(publicRoutes, path) {
+		return handlers
+	}
+
+	operation := method + " " + path
+	allowed := r.policy[operation]
+
+	check := func(c *fiber.Ctx) error {
+		claims, _ := auth.ClaimsFrom(c)
+		if claims == nil || !slices.Contains(allowed, claims.Role) {
+			denied := &ForbiddenError{
+				Operation:    operation,
+				AllowedRoles: allowed,
+				Reason:       "role is not allowed to perform this operation",
+			}
+			if claims != nil {
+				denied.Role = claims.Role
+			}
+			return denied.respond(c)
+		}
+		return c.Next()
+	}
+
+	return append([]fiber.Handler{check}, handlers...)
+}
+
+type AuthorizationServiceInterface interface {
+	CanWriteSession(c *fiber.Ctx, sessionID string) error
+}
+
+type AuthorizationService struct {
+	repo *repository.Repository
+}
+
+func NewAuthorizationService(repo *repository.Repository) AuthorizationServiceInterface {
+	return &AuthorizationService{repo: repo}
+}
+
+// CanWriteSession restricts therapists to recording data on their own sessions.
+// Other roles the policy lets through may write to any session.
+func (s *AuthorizationService) CanWriteSession(c *fiber.Ctx, sessionID string) error {
+	claims, _ := auth.ClaimsFrom(c)
+	if claims == nil || claims.Role != models.RoleTherapist {
+		return nil
+	}
+
+	session, err := s.repo.Session.FindByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New("session not found")
+	}
+	if err != nil {
+		return err
+	}
+
+	if session.StaffID != claims.StaffID() {
+		return &ForbiddenError{
+			Operation:    fmt.Sprintf("%s %s", c.Method(), c.Route().Path),
+			Role:         claims.Role,
+			AllowedRoles: []models.StaffRole{models.RoleAdmin, models.RoleBehavioralAnalyst},
+			Reason:       "therapists can only record activities on their own sessions",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package service
+
+// backend/internal/service/authorization_test.go
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// TestPolicyCoversEveryRoute checks every generated route has roles in the policy,
+// since operations missing from it are denied to everyone
+func TestPolicyCoversEveryRoute(t *testing.T) {
+	app := fiber.New()
+	RegisterHandlers(app, &Server{})
+
+	routes := 0
+	for _, route := range app.GetRoutes(true) {
+		if route.Method == fiber.MethodHead || slices.Contains(publicRoutes, route.Path) {
+			continue
+		}
+		routes++
+		operation := route.Method + " " + route.Path
+		if len(Policy[operation]) == 0 {
+			t.Errorf("%s has no roles in the policy", operation)
+		}
+	}
+	if routes == 0 {
+		t.Fatal("no routes were registered")
+	}
+}
+
+// TestPolicyHasNoStaleEntries checks every policy entry still names a generated route
+func TestPolicyHasNoStaleEntries(t *testing.T) {
+	app := fiber.New()
+	RegisterHandlers(app, &Server{})
+
+	registered := map[string]bool{}
+	for _, route := range app.GetRoutes(true) {
+		registered[route.Method+" "+route.Path] = true
+	}
+	for operation := range Policy {
+		if !registered[operation] {
+			t.Errorf("%s is in the policy but isn't a route", operation)
+		}
+	}
+}
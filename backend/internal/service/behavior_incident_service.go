@@ -0,0 +1,304 @@
+package service
+
+// backend/internal/service/behavior_incident_service.go
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var ErrIncidentNotFound = errors.New("behavior incident not found")
+
+// BehaviorSummary counts a patient's behavior incidents by local hour of the day, antecedent,
+// hypothesised function and behavior type, for building behavior intervention plans
+type BehaviorSummary struct {
+	PatientID      string           `json:"patient_id"`
+	From           *string          `json:"from"`
+	To             *string          `json:"to"` // Included
+	BehaviorType   *string          `json:"behavior_type"`
+	Total          int              `json:"total"`
+	ByTimeOfDay    []*HourCount     `json:"by_time_of_day"` // Every hour, midnight first
+	ByAntecedent   []*IncidentCount `json:"by_antecedent"`
+	ByFunction     []*IncidentCount `json:"by_function"`
+	ByBehaviorType []*IncidentCount `json:"by_behavior_type"`
+}
+
+type HourCount struct {
+	Hour  int `json:"hour"` // 0 to 23
+	Count int `json:"count"`
+}
+
+// IncidentCount is how many incidents share a value, most frequent first. Free text values
+// are grouped ignoring case and surrounding spaces, labelled as first recorded.
+type IncidentCount struct {
+	Label   string  `json:"label"`
+	Count   int     `json:"count"`
+	Percent float64 `json:"percent"` // Of all incidents summarised
+}
+
+type BehaviorIncidentServiceInterface interface {
+	ListByPatient(patientID, from, to, behaviorType string) ([]*models.BehaviorIncident, error)
+	GetByID(id int) (*models.BehaviorIncident, error)
+	Create(caller *models.Viewer, sessionID string, incident *models.BehaviorIncident, now time.Time) (*models.BehaviorIncident, error)
+	Update(id int, incident *models.BehaviorIncident, now time.Time) (*models.BehaviorIncident, error)
+	Delete(id int) error
+	Summary(patientID, from, to, behaviorType string) (*BehaviorSummary, error)
+}
+
+type BehaviorIncidentService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewBehaviorIncidentService(repo *repository.Repository, scheduling config.Scheduling) BehaviorIncidentServiceInterface {
+	return &BehaviorIncidentService{repo: repo, location: scheduling.Location()}
+}
+
+// List a patient's incidents between the from and to dates, both included, earliest first
+func (s *BehaviorIncidentService) ListByPatient(patientID, from, to, behaviorType string) ([]*models.BehaviorIncident, error) {
+	if err := s.checkPatient(patientID); err != nil {
+		return nil, err
+	}
+	start, end, err := dateRange(s.location, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.BehaviorIncident.FindByPatientID(patientID, start, end, strings.TrimSpace(behaviorType))
+}
+
+// Get an incident by ID. Incidents of patients outside the caller's caseload aren't found.
+func (s *BehaviorIncidentService) GetByID(id int) (*models.BehaviorIncident, error) {
+	incident, err := s.repo.BehaviorIncident.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrIncidentNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPatient(incident.PatientID); err != nil {
+		if err.Error() == "patient not found" {
+			return nil, ErrIncidentNotFound
+		}
+		return nil, err
+	}
+	return incident, nil
+}
+
+// Create records an incident during a session, for the session's patient. It occurred at the
+// start of the session unless it says otherwise.
+func (s *BehaviorIncidentService) Create(caller *models.Viewer, sessionID string, incident *models.BehaviorIncident, now time.Time) (*models.BehaviorIncident, error) {
+	session, err := s.repo.Session.FindByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("session not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if incident.OccurredAt.IsZero() {
+		incident.OccurredAt = session.StartTime
+	}
+	if err := checkIncident(incident, now); err != nil {
+		return nil, err
+	}
+	incident.PatientID = session.PatientID
+	incident.SessionID = session.ID
+	incident.RecordedByID = caller.StaffID
+
+	if err := s.repo.BehaviorIncident.Create(incident); err != nil {
+		return nil, err
+	}
+	return incident, nil
+}
+
+// Update replaces what an incident records. Its session and patient stay the same.
+func (s *BehaviorIncidentService) Update(id int, incident *models.BehaviorIncident, now time.Time) (*models.BehaviorIncident, error) {
+	existing, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if incident.OccurredAt.IsZero() {
+		incident.OccurredAt = existing.OccurredAt
+	}
+	if err := checkIncident(incident, now); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.BehaviorIncident.Update(id, map[string]interface{}{
+		"occurred_at":         incident.OccurredAt,
+		"behavior_type":       incident.BehaviorType,
+		"intensity":           incident.Intensity,
+		"duration_seconds":    incident.DurationSeconds,
+		"setting":             incident.Setting,
+		"antecedent":          incident.Antecedent,
+		"behavior":            incident.Behavior,
+		"consequence":         incident.Consequence,
+		"staff_response":      incident.StaffResponse,
+		"function_hypothesis": incident.FunctionHypothesis,
+	}); err != nil {
+		return nil, err
+	}
+	return s.repo.BehaviorIncident.FindByID(id)
+}
+
+// Delete an incident recorded by mistake
+func (s *BehaviorIncidentService) Delete(id int) error {
+	if _, err := s.GetByID(id); err != nil {
+		return err
+	}
+	return s.repo.BehaviorIncident.Delete(id)
+}
+
+// Summary counts a patient's incidents between the from and to dates, both included. Either
+// date can be left out to leave that end of the range open.
+func (s *BehaviorIncidentService) Summary(patientID, from, to, behaviorType string) (*BehaviorSummary, error) {
+	incidents, err := s.ListByPatient(patientID, from, to, behaviorType)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &BehaviorSummary{
+		PatientID:   patientID,
+		Total:       len(incidents),
+		ByTimeOfDay: make([]*HourCount, 24),
+	}
+	if from != "" {
+		summary.From = &from
+	}
+	if to != "" {
+		summary.To = &to
+	}
+	if behaviorType = strings.TrimSpace(behaviorType); behaviorType != "" {
+		summary.BehaviorType = &behaviorType
+	}
+	for hour := range summary.ByTimeOfDay {
+		summary.ByTimeOfDay[hour] = &HourCount{Hour: hour}
+	}
+
+	antecedents := incidentCounter{}
+	functions := incidentCounter{}
+	behaviorTypes := incidentCounter{}
+	for _, incident := range incidents {
+		summary.ByTimeOfDay[incident.OccurredAt.In(s.location).Hour()].Count++
+		antecedents.add(incident.Antecedent)
+		functions.add(string(incident.FunctionHypothesis))
+		behaviorTypes.add(incident.BehaviorType)
+	}
+	summary.ByAntecedent = antecedents.counts(len(incidents))
+	summary.ByFunction = functions.counts(len(incidents))
+	summary.ByBehaviorType = behaviorTypes.counts(len(incidents))
+	return summary, nil
+}
+
+// dateRange turns from and to dates into the midnights starting from and ending to, in UTC
+// for querying. Empty dates give zero times.
+func dateRange(location *time.Location, from, to string) (time.Time, time.Time, error) {
+	var start, end time.Time
+	if from != "" {
+		day, err := time.ParseInLocation(dateLayout, from, location)
+		if err != nil {
+			return start, end, errors.New("from must look like 2026-01-31")
+		}
+		start = day.UTC()
+	}
+	if to != "" {
+		day, err := time.ParseInLocation(dateLayout, to, location)
+		if err != nil {
+			return start, end, errors.New("to must look like 2026-01-31")
+		}
+		end = day.AddDate(0, 0, 1).UTC()
+	}
+	if !start.IsZero() && !end.IsZero() && !start.Before(end) {
+		return start, end, errors.New("from must not be after to")
+	}
+	return start, end, nil
+}
+
+func (s *BehaviorIncidentService) checkPatient(patientID string) error {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("patient not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// checkIncident validates an incident, which needs all three of its antecedent, behavior and consequence
+func checkIncident(incident *models.BehaviorIncident, now time.Time) error {
+	incident.BehaviorType = strings.TrimSpace(incident.BehaviorType)
+	incident.Setting = strings.TrimSpace(incident.Setting)
+	incident.Antecedent = strings.TrimSpace(incident.Antecedent)
+	incident.Behavior = strings.TrimSpace(incident.Behavior)
+	incident.Consequence = strings.TrimSpace(incident.Consequence)
+	incident.StaffResponse = strings.TrimSpace(incident.StaffResponse)
+
+	switch {
+	case incident.BehaviorType == "":
+		return errors.New("behavior type is required")
+	case incident.Antecedent == "":
+		return errors.New("antecedent is required")
+	case incident.Behavior == "":
+		return errors.New("behavior is required")
+	case incident.Consequence == "":
+		return errors.New("consequence is required")
+	case incident.OccurredAt.After(now):
+		return errors.New("incidents can't be recorded ahead of time")
+	case incident.DurationSeconds != nil && *incident.DurationSeconds < 0:
+		return errors.New("duration can't be negative")
+	}
+
+	switch incident.Intensity {
+	case models.IntensityMild, models.IntensityModerate, models.IntensitySevere:
+	default:
+		return errors.New("intensity must be mild, moderate or severe")
+	}
+
+	if incident.FunctionHypothesis == "" {
+		incident.FunctionHypothesis = models.FunctionUnknown
+	}
+	switch incident.FunctionHypothesis {
+	case models.FunctionEscape, models.FunctionAttention, models.FunctionTangible, models.FunctionAutomatic, models.FunctionUnknown:
+		return nil
+	default:
+		return errors.New("function hypothesis must be escape, attention, tangible, automatic or unknown")
+	}
+}
+
+// incidentCounter counts incidents by a free text value, ignoring case and surrounding spaces
+type incidentCounter map[string]*IncidentCount
+
+func (c incidentCounter) add(value string) {
+	key := strings.ToLower(strings.TrimSpace(value))
+	count, ok := c[key]
+	if !ok {
+		count = &IncidentCount{Label: strings.TrimSpace(value)}
+		c[key] = count
+	}
+	count.Count++
+}
+
+// counts lists the counts most frequent first, with their share of total
+func (c incidentCounter) counts(total int) []*IncidentCount {
+	counts := make([]*IncidentCount, 0, len(c))
+	for _, count := range c {
+		count.Percent = round(float64(count.Count) * 100 / float64(total))
+		counts = append(counts, count)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		return strings.ToLower(counts[i].Label) < strings.ToLower(counts[j].Label)
+	})
+	return counts
+}
@@ -0,0 +1,188 @@
+package service
+
+// backend/internal/service/behavior_incident_service_test.go
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestCheckIncident(t *testing.T) {
+	now := time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC)
+	valid := func() models.BehaviorIncident {
+		return models.BehaviorIncident{
+			OccurredAt:   now.Add(-time.Hour),
+			BehaviorType: " Aggression ",
+			Intensity:    models.IntensityModerate,
+			Antecedent:   "Asked to tidy up",
+			Behavior:     "Threw the blocks",
+			Consequence:  "Task removed",
+		}
+	}
+	with := func(change func(incident *models.BehaviorIncident)) models.BehaviorIncident {
+		incident := valid()
+		change(&incident)
+		return incident
+	}
+	negative := -30
+
+	tests := []struct {
+		name    string
+		input   models.BehaviorIncident
+		wantErr string
+	}{
+		{name: "complete record", input: valid()},
+		{name: "no behavior type", input: with(func(i *models.BehaviorIncident) { i.BehaviorType = "  " }), wantErr: "behavior type is required"},
+		{name: "no antecedent", input: with(func(i *models.BehaviorIncident) { i.Antecedent = "" }), wantErr: "antecedent is required"},
+		{name: "no behavior", input: with(func(i *models.BehaviorIncident) { i.Behavior = "" }), wantErr: "behavior is required"},
+		{name: "no consequence", input: with(func(i *models.BehaviorIncident) { i.Consequence = "" }), wantErr: "consequence is required"},
+		{name: "ahead of time", input: with(func(i *models.BehaviorIncident) { i.OccurredAt = now.Add(time.Minute) }), wantErr: "incidents can't be recorded ahead of time"},
+		{name: "negative duration", input: with(func(i *models.BehaviorIncident) { i.DurationSeconds = &negative }), wantErr: "duration can't be negative"},
+		{name: "unknown intensity", input: with(func(i *models.BehaviorIncident) { i.Intensity = "extreme" }), wantErr: "intensity must be mild, moderate or severe"},
+		{name: "unknown function", input: with(func(i *models.BehaviorIncident) { i.FunctionHypothesis = "sensory" }), wantErr: "function hypothesis must be escape, attention, tangible, automatic or unknown"},
+	}
+	for _, tt := range tests {
+		incident := tt.input
+		err := checkIncident(&incident, now)
+		if tt.wantErr == "" {
+			if err != nil {
+				t.Errorf("%s: checkIncident error = %v", tt.name, err)
+				continue
+			}
+			if incident.BehaviorType != "Aggression" || incident.FunctionHypothesis != models.FunctionUnknown {
+				t.Errorf("%s: checked incident is %q with function %q, want it trimmed with an unknown function", tt.name, incident.BehaviorType, incident.FunctionHypothesis)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.wantErr {
+			t.Errorf("%s: checkIncident error = %v, want %s", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestBehaviorSummary(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	other, _ := createTestPatient(t, repo)
+	service := NewBehaviorIncidentService(repo, config.Scheduling{Timezone: "UTC"})
+	caller := &models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist}
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+
+	record := func(patientID string, at time.Time, behaviorType, antecedent string, function models.BehaviorFunction) {
+		t.Helper()
+		start := at.Truncate(time.Hour)
+		session := &models.Session{ID: uuid.NewString(), PatientID: patientID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(time.Hour)}
+		if err := repo.Session.Create(session); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.Create(caller, session.ID, &models.BehaviorIncident{
+			OccurredAt:         at,
+			BehaviorType:       behaviorType,
+			Intensity:          models.IntensityMild,
+			Antecedent:         antecedent,
+			Behavior:           "Observed",
+			Consequence:        "Redirected",
+			FunctionHypothesis: function,
+		}, now); err != nil {
+			t.Fatal(err)
+		}
+	}
+	at := func(day, hour int) time.Time { return time.Date(2026, 3, day, hour, 15, 0, 0, time.UTC) }
+	record(patient.ID, at(2, 10), "Aggression", "Demand placed", models.FunctionEscape)
+	record(patient.ID, at(3, 10), "aggression ", " demand placed", models.FunctionEscape)
+	record(patient.ID, at(4, 15), "Elopement", "Transition", models.FunctionEscape)
+	record(patient.ID, at(10, 15), "Aggression", "Toy removed", models.FunctionTangible)
+	record(other.ID, at(3, 10), "Aggression", "Demand placed", models.FunctionEscape)
+
+	type count struct {
+		label   string
+		count   int
+		percent float64
+	}
+	tests := []struct {
+		name         string
+		from, to     string
+		behaviorType string
+		total        int
+		hours        map[int]int
+		antecedents  []count
+		functions    []count
+		types        []count
+		wantErr      bool
+	}{
+		{
+			name:        "every incident",
+			total:       4,
+			hours:       map[int]int{10: 2, 15: 2},
+			antecedents: []count{{"Demand placed", 2, 50}, {"Toy removed", 1, 25}, {"Transition", 1, 25}},
+			functions:   []count{{"escape", 3, 75}, {"tangible", 1, 25}},
+			types:       []count{{"Aggression", 3, 75}, {"Elopement", 1, 25}},
+		},
+		{
+			name:        "between dates, both included",
+			from:        "2026-03-03",
+			to:          "2026-03-04",
+			total:       2,
+			hours:       map[int]int{10: 1, 15: 1},
+			antecedents: []count{{"demand placed", 1, 50}, {"Transition", 1, 50}},
+			functions:   []count{{"escape", 2, 100}},
+			types:       []count{{"aggression", 1, 50}, {"Elopement", 1, 50}},
+		},
+		{
+			name:         "one behavior type",
+			behaviorType: "Elopement",
+			total:        1,
+			hours:        map[int]int{15: 1},
+			antecedents:  []count{{"Transition", 1, 100}},
+			functions:    []count{{"escape", 1, 100}},
+			types:        []count{{"Elopement", 1, 100}},
+		},
+		{name: "no incidents", from: "2026-03-11", hours: map[int]int{}},
+		{name: "from after to", from: "2026-03-05", to: "2026-03-04", wantErr: true},
+		{name: "not a date", from: "March", wantErr: true},
+	}
+	for _, tt := range tests {
+		summary, err := service.Summary(patient.ID, tt.from, tt.to, tt.behaviorType)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Summary error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if summary.Total != tt.total || len(summary.ByTimeOfDay) != 24 {
+			t.Errorf("%s: %d incidents over %d hours, want %d over 24", tt.name, summary.Total, len(summary.ByTimeOfDay), tt.total)
+			continue
+		}
+		for _, hour := range summary.ByTimeOfDay {
+			if hour.Count != tt.hours[hour.Hour] {
+				t.Errorf("%s: %d incidents at %d:00, want %d", tt.name, hour.Count, hour.Hour, tt.hours[hour.Hour])
+			}
+		}
+		for _, c := range []struct {
+			by   string
+			got  []*IncidentCount
+			want []count
+		}{
+			{"antecedent", summary.ByAntecedent, tt.antecedents},
+			{"function", summary.ByFunction, tt.functions},
+			{"behavior type", summary.ByBehaviorType, tt.types},
+		} {
+			if len(c.got) != len(c.want) {
+				t.Errorf("%s: %d counts by %s, want %d", tt.name, len(c.got), c.by, len(c.want))
+				continue
+			}
+			for i, want := range c.want {
+				if got := c.got[i]; got.Label != want.label || got.Count != want.count || got.Percent != want.percent {
+					t.Errorf("%s: by %s %d = %s %d (%v%%), want %s %d (%v%%)", tt.name, c.by, i,
+						got.Label, got.Count, got.Percent, want.label, want.count, want.percent)
+				}
+			}
+		}
+	}
+}
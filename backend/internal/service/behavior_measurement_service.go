@@ -0,0 +1,414 @@
+package service
+
+// backend/internal/service/behavior_measurement_service.go
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrMeasurementNotFound = errors.New("behavior measurement not found")
+	ErrDataPointNotFound   = errors.New("data point not found")
+	ErrMeasurementRecorded = errors.New("behavior measurements with data points can't be deleted; deactivate them instead")
+	ErrMeasurementMethod   = errors.New("the method and interval of a behavior measurement with data points can't be changed")
+	ErrMeasurementInactive = errors.New("behavior measurement is inactive")
+	ErrDataPointExists     = errors.New("the session already has a data point for this measurement")
+)
+
+// ScoredDataPoint is a data point with the figure it's graphed by, which depends on its
+// measurement's method. The figures of other methods are nil.
+type ScoredDataPoint struct {
+	DataPoint          *models.MeasurementDataPoint `json:"data_point"`
+	SessionStart       time.Time                    `json:"session_start"`
+	RatePerHour        *float64                     `json:"rate_per_hour"`        // Frequency
+	PercentOfTime      *float64                     `json:"percent_of_time"`      // Duration, of the time observed
+	PercentOfIntervals *float64                     `json:"percent_of_intervals"` // Partial and whole interval
+}
+
+// MeasurementGraph is a measurement with its data points, in the order their sessions started
+type MeasurementGraph struct {
+	Measurement *models.BehaviorMeasurement `json:"measurement"`
+	Points      []*ScoredDataPoint          `json:"points"`
+}
+
+type BehaviorMeasurementServiceInterface interface {
+	ListByPatient(patientID string) ([]*models.BehaviorMeasurement, error)
+	Get(id int) (*models.BehaviorMeasurement, error)
+	Create(caller *models.Viewer, patientID string, measurement *models.BehaviorMeasurement) (*models.BehaviorMeasurement, error)
+	Update(id int, measurement *models.BehaviorMeasurement) (*models.BehaviorMeasurement, error)
+	Delete(id int) error
+	ListBySession(sessionID string) ([]*ScoredDataPoint, error)
+	GetDataPoint(id int) (*models.MeasurementDataPoint, error)
+	Record(caller *models.Viewer, sessionID string, point *models.MeasurementDataPoint, now time.Time) (*ScoredDataPoint, error)
+	UpdateDataPoint(id int, point *models.MeasurementDataPoint) (*ScoredDataPoint, error)
+	DeleteDataPoint(id int) error
+	Graph(measurementID int, from, to string) (*MeasurementGraph, error)
+}
+
+type BehaviorMeasurementService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewBehaviorMeasurementService(repo *repository.Repository, scheduling config.Scheduling) BehaviorMeasurementServiceInterface {
+	return &BehaviorMeasurementService{repo: repo, location: scheduling.Location()}
+}
+
+// List a patient's behavior measurements, active ones first
+func (s *BehaviorMeasurementService) ListByPatient(patientID string) ([]*models.BehaviorMeasurement, error) {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+	return s.repo.BehaviorMeasurement.FindByPatientID(patientID)
+}
+
+// Get a behavior measurement. Measurements of patients outside the caller's caseload aren't found.
+func (s *BehaviorMeasurementService) Get(id int) (*models.BehaviorMeasurement, error) {
+	measurement, err := s.repo.BehaviorMeasurement.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMeasurementNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.Patient.FindByID(measurement.PatientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMeasurementNotFound
+		}
+		return nil, err
+	}
+	return measurement, nil
+}
+
+// Create defines a new measurement of one of a patient's behaviors
+func (s *BehaviorMeasurementService) Create(caller *models.Viewer, patientID string, measurement *models.BehaviorMeasurement) (*models.BehaviorMeasurement, error) {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+	if err := checkMeasurement(measurement); err != nil {
+		return nil, err
+	}
+
+	measurement.PatientID = patientID
+	measurement.Active = true
+	measurement.CreatedByID = caller.StaffID
+	if err := s.repo.BehaviorMeasurement.Create(measurement); err != nil {
+		return nil, err
+	}
+	return measurement, nil
+}
+
+// Update replaces a measurement's definition. Once sessions have recorded it, its method and
+// interval stay as they are so its data points remain comparable.
+func (s *BehaviorMeasurementService) Update(id int, measurement *models.BehaviorMeasurement) (*models.BehaviorMeasurement, error) {
+	existing, err := s.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkMeasurement(measurement); err != nil {
+		return nil, err
+	}
+
+	if measurement.Method != existing.Method || !sameInterval(measurement.IntervalSeconds, existing.IntervalSeconds) {
+		recorded, err := s.repo.BehaviorMeasurement.HasDataPoints(id)
+		if err != nil {
+			return nil, err
+		}
+		if recorded {
+			return nil, ErrMeasurementMethod
+		}
+	}
+
+	if err := s.repo.BehaviorMeasurement.Update(id, map[string]interface{}{
+		"behavior":         measurement.Behavior,
+		"definition":       measurement.Definition,
+		"method":           measurement.Method,
+		"interval_seconds": measurement.IntervalSeconds,
+		"active":           measurement.Active,
+	}); err != nil {
+		return nil, err
+	}
+	return s.repo.BehaviorMeasurement.FindByID(id)
+}
+
+// Delete a measurement no session has recorded
+func (s *BehaviorMeasurementService) Delete(id int) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+	recorded, err := s.repo.BehaviorMeasurement.HasDataPoints(id)
+	if err != nil {
+		return err
+	}
+	if recorded {
+		return ErrMeasurementRecorded
+	}
+	return s.repo.BehaviorMeasurement.Delete(id)
+}
+
+// ListBySession lists the data points a session recorded, scored
+func (s *BehaviorMeasurementService) ListBySession(sessionID string) ([]*ScoredDataPoint, error) {
+	if _, err := s.repo.Session.FindByID(sessionID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("session not found")
+		}
+		return nil, err
+	}
+	points, err := s.repo.BehaviorMeasurement.FindDataPointsBySessionID(sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	scored := make([]*ScoredDataPoint, 0, len(points))
+	for _, point := range points {
+		measurement, err := s.repo.BehaviorMeasurement.FindByID(point.MeasurementID)
+		if err != nil {
+			return nil, err
+		}
+		scored = append(scored, scoreDataPoint(measurement, point))
+	}
+	return scored, nil
+}
+
+// GetDataPoint gets a data point with its session. Data points of patients outside the
+// caller's caseload aren't found.
+func (s *BehaviorMeasurementService) GetDataPoint(id int) (*models.MeasurementDataPoint, error) {
+	point, err := s.repo.BehaviorMeasurement.FindDataPointByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDataPointNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.Get(point.MeasurementID); err != nil {
+		if errors.Is(err, ErrMeasurementNotFound) {
+			return nil, ErrDataPointNotFound
+		}
+		return nil, err
+	}
+	return point, nil
+}
+
+// Record adds a session's data point for one of its patient's active measurements. The
+// behavior is taken to have been observed for the whole session, or for every interval when
+// it's recorded by interval, unless the data point says otherwise.
+func (s *BehaviorMeasurementService) Record(caller *models.Viewer, sessionID string, point *models.MeasurementDataPoint, now time.Time) (*ScoredDataPoint, error) {
+	session, err := s.repo.Session.FindByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("session not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if session.StartTime.After(now) {
+		return nil, errors.New("data points can't be recorded before the session starts")
+	}
+
+	measurement, err := s.repo.BehaviorMeasurement.FindByID(point.MeasurementID)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && measurement.PatientID != session.PatientID) {
+		return nil, ErrMeasurementNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !measurement.Active {
+		return nil, ErrMeasurementInactive
+	}
+	if _, err := s.repo.BehaviorMeasurement.FindDataPoint(measurement.ID, sessionID); err == nil {
+		return nil, ErrDataPointExists
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	if err := checkDataPoint(measurement, point, session); err != nil {
+		return nil, err
+	}
+	point.SessionID = sessionID
+	point.RecordedByID = caller.StaffID
+	if err := s.repo.BehaviorMeasurement.CreateDataPoint(point); err != nil {
+		return nil, err
+	}
+	point.Session = session
+	return scoreDataPoint(measurement, point), nil
+}
+
+// UpdateDataPoint replaces the figures a data point records. It stays with its measurement and session.
+func (s *BehaviorMeasurementService) UpdateDataPoint(id int, point *models.MeasurementDataPoint) (*ScoredDataPoint, error) {
+	existing, err := s.GetDataPoint(id)
+	if err != nil {
+		return nil, err
+	}
+	measurement, err := s.repo.BehaviorMeasurement.FindByID(existing.MeasurementID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDataPoint(measurement, point, existing.Session); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.BehaviorMeasurement.UpdateDataPoint(id, map[string]interface{}{
+		"observation_seconds": point.ObservationSeconds,
+		"count":               point.Count,
+		"duration_seconds":    point.DurationSeconds,
+		"intervals":           point.Intervals,
+		"intervals_scored":    point.IntervalsScored,
+	}); err != nil {
+		return nil, err
+	}
+	updated, err := s.repo.BehaviorMeasurement.FindDataPointByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return scoreDataPoint(measurement, updated), nil
+}
+
+// Delete a data point recorded by mistake
+func (s *BehaviorMeasurementService) DeleteDataPoint(id int) error {
+	if _, err := s.GetDataPoint(id); err != nil {
+		return err
+	}
+	return s.repo.BehaviorMeasurement.DeleteDataPoint(id)
+}
+
+// Graph scores a measurement's data points from sessions starting between the from and to
+// dates, both included. Either date can be left out to leave that end of the range open.
+func (s *BehaviorMeasurementService) Graph(measurementID int, from, to string) (*MeasurementGraph, error) {
+	measurement, err := s.Get(measurementID)
+	if err != nil {
+		return nil, err
+	}
+	start, end, err := dateRange(s.location, from, to)
+	if err != nil {
+		return nil, err
+	}
+	points, err := s.repo.BehaviorMeasurement.FindDataPointsByMeasurementID(measurementID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	graph := &MeasurementGraph{Measurement: measurement, Points: make([]*ScoredDataPoint, 0, len(points))}
+	for _, point := range points {
+		graph.Points = append(graph.Points, scoreDataPoint(measurement, point))
+	}
+	return graph, nil
+}
+
+// checkMeasurement validates a measurement. Only interval recording has an interval length.
+func checkMeasurement(measurement *models.BehaviorMeasurement) error {
+	measurement.Behavior = strings.TrimSpace(measurement.Behavior)
+	if measurement.Behavior == "" {
+		return errors.New("behavior is required")
+	}
+
+	switch measurement.Method {
+	case models.MeasureFrequency, models.MeasureDuration:
+		if measurement.IntervalSeconds != nil {
+			return errors.New("only interval measurements have an interval length")
+		}
+	case models.MeasurePartialInterval, models.MeasureWholeInterval:
+		if measurement.IntervalSeconds == nil || *measurement.IntervalSeconds < 1 {
+			return errors.New("interval measurements need an interval length of at least a second")
+		}
+	default:
+		return errors.New("method must be frequency, duration, partial_interval or whole_interval")
+	}
+	return nil
+}
+
+func sameInterval(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// checkDataPoint validates a data point against its measurement's method, defaulting how long
+// the behavior was observed for from the session or the intervals
+func checkDataPoint(measurement *models.BehaviorMeasurement, point *models.MeasurementDataPoint, session *models.Session) error {
+	for _, figure := range []*int{point.Count, point.DurationSeconds, point.Intervals, point.IntervalsScored} {
+		if figure != nil && *figure < 0 {
+			return errors.New("data points can't be negative")
+		}
+	}
+	if point.ObservationSeconds < 0 {
+		return errors.New("observation time can't be negative")
+	}
+
+	switch measurement.Method {
+	case models.MeasureFrequency:
+		if point.Count == nil {
+			return errors.New("frequency measurements record a count")
+		}
+		if point.DurationSeconds != nil || point.Intervals != nil || point.IntervalsScored != nil {
+			return errors.New("frequency measurements only record a count")
+		}
+	case models.MeasureDuration:
+		if point.DurationSeconds == nil {
+			return errors.New("duration measurements record a duration")
+		}
+		if point.Count != nil || point.Intervals != nil || point.IntervalsScored != nil {
+			return errors.New("duration measurements only record a duration")
+		}
+	default:
+		if point.Intervals == nil || point.IntervalsScored == nil || *point.Intervals == 0 {
+			return errors.New("interval measurements record the intervals observed and scored")
+		}
+		if point.Count != nil || point.DurationSeconds != nil {
+			return errors.New("interval measurements only record intervals")
+		}
+		if *point.IntervalsScored > *point.Intervals {
+			return errors.New("intervals scored can't be more than the intervals observed")
+		}
+		if point.ObservationSeconds == 0 {
+			point.ObservationSeconds = *point.Intervals * *measurement.IntervalSeconds
+		}
+	}
+
+	if point.ObservationSeconds == 0 {
+		point.ObservationSeconds = int(session.EndTime.Sub(session.StartTime).Seconds())
+	}
+	if point.ObservationSeconds <= 0 {
+		return errors.New("observation time is required when the session has no length")
+	}
+	if point.DurationSeconds != nil && *point.DurationSeconds > point.ObservationSeconds {
+		return errors.New("duration can't be longer than the observation time")
+	}
+	return nil
+}
+
+// scoreDataPoint works out the figure a data point is graphed by: the rate per hour of a count,
+// the share of the observation a duration took up, or the share of intervals scored
+func scoreDataPoint(measurement *models.BehaviorMeasurement, point *models.MeasurementDataPoint) *ScoredDataPoint {
+	scored := &ScoredDataPoint{DataPoint: point}
+	if point.Session != nil {
+		scored.SessionStart = point.Session.StartTime
+	}
+
+	switch {
+	case measurement.Method == models.MeasureFrequency && point.Count != nil && point.ObservationSeconds > 0:
+		rate := round(float64(*point.Count) * 3600 / float64(point.ObservationSeconds))
+		scored.RatePerHour = &rate
+	case measurement.Method == models.MeasureDuration && point.DurationSeconds != nil && point.ObservationSeconds > 0:
+		percent := round(float64(*point.DurationSeconds) * 100 / float64(point.ObservationSeconds))
+		scored.PercentOfTime = &percent
+	case point.Intervals != nil && point.IntervalsScored != nil && *point.Intervals > 0:
+		percent := round(float64(*point.IntervalsScored) * 100 / float64(*point.Intervals))
+		scored.PercentOfIntervals = &percent
+	}
+	return scored
+}
@@ -0,0 +1,101 @@
+package service
+
+// backend/internal/service/behavior_measurement_service_test.go
+
+import (
+	"testing"
+	"time"
+
+	"palaam/internal/models"
+)
+
+func TestScoreDataPoint(t *testing.T) {
+	frequency := &models.BehaviorMeasurement{Method: models.MeasureFrequency}
+	duration := &models.BehaviorMeasurement{Method: models.MeasureDuration}
+	partial := &models.BehaviorMeasurement{Method: models.MeasurePartialInterval, IntervalSeconds: intPointer(10)}
+
+	tests := []struct {
+		name        string
+		measurement *models.BehaviorMeasurement
+		point       models.MeasurementDataPoint
+		rate        *float64
+		time        *float64
+		intervals   *float64
+	}{
+		{"count over an hour", frequency, models.MeasurementDataPoint{Count: intPointer(6), ObservationSeconds: 3600}, floatPointer(6), nil, nil},
+		{"count over 45 minutes", frequency, models.MeasurementDataPoint{Count: intPointer(5), ObservationSeconds: 2700}, floatPointer(6.67), nil, nil},
+		{"count with no observation", frequency, models.MeasurementDataPoint{Count: intPointer(5)}, nil, nil, nil},
+		{"duration", duration, models.MeasurementDataPoint{DurationSeconds: intPointer(90), ObservationSeconds: 1800}, nil, floatPointer(5), nil},
+		{"duration throughout", duration, models.MeasurementDataPoint{DurationSeconds: intPointer(600), ObservationSeconds: 600}, nil, floatPointer(100), nil},
+		{"intervals", partial, models.MeasurementDataPoint{Intervals: intPointer(30), IntervalsScored: intPointer(7), ObservationSeconds: 300}, nil, nil, floatPointer(23.33)},
+		{"no intervals scored", partial, models.MeasurementDataPoint{Intervals: intPointer(30), IntervalsScored: intPointer(0), ObservationSeconds: 300}, nil, nil, floatPointer(0)},
+	}
+	for _, tt := range tests {
+		scored := scoreDataPoint(tt.measurement, &tt.point)
+		for _, figure := range []struct {
+			name      string
+			got, want *float64
+		}{
+			{"rate per hour", scored.RatePerHour, tt.rate},
+			{"percent of time", scored.PercentOfTime, tt.time},
+			{"percent of intervals", scored.PercentOfIntervals, tt.intervals},
+		} {
+			if (figure.got == nil) != (figure.want == nil) || (figure.got != nil && *figure.got != *figure.want) {
+				t.Errorf("%s: %s = %v, want %v", tt.name, figure.name, show(figure.got), show(figure.want))
+			}
+		}
+	}
+}
+
+func TestCheckDataPoint(t *testing.T) {
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	session := &models.Session{StartTime: start, EndTime: start.Add(45 * time.Minute)}
+	frequency := &models.BehaviorMeasurement{Method: models.MeasureFrequency}
+	duration := &models.BehaviorMeasurement{Method: models.MeasureDuration}
+	whole := &models.BehaviorMeasurement{Method: models.MeasureWholeInterval, IntervalSeconds: intPointer(15)}
+
+	tests := []struct {
+		name        string
+		measurement *models.BehaviorMeasurement
+		point       models.MeasurementDataPoint
+		observed    int // Observation seconds once defaulted
+		wantErr     bool
+	}{
+		{"count observed for the session", frequency, models.MeasurementDataPoint{Count: intPointer(3)}, 2700, false},
+		{"count observed for part of it", frequency, models.MeasurementDataPoint{Count: intPointer(3), ObservationSeconds: 600}, 600, false},
+		{"count missing", frequency, models.MeasurementDataPoint{}, 0, true},
+		{"count with a duration", frequency, models.MeasurementDataPoint{Count: intPointer(3), DurationSeconds: intPointer(5)}, 0, true},
+		{"negative count", frequency, models.MeasurementDataPoint{Count: intPointer(-1)}, 0, true},
+		{"duration", duration, models.MeasurementDataPoint{DurationSeconds: intPointer(120)}, 2700, false},
+		{"duration longer than observed", duration, models.MeasurementDataPoint{DurationSeconds: intPointer(700), ObservationSeconds: 600}, 0, true},
+		{"intervals observed for their length", whole, models.MeasurementDataPoint{Intervals: intPointer(40), IntervalsScored: intPointer(12)}, 600, false},
+		{"more intervals scored than observed", whole, models.MeasurementDataPoint{Intervals: intPointer(10), IntervalsScored: intPointer(12)}, 0, true},
+		{"no intervals", whole, models.MeasurementDataPoint{Intervals: intPointer(0), IntervalsScored: intPointer(0)}, 0, true},
+		{"intervals with a count", whole, models.MeasurementDataPoint{Intervals: intPointer(10), IntervalsScored: intPointer(2), Count: intPointer(2)}, 0, true},
+	}
+	for _, tt := range tests {
+		err := checkDataPoint(tt.measurement, &tt.point, session)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: checkDataPoint() error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err == nil && tt.point.ObservationSeconds != tt.observed {
+			t.Errorf("%s: observed for %d seconds, want %d", tt.name, tt.point.ObservationSeconds, tt.observed)
+		}
+	}
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func floatPointer(value float64) *float64 {
+	return &value
+}
+
+func show(value *float64) interface{} {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
@@ -0,0 +1,253 @@
+package service
+
+// backend/internal/service/branch_service.go
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var ErrBranchNotFound = errors.New("branch not found")
+
+// OutsideHoursError is returned for a session outside its branch's operating hours
+type OutsideHoursError struct {
+	BranchID int
+	Hours    *models.OperatingHours
+}
+
+func (e *OutsideHoursError) Error() string {
+	day := time.Weekday(e.Hours.DayOfWeek)
+	if e.Hours.IsClosed {
+		return fmt.Sprintf("branch %d is closed on %ss", e.BranchID, day)
+	}
+	return fmt.Sprintf("branch %d is open from %s to %s on %ss", e.BranchID, e.Hours.OpenTime, e.Hours.CloseTime, day)
+}
+
+// respond writes the error as a 400 with the hours the session conflicts with
+func (e *OutsideHoursError) respond(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":       e.Error(),
+		"branch_id":   e.BranchID,
+		"day_of_week": e.Hours.DayOfWeek,
+		"open_time":   e.Hours.OpenTime,
+		"close_time":  e.Hours.CloseTime,
+		"is_closed":   e.Hours.IsClosed,
+	})
+}
+
+type BranchServiceInterface interface {
+	List() ([]*models.Branch, error)
+	Create(branch *models.Branch) (*models.Branch, error)
+	GetByID(id int) (*models.Branch, error)
+	Update(id int, updates map[string]interface{}) (*models.Branch, error)
+	Delete(id int) error
+
+	Hours(id int) ([]*models.OperatingHours, error)
+	SetHours(id int, hours []*models.OperatingHours) ([]*models.OperatingHours, error)
+}
+
+type BranchService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewBranchService(repo *repository.Repository, scheduling config.Scheduling) BranchServiceInterface {
+	return &BranchService{repo: repo, location: scheduling.Location()}
+}
+
+// List all branches
+func (s *BranchService) List() ([]*models.Branch, error) {
+	return s.repo.Branch.ListBranches()
+}
+
+// Create a new branch
+func (s *BranchService) Create(branch *models.Branch) (*models.Branch, error) {
+	if branch.HoursPolicy == "" {
+		branch.HoursPolicy = models.HoursEnforce
+	}
+	if err := checkHoursPolicy(branch.HoursPolicy); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Branch.Create(branch); err != nil {
+		return nil, err
+	}
+	return branch, nil
+}
+
+// Get a branch by ID
+func (s *BranchService) GetByID(id int) (*models.Branch, error) {
+	branch, err := s.repo.Branch.GetBranchByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrBranchNotFound
+	}
+	return branch, err
+}
+
+// Update a branch
+func (s *BranchService) Update(id int, updates map[string]interface{}) (*models.Branch, error) {
+	if _, err := s.GetByID(id); err != nil {
+		return nil, err
+	}
+
+	delete(updates, "id")
+	if policy, ok := updates["hours_policy"]; ok {
+		if err := checkHoursPolicy(models.HoursPolicy(fmt.Sprint(policy))); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.repo.Branch.Update(id, updates); err != nil {
+		return nil, err
+	}
+	return s.GetByID(id)
+}
+
+// Delete a branch
+func (s *BranchService) Delete(id int) error {
+	_, err := s.repo.Branch.DeleteBranch(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrBranchNotFound
+	}
+	return err
+}
+
+// Hours lists a branch's operating hours by day of the week
+func (s *BranchService) Hours(id int) ([]*models.OperatingHours, error) {
+	if _, err := s.GetByID(id); err != nil {
+		return nil, err
+	}
+	return s.repo.OperatingHours.FindByBranch(id)
+}
+
+// SetHours replaces a branch's weekly operating hours. Days left out aren't restricted.
+func (s *BranchService) SetHours(id int, hours []*models.OperatingHours) ([]*models.OperatingHours, error) {
+	if _, err := s.GetByID(id); err != nil {
+		return nil, err
+	}
+
+	days := map[int16]bool{}
+	for _, day := range hours {
+		if day.DayOfWeek < 0 || day.DayOfWeek > 6 {
+			return nil, errors.New("day of week must be from 0 for Sunday to 6 for Saturday")
+		}
+		if days[day.DayOfWeek] {
+			return nil, fmt.Errorf("hours for %s are given twice", time.Weekday(day.DayOfWeek))
+		}
+		days[day.DayOfWeek] = true
+
+		day.BranchID = id
+		if day.IsClosed {
+			day.OpenTime, day.CloseTime = "", ""
+			continue
+		}
+		opening, err := minuteOfDay(day.OpenTime)
+		if err != nil {
+			return nil, err
+		}
+		closing, err := minuteOfDay(day.CloseTime)
+		if err != nil {
+			return nil, err
+		}
+		if closing <= opening {
+			return nil, fmt.Errorf("hours for %s must close after they open", time.Weekday(day.DayOfWeek))
+		}
+	}
+
+	err := s.repo.Transaction(func(repo *repository.Repository) error {
+		existing, err := repo.OperatingHours.FindByBranch(id)
+		if err != nil {
+			return err
+		}
+		for _, day := range existing {
+			if err := repo.OperatingHours.Delete(id, day.DayOfWeek); err != nil {
+				return err
+			}
+		}
+		for _, day := range hours {
+			if err := repo.OperatingHours.Create(day); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.OperatingHours.FindByBranch(id)
+}
+
+// checkOperatingHours checks a session from start to end against the hours of its branch's
+// weekday in loc. Sessions without a branch, and days without hours, aren't restricted.
+func checkOperatingHours(repo *repository.Repository, loc *time.Location, branchID *int, start, end time.Time) ([]string, error) {
+	if branchID == nil {
+		return nil, nil
+	}
+	branch, err := repo.Branch.GetBranchByID(*branchID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrBranchNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	start, end = start.In(loc), end.In(loc)
+	hours, err := repo.OperatingHours.FindByBranchAndDay(branch.ID, int16(start.Weekday()))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	outside := hours.IsClosed
+	if !outside {
+		opening, err := minuteOfDay(hours.OpenTime)
+		if err != nil {
+			return nil, err
+		}
+		closing, err := minuteOfDay(hours.CloseTime)
+		if err != nil {
+			return nil, err
+		}
+		// Minutes are counted from the start's midnight, so sessions running past midnight end after close
+		days := int(time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC).
+			Sub(time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24)
+		from := start.Hour()*60 + start.Minute()
+		to := days*24*60 + end.Hour()*60 + end.Minute()
+		outside = from < opening || to > closing
+	}
+	if !outside {
+		return nil, nil
+	}
+
+	violation := &OutsideHoursError{BranchID: branch.ID, Hours: hours}
+	if branch.HoursPolicy == models.HoursWarn {
+		return []string{violation.Error()}, nil
+	}
+	return nil, violation
+}
+
+// minuteOfDay reads a time such as 09:30 as minutes after midnight
+func minuteOfDay(value string) (int, error) {
+	parsed, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("time %q must look like 09:30", value)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func checkHoursPolicy(policy models.HoursPolicy) error {
+	switch policy {
+	case models.HoursEnforce, models.HoursWarn:
+		return nil
+	default:
+		return errors.New("hours policy must be enforce or warn")
+	}
+}
@@ -0,0 +1,275 @@
+package service
+
+// backend/internal/service/calendar_service.go
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/auth"
+	"palaam/internal/ical"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+	ErrInvalidFeedToken     = errors.New("invalid or revoked calendar feed token")
+	ErrFeedNotAllowed       = errors.New("only admins can manage another staff member's calendar feeds")
+)
+
+// feedHistory is how far back feeds list past sessions
+const feedHistory = 90 * 24 * time.Hour
+
+type CalendarServiceInterface interface {
+	StaffFeeds(caller *models.Viewer, staffID string) ([]*models.CalendarFeed, error)
+	PatientFeeds(patientID string) ([]*models.CalendarFeed, error)
+	CreateStaffFeed(caller *models.Viewer, staffID string) (*models.CalendarFeed, string, error)
+	CreatePatientFeed(caller *models.Viewer, patientID string) (*models.CalendarFeed, string, error)
+	Revoke(caller *models.Viewer, id int) error
+
+	Open(token string) (*models.CalendarFeed, error)
+	StaffCalendar(staffID string, now time.Time) (*ical.Calendar, error)
+	PatientCalendar(patientID string, now time.Time) (*ical.Calendar, error)
+}
+
+type CalendarService struct {
+	repo *repository.Repository
+}
+
+func NewCalendarService(repo *repository.Repository) CalendarServiceInterface {
+	return &CalendarService{repo: repo}
+}
+
+// StaffFeeds lists a staff member's calendar feeds, including revoked ones
+func (s *CalendarService) StaffFeeds(caller *models.Viewer, staffID string) ([]*models.CalendarFeed, error) {
+	if err := s.checkStaff(caller, staffID); err != nil {
+		return nil, err
+	}
+	return s.repo.CalendarFeed.FindByStaffID(staffID)
+}
+
+// PatientFeeds lists a patient's calendar feeds, including revoked ones
+func (s *CalendarService) PatientFeeds(patientID string) ([]*models.CalendarFeed, error) {
+	if err := s.checkPatient(patientID); err != nil {
+		return nil, err
+	}
+	return s.repo.CalendarFeed.FindByPatientID(patientID)
+}
+
+// CreateStaffFeed creates a feed of a staff member's sessions and returns its token.
+// Staff can create feeds for themselves; admins for anyone.
+func (s *CalendarService) CreateStaffFeed(caller *models.Viewer, staffID string) (*models.CalendarFeed, string, error) {
+	if err := s.checkStaff(caller, staffID); err != nil {
+		return nil, "", err
+	}
+	return s.create(&models.CalendarFeed{StaffID: &staffID, CreatedByID: caller.StaffID})
+}
+
+// CreatePatientFeed creates a feed of a patient's sessions and returns its token. The feed
+// only shows the sessions the caller can see, for as long as they can see them.
+func (s *CalendarService) CreatePatientFeed(caller *models.Viewer, patientID string) (*models.CalendarFeed, string, error) {
+	if err := s.checkPatient(patientID); err != nil {
+		return nil, "", err
+	}
+	return s.create(&models.CalendarFeed{PatientID: &patientID, CreatedByID: caller.StaffID})
+}
+
+// Revoke a feed, so its URL stops working. Feeds can be revoked by whoever created them,
+// the staff member whose sessions they show, or an admin.
+func (s *CalendarService) Revoke(caller *models.Viewer, id int) error {
+	feed, err := s.repo.CalendarFeed.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	allowed := caller.Role == models.RoleAdmin || feed.CreatedByID == caller.StaffID ||
+		(feed.StaffID != nil && *feed.StaffID == caller.StaffID)
+	if !allowed {
+		return ErrFeedNotAllowed
+	}
+	if feed.RevokedAt != nil {
+		return nil
+	}
+	return s.repo.CalendarFeed.Update(id, map[string]interface{}{"revoked_at": time.Now()})
+}
+
+// Open finds the feed a token belongs to, with the staff member who created it, and
+// records that it was used. Revoked feeds aren't found.
+func (s *CalendarService) Open(token string) (*models.CalendarFeed, error) {
+	if token == "" {
+		return nil, ErrInvalidFeedToken
+	}
+	feed, err := s.repo.CalendarFeed.FindByTokenHash(auth.HashFeedToken(token))
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvalidFeedToken
+	}
+	if err != nil {
+		return nil, err
+	}
+	if feed.RevokedAt != nil {
+		return nil, ErrInvalidFeedToken
+	}
+
+	now := time.Now()
+	if err := s.repo.CalendarFeed.Update(feed.ID, map[string]interface{}{"last_used_at": now}); err != nil {
+		return nil, err
+	}
+	feed.LastUsedAt = &now
+	return feed, nil
+}
+
+// StaffCalendar lists a staff member's sessions from feedHistory ago as calendar events. Patients
+// are named by their initials, since staff calendars are often shared or shown on lock screens.
+func (s *CalendarService) StaffCalendar(staffID string, now time.Time) (*ical.Calendar, error) {
+	staff, err := s.repo.Staff.FindByID(staffID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("staff member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.repo.Session.FindByStaffID(staffID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{}
+	return s.calendar(staff.Name, sessions, now, func(session *models.Session) (string, error) {
+		if _, ok := names[session.PatientID]; !ok {
+			patient, err := s.repo.Patient.FindByID(session.PatientID)
+			if err != nil {
+				return "", err
+			}
+			names[session.PatientID] = initials(patient.Name)
+		}
+		return "Session with " + names[session.PatientID], nil
+	})
+}
+
+// PatientCalendar lists a patient's sessions from feedHistory ago as calendar events
+func (s *CalendarService) PatientCalendar(patientID string, now time.Time) (*ical.Calendar, error) {
+	patient, err := s.repo.Patient.FindByID(patientID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("patient not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.repo.Session.FindByPatientID(patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := map[string]string{}
+	return s.calendar(patient.Name, sessions, now, func(session *models.Session) (string, error) {
+		if _, ok := names[session.StaffID]; !ok {
+			staff, err := s.repo.Staff.FindByID(session.StaffID)
+			if err != nil {
+				return "", err
+			}
+			names[session.StaffID] = staff.Name
+		}
+		return "Session with " + names[session.StaffID], nil
+	})
+}
+
+// calendar turns sessions into events identified by their session ID, so moved sessions
+// update in place and deleted ones disappear. Sessions flagged by a branch closure are
+// tentative until they're rescheduled.
+func (s *CalendarService) calendar(name string, sessions []*models.Session, now time.Time, summary func(*models.Session) (string, error)) (*ical.Calendar, error) {
+	slices.SortFunc(sessions, func(a, b *models.Session) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+
+	locations := map[int]string{}
+	calendar := &ical.Calendar{Name: "Paalam: " + name}
+	for _, session := range sessions {
+		if session.EndTime.Before(now.Add(-feedHistory)) {
+			continue
+		}
+		event := ical.Event{
+			UID:       session.ID + "@paalam",
+			Start:     session.StartTime,
+			End:       session.EndTime,
+			Tentative: session.ClosureID != nil,
+			Modified:  session.UpdatedAt,
+		}
+		var err error
+		if event.Summary, err = summary(session); err != nil {
+			return nil, err
+		}
+		if session.BranchID != nil {
+			if _, ok := locations[*session.BranchID]; !ok {
+				branch, err := s.repo.Branch.GetBranchByID(*session.BranchID)
+				if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, err
+				}
+				if err == nil && branch.Location != nil {
+					locations[*session.BranchID] = *branch.Location
+				}
+			}
+			event.Location = locations[*session.BranchID]
+		}
+		if event.Tentative {
+			event.Description = "The branch is closed on this day, so the session will be rescheduled."
+		}
+		calendar.Events = append(calendar.Events, event)
+	}
+	return calendar, nil
+}
+
+// initials shortens a name such as Asha Rao to A.R.
+func initials(name string) string {
+	var b strings.Builder
+	for _, part := range strings.Fields(name) {
+		first, _ := utf8.DecodeRuneInString(part)
+		b.WriteRune(first)
+		b.WriteString(".")
+	}
+	return b.String()
+}
+
+func (s *CalendarService) create(feed *models.CalendarFeed) (*models.CalendarFeed, string, error) {
+	token, err := auth.GenerateFeedToken()
+	if err != nil {
+		return nil, "", err
+	}
+	feed.TokenHash = auth.HashFeedToken(token)
+	if err := s.repo.CalendarFeed.Create(feed); err != nil {
+		return nil, "", err
+	}
+	return feed, token, nil
+}
+
+func (s *CalendarService) checkStaff(caller *models.Viewer, staffID string) error {
+	if caller.StaffID != staffID && caller.Role != models.RoleAdmin {
+		return ErrFeedNotAllowed
+	}
+	if _, err := s.repo.Staff.FindByID(staffID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("staff member not found")
+		}
+		return err
+	}
+	return nil
+}
+
+// checkPatient checks the patient is inside the caseload the repository is limited to
+func (s *CalendarService) checkPatient(patientID string) error {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("patient not found")
+		}
+		return err
+	}
+	return nil
+}
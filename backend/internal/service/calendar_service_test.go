@@ -0,0 +1,72 @@
+package service
+
+// backend/internal/service/calendar_service_test.go
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/models"
+)
+
+func TestInitials(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Asha Rao", "A.R."},
+		{"  Arjun   K  Menon ", "A.K.M."},
+		{"Kavya", "K."},
+		{"ஆதி சேகர்", "ஆ.ச."}, // The first character of each word, without its vowel sign
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := initials(tt.name); got != tt.want {
+			t.Errorf("initials(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestStaffCalendar(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	if err := repo.Patient.Update(patient.ID, map[string]interface{}{"name": "Asha Rao"}); err != nil {
+		t.Fatal(err)
+	}
+	start := time.Now().Add(time.Hour).Truncate(time.Minute)
+	session := &models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(time.Hour)}
+	if err := repo.Session.Create(session); err != nil {
+		t.Fatal(err)
+	}
+	service := NewCalendarService(repo)
+
+	calendar, err := service.StaffCalendar(staff.ID, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calendar.Events) != 1 {
+		t.Fatalf("calendar has %d events, want 1", len(calendar.Events))
+	}
+	event := calendar.Events[0]
+	if event.Summary != "Session with A.R." {
+		t.Errorf("summary = %q, want the patient's initials", event.Summary)
+	}
+	if event.Modified.IsZero() {
+		t.Fatal("event has no modified time")
+	}
+
+	// Moving the session changes when it was modified, so apps take the new time
+	time.Sleep(10 * time.Millisecond)
+	if _, err := repo.Session.Update(session.ID, map[string]interface{}{"start_time": start.Add(time.Hour), "end_time": start.Add(2 * time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+	moved, err := service.StaffCalendar(staff.ID, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !moved.Events[0].Modified.After(event.Modified) {
+		t.Errorf("moved event modified at %v, want after %v", moved.Events[0].Modified, event.Modified)
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+// backend/internal/service/catalog_import_test.go
+
+import (
+	"reflect"
+	"testing"
+
+	"palaam/internal/catalog"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+func testCatalog(version int, groups ...catalog.Group) *catalog.Catalog {
+	return &catalog.Catalog{Name: "Test assessment", Version: version, File: "test.yaml", Groups: groups}
+}
+
+func TestCatalogImport(t *testing.T) {
+	maxScore := 2.0
+	first := testCatalog(1, catalog.Group{Number: 1, Name: "Requesting", Questions: []catalog.Question{
+		{Code: "R1", Text: "Requests items", Type: models.AnswerScore},
+		{Code: "R2", Text: "Requests actions", Type: models.AnswerScore},
+	}})
+	reworded := testCatalog(2, catalog.Group{Number: 1, Name: "Requesting", Questions: []catalog.Question{
+		{Code: "R1", Text: "Requests preferred items", Type: models.AnswerScore, MaxScore: &maxScore},
+		{Code: "R3", Text: "Requests help", Type: models.AnswerScore},
+	}})
+
+	tests := []struct {
+		name    string
+		catalog *catalog.Catalog
+		dryRun  bool
+		want    catalog.Diff
+		active  []string // Codes of the questions left unretired
+	}{
+		{
+			name:    "dry run of a new assessment",
+			catalog: first,
+			dryRun:  true,
+			want:    catalog.Diff{Created: true, Added: []string{"R1", "R2"}},
+		},
+		{
+			name:    "new assessment",
+			catalog: first,
+			want:    catalog.Diff{Created: true, Added: []string{"R1", "R2"}},
+			active:  []string{"R1", "R2"},
+		},
+		{
+			name:    "same file again",
+			catalog: first,
+			want:    catalog.Diff{},
+			active:  []string{"R1", "R2"},
+		},
+		{
+			name:    "dry run of a new version",
+			catalog: reworded,
+			dryRun:  true,
+			want: catalog.Diff{
+				Fields:  []string{"version"},
+				Added:   []string{"R3"},
+				Changed: []catalog.QuestionChange{{Code: "R1", Fields: []string{"text", "max score"}}},
+				Retired: []string{"R2"},
+			},
+			active: []string{"R1", "R2"},
+		},
+		{
+			name:    "new version",
+			catalog: reworded,
+			want: catalog.Diff{
+				Fields:  []string{"version"},
+				Added:   []string{"R3"},
+				Changed: []catalog.QuestionChange{{Code: "R1", Fields: []string{"text", "max score"}}},
+				Retired: []string{"R2"},
+			},
+			active: []string{"R1", "R3"},
+		},
+		{
+			name:    "retired question restored",
+			catalog: testCatalog(3, first.Groups[0], catalog.Group{Number: 2, Questions: reworded.Groups[0].Questions[1:]}),
+			want: catalog.Diff{
+				Fields:   []string{"version"},
+				Changed:  []catalog.QuestionChange{{Code: "R1", Fields: []string{"text", "max score"}}, {Code: "R3", Fields: []string{"group", "group name", "position"}}},
+				Restored: []string{"R2"},
+			},
+			active: []string{"R1", "R2", "R3"},
+		},
+	}
+
+	db := newTestDB(t)
+	importer := catalog.NewImporter(db)
+	repo := repository.NewRepository(db)
+	for _, tt := range tests {
+		diffs, err := importer.Import([]*catalog.Catalog{tt.catalog}, tt.dryRun)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		tt.want.Assessment, tt.want.File = tt.catalog.Name, tt.catalog.File
+		if len(diffs) != 1 || !reflect.DeepEqual(*diffs[0], tt.want) {
+			t.Errorf("%s: diff = %+v, want %+v", tt.name, diffs, tt.want)
+		}
+
+		assessment, err := repo.Assessment.FindByName(tt.catalog.Name)
+		if tt.active == nil {
+			if err == nil {
+				t.Errorf("%s: the dry run created the assessment", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		questions, err := repo.OnboardingQuestion.FindAllByAssessmentID(assessment.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var active []string
+		for _, question := range questions {
+			if !question.Retired {
+				active = append(active, question.Code)
+			}
+		}
+		if !reflect.DeepEqual(active, tt.active) {
+			t.Errorf("%s: active questions = %v, want %v", tt.name, active, tt.active)
+		}
+	}
+
+	if _, err := importer.Import([]*catalog.Catalog{first}, false); err == nil {
+		t.Error("importing an older version succeeded, want an error")
+	}
+}
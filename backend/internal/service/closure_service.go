@@ -0,0 +1,455 @@
+package service
+
+// backend/internal/service/closure_service.go
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/holidays"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrClosureNotFound     = errors.New("branch closure not found")
+	ErrSessionNotOnClosure = errors.New("session is not flagged by the closure")
+)
+
+const dateLayout = "2006-01-02"
+
+// BranchClosedError is returned for a session on a day its branch is closed
+type BranchClosedError struct {
+	Closure *models.BranchClosure
+}
+
+func (e *BranchClosedError) Error() string {
+	if e.Closure.StartDate == e.Closure.EndDate {
+		return fmt.Sprintf("branch %d is closed on %s for %s", e.Closure.BranchID, e.Closure.StartDate, e.Closure.Name)
+	}
+	return fmt.Sprintf("branch %d is closed from %s to %s for %s",
+		e.Closure.BranchID, e.Closure.StartDate, e.Closure.EndDate, e.Closure.Name)
+}
+
+// respond writes the error as a 400 with the closure the session falls on
+func (e *BranchClosedError) respond(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":      e.Error(),
+		"branch_id":  e.Closure.BranchID,
+		"closure_id": e.Closure.ID,
+		"start_date": e.Closure.StartDate,
+		"end_date":   e.Closure.EndDate,
+		"name":       e.Closure.Name,
+	})
+}
+
+// SessionMove is a new time for a session flagged by a closure
+type SessionMove struct {
+	SessionID string    `json:"session_id"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+// SessionConflict is a session that can't be moved or cancelled, and why
+type SessionConflict struct {
+	SessionID string `json:"session_id"`
+	Reason    string `json:"reason"`
+}
+
+// RescheduleConflictError lists the sessions of a bulk reschedule or cancellation that can't
+// be carried out. None of the sessions are changed.
+type RescheduleConflictError struct {
+	Conflicts []SessionConflict
+}
+
+func (e *RescheduleConflictError) Error() string {
+	return "some sessions can't be rescheduled"
+}
+
+// respond writes the error as a 409 listing the conflicting sessions
+func (e *RescheduleConflictError) respond(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+		"error":     e.Error(),
+		"conflicts": e.Conflicts,
+	})
+}
+
+// ClosureImpact is a closure and the sessions it flagged
+type ClosureImpact struct {
+	Closure  *models.BranchClosure `json:"closure"`
+	Sessions []*models.Session     `json:"sessions"`
+}
+
+type ClosureServiceInterface interface {
+	List(branchID int, from, to string) ([]*models.BranchClosure, error)
+	Create(closure *models.BranchClosure) (*ClosureImpact, error)
+	ImportHolidays(branchID, year int) ([]*ClosureImpact, error)
+	Delete(id int) error
+	Sessions(id int) ([]*models.Session, error)
+	Reschedule(id int, offsetDays int, moves []SessionMove) ([]*models.Session, []string, error)
+	Cancel(id int, sessionIDs []string) error
+}
+
+type ClosureService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewClosureService(repo *repository.Repository, scheduling config.Scheduling) ClosureServiceInterface {
+	return &ClosureService{repo: repo, location: scheduling.Location()}
+}
+
+// List a branch's closures between two dates, both optional
+func (s *ClosureService) List(branchID int, from, to string) ([]*models.BranchClosure, error) {
+	if _, err := s.checkBranch(branchID); err != nil {
+		return nil, err
+	}
+	for _, date := range []string{from, to} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(dateLayout, date); err != nil {
+			return nil, fmt.Errorf("date %q must look like 2026-01-31", date)
+		}
+	}
+	return s.repo.BranchClosure.FindByBranch(branchID, from, to)
+}
+
+// Create a closure and flag the branch's sessions on its days for rescheduling
+func (s *ClosureService) Create(closure *models.BranchClosure) (*ClosureImpact, error) {
+	if _, err := s.checkBranch(closure.BranchID); err != nil {
+		return nil, err
+	}
+	if closure.EndDate == "" {
+		closure.EndDate = closure.StartDate
+	}
+	start, err := time.Parse(dateLayout, closure.StartDate)
+	if err != nil {
+		return nil, errors.New("start date must look like 2026-01-31")
+	}
+	end, err := time.Parse(dateLayout, closure.EndDate)
+	if err != nil {
+		return nil, errors.New("end date must look like 2026-01-31")
+	}
+	if end.Before(start) {
+		return nil, errors.New("end date must not be before start date")
+	}
+	if closure.Name == "" {
+		return nil, errors.New("closure name is required")
+	}
+
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		return s.create(repo, closure)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.impact(closure)
+}
+
+// ImportHolidays closes a branch on the bundled public holidays of a year. Holidays the
+// branch is already closed on are skipped, so importing twice changes nothing.
+func (s *ClosureService) ImportHolidays(branchID, year int) ([]*ClosureImpact, error) {
+	if _, err := s.checkBranch(branchID); err != nil {
+		return nil, err
+	}
+	list, err := holidays.India(year)
+	if err != nil {
+		return nil, err
+	}
+
+	var created []*models.BranchClosure
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		for _, holiday := range list {
+			existing, err := repo.BranchClosure.FindByBranch(branchID, holiday.Date, holiday.Date)
+			if err != nil {
+				return err
+			}
+			if len(existing) > 0 {
+				continue
+			}
+			closure := &models.BranchClosure{
+				BranchID:  branchID,
+				StartDate: holiday.Date,
+				EndDate:   holiday.Date,
+				Name:      holiday.Name,
+				Holiday:   true,
+			}
+			if err := s.create(repo, closure); err != nil {
+				return err
+			}
+			created = append(created, closure)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	impacts := []*ClosureImpact{}
+	for _, closure := range created {
+		impact, err := s.impact(closure)
+		if err != nil {
+			return nil, err
+		}
+		impacts = append(impacts, impact)
+	}
+	return impacts, nil
+}
+
+// Delete a closure. Sessions it flagged that weren't rescheduled keep their time.
+func (s *ClosureService) Delete(id int) error {
+	if _, err := s.find(id); err != nil {
+		return err
+	}
+	return s.repo.Transaction(func(repo *repository.Repository) error {
+		if err := repo.Session.ClearClosure(id); err != nil {
+			return err
+		}
+		return repo.BranchClosure.Delete(id)
+	})
+}
+
+// Sessions lists the sessions a closure flagged that haven't been rescheduled or cancelled yet
+func (s *ClosureService) Sessions(id int) ([]*models.Session, error) {
+	if _, err := s.find(id); err != nil {
+		return nil, err
+	}
+	return s.repo.Session.FindByClosureID(id)
+}
+
+// Reschedule moves sessions off a closure, either to the times given for each or by a number
+// of days at the same local time. A shift applies to every flagged session without a time of
+// its own. Either every session moves or none do; the conflicts are returned as a
+// RescheduleConflictError. The warnings are about sessions moved outside the hours of a
+// branch that only warns about them.
+func (s *ClosureService) Reschedule(id int, offsetDays int, moves []SessionMove) ([]*models.Session, []string, error) {
+	if _, err := s.find(id); err != nil {
+		return nil, nil, err
+	}
+	if offsetDays == 0 && len(moves) == 0 {
+		return nil, nil, errors.New("give the sessions new times or a number of days to shift them by")
+	}
+	flagged, err := s.repo.Session.FindByClosureID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	targets := map[string]SessionMove{}
+	for _, move := range moves {
+		if _, ok := targets[move.SessionID]; ok {
+			return nil, nil, fmt.Errorf("session %s is given twice", move.SessionID)
+		}
+		targets[move.SessionID] = move
+	}
+	var conflicts []SessionConflict
+	for sessionID := range targets {
+		if !slices.ContainsFunc(flagged, func(session *models.Session) bool { return session.ID == sessionID }) {
+			conflicts = append(conflicts, SessionConflict{SessionID: sessionID, Reason: ErrSessionNotOnClosure.Error()})
+		}
+	}
+	if offsetDays != 0 {
+		for _, session := range flagged {
+			if _, ok := targets[session.ID]; ok {
+				continue
+			}
+			start, end := session.StartTime.In(s.location), session.EndTime.In(s.location)
+			targets[session.ID] = SessionMove{
+				SessionID: session.ID,
+				StartTime: start.AddDate(0, 0, offsetDays).UTC(),
+				EndTime:   end.AddDate(0, 0, offsetDays).UTC(),
+			}
+		}
+	}
+
+	var moved []*models.Session
+	var warnings []string
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		for _, session := range flagged {
+			move, ok := targets[session.ID]
+			if !ok {
+				continue
+			}
+			start, end := move.StartTime.UTC(), move.EndTime.UTC()
+			if err := checkTimes(start, end); err != nil {
+				conflicts = append(conflicts, SessionConflict{SessionID: session.ID, Reason: err.Error()})
+				continue
+			}
+			overlapping, err := repo.Session.CheckOverlappingSessions(session.StaffID, start, end, session.ID)
+			if err != nil {
+				return err
+			}
+			if overlapping {
+				conflicts = append(conflicts, SessionConflict{SessionID: session.ID, Reason: "staff member has overlapping session at this time"})
+				continue
+			}
+			warned, err := checkSchedule(repo, s.location, session.BranchID, start, end)
+			var closed *BranchClosedError
+			var outside *OutsideHoursError
+			if errors.As(err, &closed) || errors.As(err, &outside) {
+				conflicts = append(conflicts, SessionConflict{SessionID: session.ID, Reason: err.Error()})
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			for _, warning := range warned {
+				if !slices.Contains(warnings, warning) {
+					warnings = append(warnings, warning)
+				}
+			}
+
+			if _, err := repo.Session.Update(session.ID, map[string]interface{}{
+				"start_time": start,
+				"end_time":   end,
+				"closure_id": nil,
+			}); err != nil {
+				return err
+			}
+			updated, err := repo.Session.FindByID(session.ID)
+			if err != nil {
+				return err
+			}
+			moved = append(moved, updated)
+		}
+		if len(conflicts) > 0 {
+			return &RescheduleConflictError{Conflicts: conflicts}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return moved, warnings, nil
+}
+
+// Cancel deletes sessions flagged by a closure, or all of them when none are given. Sessions
+// generated by a series are cancelled as occurrences, so the series doesn't recreate them.
+// Either every session is cancelled or none are.
+func (s *ClosureService) Cancel(id int, sessionIDs []string) error {
+	if _, err := s.find(id); err != nil {
+		return err
+	}
+	flagged, err := s.repo.Session.FindByClosureID(id)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []SessionConflict
+	for _, sessionID := range sessionIDs {
+		if !slices.ContainsFunc(flagged, func(session *models.Session) bool { return session.ID == sessionID }) {
+			conflicts = append(conflicts, SessionConflict{SessionID: sessionID, Reason: ErrSessionNotOnClosure.Error()})
+		}
+	}
+
+	return s.repo.Transaction(func(repo *repository.Repository) error {
+		for _, session := range flagged {
+			if len(sessionIDs) > 0 && !slices.Contains(sessionIDs, session.ID) {
+				continue
+			}
+			if err := deleteSession(repo, session); err != nil {
+				conflicts = append(conflicts, SessionConflict{SessionID: session.ID, Reason: err.Error()})
+				continue
+			}
+			if session.SeriesID != nil && session.OccursAt != nil {
+				if err := repo.SessionSeries.AddException(&models.SessionSeriesException{
+					SeriesID: *session.SeriesID,
+					OccursAt: *session.OccursAt,
+				}); err != nil {
+					return err
+				}
+			}
+		}
+		if len(conflicts) > 0 {
+			return &RescheduleConflictError{Conflicts: conflicts}
+		}
+		return nil
+	})
+}
+
+// create saves a closure that doesn't overlap another one of its branch and flags the
+// sessions that start or end on its days
+func (s *ClosureService) create(repo *repository.Repository, closure *models.BranchClosure) error {
+	overlapping, err := repo.BranchClosure.FindByBranch(closure.BranchID, closure.StartDate, closure.EndDate)
+	if err != nil {
+		return err
+	}
+	if len(overlapping) > 0 {
+		return fmt.Errorf("branch is already closed for %s from %s to %s",
+			overlapping[0].Name, overlapping[0].StartDate, overlapping[0].EndDate)
+	}
+	if err := repo.BranchClosure.Create(closure); err != nil {
+		return err
+	}
+
+	from, to := closureRange(closure, s.location)
+	return repo.Session.FlagClosure(closure.ID, closure.BranchID, from, to)
+}
+
+func (s *ClosureService) impact(closure *models.BranchClosure) (*ClosureImpact, error) {
+	sessions, err := s.repo.Session.FindByClosureID(closure.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &ClosureImpact{Closure: closure, Sessions: sessions}, nil
+}
+
+func (s *ClosureService) find(id int) (*models.BranchClosure, error) {
+	closure, err := s.repo.BranchClosure.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrClosureNotFound
+	}
+	return closure, err
+}
+
+func (s *ClosureService) checkBranch(branchID int) (*models.Branch, error) {
+	branch, err := s.repo.Branch.GetBranchByID(branchID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrBranchNotFound
+	}
+	return branch, err
+}
+
+// closureRange is the span of a closure's days in loc, from the first midnight to the last
+func closureRange(closure *models.BranchClosure, loc *time.Location) (time.Time, time.Time) {
+	start, _ := time.ParseInLocation(dateLayout, closure.StartDate, loc)
+	end, _ := time.ParseInLocation(dateLayout, closure.EndDate, loc)
+	return start.UTC(), end.AddDate(0, 0, 1).UTC()
+}
+
+// findClosure finds the closure of a branch a session from start to end falls on, if any
+func findClosure(repo *repository.Repository, loc *time.Location, branchID *int, start, end time.Time) (*models.BranchClosure, error) {
+	if branchID == nil {
+		return nil, nil
+	}
+	from := start.In(loc).Format(dateLayout)
+	to := end.Add(-time.Nanosecond).In(loc).Format(dateLayout)
+	closures, err := repo.BranchClosure.FindByBranch(*branchID, from, to)
+	if err != nil || len(closures) == 0 {
+		return nil, err
+	}
+	return closures[0], nil
+}
+
+// checkSchedule checks a session from start to end against its branch's operating hours and
+// closures. Closures always reject the session with a BranchClosedError.
+func checkSchedule(repo *repository.Repository, loc *time.Location, branchID *int, start, end time.Time) ([]string, error) {
+	warnings, err := checkOperatingHours(repo, loc, branchID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	closure, err := findClosure(repo, loc, branchID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if closure != nil {
+		return nil, &BranchClosedError{Closure: closure}
+	}
+	return warnings, nil
+}
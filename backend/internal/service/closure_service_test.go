@@ -0,0 +1,254 @@
+package service
+
+// backend/internal/service/closure_service_test.go
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// closureFixture is a branch open every day with a therapist's session at 10:00 on
+// each of the first days of March 2026, and one at another branch on the 3rd
+type closureFixture struct {
+	repo     *repository.Repository
+	closures ClosureServiceInterface
+	branch   *models.Branch
+	sessions map[string]*models.Session // By local date
+	other    *models.Session
+}
+
+func newClosureFixture(t *testing.T) *closureFixture {
+	t.Helper()
+	repo := newTestRepository(t)
+	scheduling := config.Scheduling{Timezone: "UTC"}
+	branches := NewBranchService(repo, scheduling)
+	branch, err := branches.Create(&models.Branch{OpeningDate: time.Now(), Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherBranch, err := branches.Create(&models.Branch{OpeningDate: time.Now(), Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	patient, staff := createTestPatient(t, repo)
+	book := func(branchID int, start time.Time) *models.Session {
+		session := &models.Session{
+			ID:        uuid.NewString(),
+			PatientID: patient.ID,
+			StaffID:   staff.ID,
+			BranchID:  &branchID,
+			StartTime: start,
+			EndTime:   start.Add(time.Hour),
+		}
+		if err := repo.Session.Create(session); err != nil {
+			t.Fatal(err)
+		}
+		return session
+	}
+
+	f := &closureFixture{repo: repo, closures: NewClosureService(repo, scheduling), branch: branch, sessions: map[string]*models.Session{}}
+	for day := 2; day <= 5; day++ {
+		start := time.Date(2026, 3, day, 10, 0, 0, 0, time.UTC)
+		f.sessions[start.Format(dateLayout)] = book(branch.ID, start)
+	}
+	f.other = book(otherBranch.ID, time.Date(2026, 3, 3, 14, 0, 0, 0, time.UTC))
+	return f
+}
+
+// flagged lists the dates of the sessions a closure flagged
+func (f *closureFixture) flagged(t *testing.T, closureID int) []string {
+	t.Helper()
+	sessions, err := f.closures.Sessions(closureID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dates []string
+	for _, session := range sessions {
+		dates = append(dates, session.StartTime.UTC().Format(dateLayout))
+	}
+	return dates
+}
+
+func TestClosureFlagsSessions(t *testing.T) {
+	tests := []struct {
+		name       string
+		start, end string
+		want       []string
+		wantErr    bool
+	}{
+		{name: "single day", start: "2026-03-03", want: []string{"2026-03-03"}},
+		{name: "several days", start: "2026-03-03", end: "2026-03-04", want: []string{"2026-03-03", "2026-03-04"}},
+		{name: "no sessions", start: "2026-03-10", end: "2026-03-12"},
+		{name: "ends before it starts", start: "2026-03-04", end: "2026-03-03", wantErr: true},
+		{name: "not a date", start: "3 March", wantErr: true},
+	}
+	for _, tt := range tests {
+		f := newClosureFixture(t)
+		impact, err := f.closures.Create(&models.BranchClosure{BranchID: f.branch.ID, StartDate: tt.start, EndDate: tt.end, Name: "Closed"})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Create error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got := f.flagged(t, impact.Closure.ID); !equalDates(got, tt.want) {
+			t.Errorf("%s: flagged %v, want %v", tt.name, got, tt.want)
+		}
+		if len(impact.Sessions) != len(tt.want) {
+			t.Errorf("%s: the impact lists %d sessions, want %d", tt.name, len(impact.Sessions), len(tt.want))
+		}
+	}
+}
+
+func TestClosureRejectsOverlapsAndBookings(t *testing.T) {
+	f := newClosureFixture(t)
+	impact, err := f.closures.Create(&models.BranchClosure{BranchID: f.branch.ID, StartDate: "2026-03-03", EndDate: "2026-03-04", Name: "Festival"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.closures.Create(&models.BranchClosure{BranchID: f.branch.ID, StartDate: "2026-03-04", Name: "Festival again"}); err == nil {
+		t.Error("an overlapping closure was created, want an error")
+	}
+
+	sessions := NewSessionService(f.repo, config.Scheduling{Timezone: "UTC"})
+	var closed *BranchClosedError
+	start := time.Date(2026, 3, 4, 15, 0, 0, 0, time.UTC)
+	book := func(start time.Time) error {
+		booked := f.sessions["2026-03-02"]
+		_, _, err := sessions.Create(&models.Session{PatientID: booked.PatientID, StaffID: booked.StaffID, BranchID: &f.branch.ID, StartTime: start, EndTime: start.Add(time.Hour)})
+		return err
+	}
+	if err := book(start); !errors.As(err, &closed) || closed.Closure.ID != impact.Closure.ID {
+		t.Errorf("booking on the closure error = %v, want the closure", err)
+	}
+	if err := book(start.AddDate(0, 0, 1)); err != nil {
+		t.Errorf("booking after the closure error = %v, want none", err)
+	}
+
+	// Deleting the closure unflags its sessions and leaves them where they were
+	if err := f.closures.Delete(impact.Closure.ID); err != nil {
+		t.Fatal(err)
+	}
+	session, err := f.repo.Session.FindByID(f.sessions["2026-03-03"].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.ClosureID != nil || !session.StartTime.Equal(f.sessions["2026-03-03"].StartTime) {
+		t.Errorf("after deleting the closure the session is flagged %v at %v", session.ClosureID, session.StartTime)
+	}
+}
+
+func TestClosureReschedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		offset    int
+		moves     func(f *closureFixture) []SessionMove
+		want      []string // Dates the flagged sessions end up on
+		conflicts int
+	}{
+		{
+			name:   "shifted a week",
+			offset: 7,
+			want:   []string{"2026-03-10", "2026-03-11"},
+		},
+		{
+			name:      "shifted onto another session",
+			offset:    2,
+			conflicts: 1,
+		},
+		{
+			name:   "one moved by hand and the rest shifted",
+			offset: 7,
+			moves: func(f *closureFixture) []SessionMove {
+				start := time.Date(2026, 3, 5, 15, 0, 0, 0, time.UTC)
+				return []SessionMove{{SessionID: f.sessions["2026-03-03"].ID, StartTime: start, EndTime: start.Add(time.Hour)}}
+			},
+			want: []string{"2026-03-05", "2026-03-11"},
+		},
+		{
+			name: "a session the closure didn't flag",
+			moves: func(f *closureFixture) []SessionMove {
+				start := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+				return []SessionMove{{SessionID: f.other.ID, StartTime: start, EndTime: start.Add(time.Hour)}}
+			},
+			conflicts: 1,
+		},
+		{
+			name: "ends before it starts",
+			moves: func(f *closureFixture) []SessionMove {
+				start := time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)
+				return []SessionMove{{SessionID: f.sessions["2026-03-03"].ID, StartTime: start, EndTime: start.Add(-time.Hour)}}
+			},
+			conflicts: 1,
+		},
+	}
+	for _, tt := range tests {
+		f := newClosureFixture(t)
+		impact, err := f.closures.Create(&models.BranchClosure{BranchID: f.branch.ID, StartDate: "2026-03-03", EndDate: "2026-03-04", Name: "Festival"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var moves []SessionMove
+		if tt.moves != nil {
+			moves = tt.moves(f)
+		}
+
+		moved, _, err := f.closures.Reschedule(impact.Closure.ID, tt.offset, moves)
+		var conflict *RescheduleConflictError
+		if tt.conflicts > 0 {
+			if !errors.As(err, &conflict) || len(conflict.Conflicts) != tt.conflicts {
+				t.Errorf("%s: Reschedule error = %v, want %d conflicts", tt.name, err, tt.conflicts)
+			}
+			// Nothing moves when any session can't
+			if got := f.flagged(t, impact.Closure.ID); !equalDates(got, []string{"2026-03-03", "2026-03-04"}) {
+				t.Errorf("%s: after the conflict the closure flags %v, want both sessions", tt.name, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Reschedule error = %v", tt.name, err)
+			continue
+		}
+
+		var dates []string
+		for _, session := range moved {
+			if session.ClosureID != nil {
+				t.Errorf("%s: session %s is still flagged", tt.name, session.ID)
+			}
+			dates = append(dates, session.StartTime.UTC().Format(dateLayout))
+		}
+		if !equalDates(dates, tt.want) {
+			t.Errorf("%s: moved to %v, want %v", tt.name, dates, tt.want)
+		}
+		if got := f.flagged(t, impact.Closure.ID); len(got) > 0 {
+			t.Errorf("%s: the closure still flags %v", tt.name, got)
+		}
+	}
+}
+
+// equalDates compares two lists of dates, ignoring their order
+func equalDates(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := map[string]int{}
+	for _, date := range a {
+		seen[date]++
+	}
+	for _, date := range b {
+		if seen[date] == 0 {
+			return false
+		}
+		seen[date]--
+	}
+	return true
+}
@@ -0,0 +1,294 @@
+package service
+
+// backend/internal/service/guardian_portal_service.go
+
+import (
+	"errors"
+	"log/slog"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/auth"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var ErrInvalidCode = errors.New("invalid or expired code")
+
+// Guardians can request maxCodeRequests codes and get maxFailedAttempts guesses wrong,
+// over all their codes, within codeWindow
+const (
+	codeWindow        = time.Hour
+	maxCodeRequests   = 5
+	maxFailedAttempts = 10
+)
+
+// progressWindow is how far back the progress summary counts session responses
+const progressWindow = 90 * 24 * time.Hour
+
+// ChildSession is a session as guardians see it. The description is left out
+// unless staff marked the session shareable.
+type ChildSession struct {
+	ID          string    `json:"id"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	Description *string   `json:"description"`
+}
+
+// ChildMedicine is a prescription as guardians see it
+type ChildMedicine struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	BrandName *string `json:"brand_name"`
+	Dosage    *string `json:"dosage"`
+}
+
+type ChildProgress struct {
+	PatientID         string                       `json:"patient_id"`
+	SessionsCompleted int                          `json:"sessions_completed"`
+	SessionsUpcoming  int                          `json:"sessions_upcoming"`
+	LastSessionAt     *time.Time                   `json:"last_session_at"`
+	NextSessionAt     *time.Time                   `json:"next_session_at"`
+	Responses         map[models.ResponseLevel]int `json:"responses"`
+}
+
+type GuardianPortalServiceInterface interface {
+	RequestCode(email, phoneNumber string) error
+	VerifyCode(email, phoneNumber, code string) (string, *models.Guardian, error)
+	Children(guardianID string) ([]*models.Patient, error)
+	Sessions(guardianID, patientID string, upcoming bool) ([]*ChildSession, error)
+	Medicines(guardianID, patientID string) ([]*ChildMedicine, error)
+	Progress(guardianID, patientID string) (*ChildProgress, error)
+}
+
+type GuardianPortalService struct {
+	repo    *repository.Repository
+	tokens  *auth.TokenManager
+	sender  auth.OTPSender
+	codeTTL time.Duration
+}
+
+// NewGuardianPortalService creates the guardian portal service. Codes are delivered with sender and expire after codeTTL.
+func NewGuardianPortalService(repo *repository.Repository, tokens *auth.TokenManager, sender auth.OTPSender, codeTTL time.Duration) GuardianPortalServiceInterface {
+	return &GuardianPortalService{repo: repo, tokens: tokens, sender: sender, codeTTL: codeTTL}
+}
+
+// RequestCode sends a one-time code to the guardian with the given email or phone number.
+// Unknown guardians, and guardians who have requested too many codes lately, are ignored
+// so callers can't tell which contacts are registered.
+func (s *GuardianPortalService) RequestCode(email, phoneNumber string) error {
+	guardian, channel, destination, err := s.findGuardian(email, phoneNumber)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	requested, err := s.repo.GuardianLoginCode.CountSince(guardian.ID, time.Now().Add(-codeWindow))
+	if err != nil {
+		return err
+	}
+	if requested >= maxCodeRequests {
+		slog.Warn("ignored a login code request from a guardian who requested too many", "guardian_id", guardian.ID)
+		return nil
+	}
+
+	code, err := auth.GenerateCode()
+	if err != nil {
+		return err
+	}
+	hash, err := auth.HashPassword(code)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repo.GuardianLoginCode.Create(&models.GuardianLoginCode{
+		GuardianID: guardian.ID,
+		CodeHash:   hash,
+		Channel:    channel,
+		ExpiresAt:  time.Now().Add(s.codeTTL),
+	}); err != nil {
+		return err
+	}
+	return s.sender.Send(channel, destination, code)
+}
+
+// VerifyCode exchanges a guardian's latest code for an access token. Guardians who have
+// guessed wrong too often lately can't sign in until the window passes, whatever code they use.
+func (s *GuardianPortalService) VerifyCode(email, phoneNumber, code string) (string, *models.Guardian, error) {
+	guardian, _, _, err := s.findGuardian(email, phoneNumber)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil, ErrInvalidCode
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	loginCode, err := s.repo.GuardianLoginCode.FindLatestActive(guardian.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return "", nil, ErrInvalidCode
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	failed, err := s.repo.GuardianLoginCode.SumAttemptsSince(guardian.ID, time.Now().Add(-codeWindow))
+	if err != nil {
+		return "", nil, err
+	}
+	if failed >= maxFailedAttempts {
+		return "", nil, ErrInvalidCode
+	}
+	if !auth.CheckPassword(loginCode.CodeHash, code) {
+		if err := s.repo.GuardianLoginCode.IncrementAttempts(loginCode.ID); err != nil {
+			return "", nil, err
+		}
+		return "", nil, ErrInvalidCode
+	}
+
+	if err := s.repo.GuardianLoginCode.Consume(loginCode.ID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return "", nil, ErrInvalidCode
+		}
+		return "", nil, err
+	}
+
+	token, err := s.tokens.IssueGuardian(guardian)
+	if err != nil {
+		return "", nil, err
+	}
+	return token, guardian, nil
+}
+
+// Children lists the patients a guardian is linked to
+func (s *GuardianPortalService) Children(guardianID string) ([]*models.Patient, error) {
+	return s.repo.Guardian.FindChildren(guardianID)
+}
+
+// Sessions lists a child's upcoming sessions, soonest first, or past sessions, latest first
+func (s *GuardianPortalService) Sessions(guardianID, patientID string, upcoming bool) ([]*ChildSession, error) {
+	if err := s.checkChild(guardianID, patientID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.repo.Session.FindByPatientID(patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := []*ChildSession{}
+	for _, session := range sessions {
+		if session.StartTime.After(now) != upcoming {
+			continue
+		}
+
+		view := &ChildSession{
+			ID:        session.ID,
+			StartTime: session.StartTime,
+			EndTime:   session.EndTime,
+		}
+		if session.Shareable {
+			description := session.Description
+			view.Description = &description
+		}
+		result = append(result, view)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if upcoming {
+			return result[i].StartTime.Before(result[j].StartTime)
+		}
+		return result[i].StartTime.After(result[j].StartTime)
+	})
+	return result, nil
+}
+
+// Medicines lists the medicines prescribed to a child
+func (s *GuardianPortalService) Medicines(guardianID, patientID string) ([]*ChildMedicine, error) {
+	if err := s.checkChild(guardianID, patientID); err != nil {
+		return nil, err
+	}
+
+	medicines, err := s.repo.Medicine.FindByPatientID(patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*ChildMedicine, 0, len(medicines))
+	for _, medicine := range medicines {
+		result = append(result, &ChildMedicine{
+			ID:        medicine.ID,
+			Name:      medicine.Name,
+			BrandName: medicine.BrandName,
+			Dosage:    medicine.Dosage,
+		})
+	}
+	return result, nil
+}
+
+// Progress summarises a child's attendance and how they responded in recent sessions
+func (s *GuardianPortalService) Progress(guardianID, patientID string) (*ChildProgress, error) {
+	if err := s.checkChild(guardianID, patientID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.repo.Session.FindByPatientID(patientID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	summary := &ChildProgress{
+		PatientID: patientID,
+		Responses: map[models.ResponseLevel]int{},
+	}
+	for _, session := range sessions {
+		start := session.StartTime
+		if start.After(now) {
+			summary.SessionsUpcoming++
+			if summary.NextSessionAt == nil || start.Before(*summary.NextSessionAt) {
+				summary.NextSessionAt = &start
+			}
+			continue
+		}
+
+		summary.SessionsCompleted++
+		if summary.LastSessionAt == nil || start.After(*summary.LastSessionAt) {
+			summary.LastSessionAt = &start
+		}
+		if session.Response != "" && now.Sub(start) <= progressWindow {
+			summary.Responses[session.Response]++
+		}
+	}
+	return summary, nil
+}
+
+// findGuardian looks a guardian up by email, or by phone number when no email is given
+func (s *GuardianPortalService) findGuardian(email, phoneNumber string) (*models.Guardian, string, string, error) {
+	switch {
+	case email != "":
+		guardian, err := s.repo.Guardian.FindByEmail(email)
+		return guardian, auth.ChannelEmail, email, err
+	case phoneNumber != "":
+		guardian, err := s.repo.Guardian.FindByPhoneNumber(phoneNumber)
+		return guardian, auth.ChannelSMS, phoneNumber, err
+	default:
+		return nil, "", "", errors.New("email or phone number is required")
+	}
+}
+
+// checkChild hides patients the guardian isn't linked to behind a not found error
+func (s *GuardianPortalService) checkChild(guardianID, patientID string) error {
+	ok, err := s.repo.Guardian.IsGuardianOf(guardianID, patientID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("patient not found")
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package service
+
+// backend/internal/service/guardian_portal_service_test.go
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/auth"
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+// recordingSender keeps the codes it's asked to send
+type recordingSender struct {
+	codes []string
+}
+
+func (s *recordingSender) Send(channel, destination, code string) error {
+	s.codes = append(s.codes, code)
+	return nil
+}
+
+func TestGuardianCodesAreLimited(t *testing.T) {
+	repo := newTestRepository(t)
+	email := "parent@example.com"
+	if err := repo.Guardian.Create(&models.Guardian{ID: uuid.NewString(), Name: "Parent", Email: &email}); err != nil {
+		t.Fatal(err)
+	}
+	sender := &recordingSender{}
+	tokens := auth.NewTokenManager(config.Auth{JWTSecret: "secret", TokenTTL: time.Hour}, "palaam")
+	service := NewGuardianPortalService(repo, tokens, sender, 10*time.Minute)
+
+	for i := 0; i < maxCodeRequests+2; i++ {
+		if err := service.RequestCode(email, ""); err != nil {
+			t.Fatalf("RequestCode() error = %v", err)
+		}
+	}
+	if len(sender.codes) != maxCodeRequests {
+		t.Fatalf("sent %d codes, want %d", len(sender.codes), maxCodeRequests)
+	}
+
+	// Wrong guesses count against the guardian, not the code they were made against
+	latest := sender.codes[len(sender.codes)-1]
+	for i := 0; i < maxFailedAttempts; i++ {
+		if _, _, err := service.VerifyCode(email, "", "wrong"); !errors.Is(err, ErrInvalidCode) {
+			t.Fatalf("VerifyCode() with a wrong code error = %v, want %v", err, ErrInvalidCode)
+		}
+	}
+	if _, _, err := service.VerifyCode(email, "", latest); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("VerifyCode() after too many wrong guesses error = %v, want %v", err, ErrInvalidCode)
+	}
+}
+
+func TestGuardianCodeIsUsedOnce(t *testing.T) {
+	repo := newTestRepository(t)
+	email := "parent@example.com"
+	guardian := &models.Guardian{ID: uuid.NewString(), Name: "Parent", Email: &email}
+	if err := repo.Guardian.Create(guardian); err != nil {
+		t.Fatal(err)
+	}
+	sender := &recordingSender{}
+	tokens := auth.NewTokenManager(config.Auth{JWTSecret: "secret", TokenTTL: time.Hour}, "palaam")
+	service := NewGuardianPortalService(repo, tokens, sender, 10*time.Minute)
+	if err := service.RequestCode(email, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	// A sign-in that loaded the code before another consumed it doesn't get to use it too
+	code, err := repo.GuardianLoginCode.FindLatestActive(guardian.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := service.VerifyCode(email, "", sender.codes[0]); err != nil {
+		t.Fatalf("VerifyCode() error = %v", err)
+	}
+	if err := repo.GuardianLoginCode.Consume(code.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("consuming a used code error = %v, want %v", err, gorm.ErrRecordNotFound)
+	}
+	if _, _, err := service.VerifyCode(email, "", sender.codes[0]); !errors.Is(err, ErrInvalidCode) {
+		t.Errorf("VerifyCode() with a used code error = %v, want %v", err, ErrInvalidCode)
+	}
+}
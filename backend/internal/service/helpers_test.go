@@ -0,0 +1,67 @@
+package service
+
+// backend/internal/service/helpers_test.go
+
+import (
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"palaam/internal/audit"
+	database "palaam/internal/db"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// newTestRepository migrates a fresh in-memory SQLite database and returns a repository on it
+func newTestRepository(t *testing.T) *repository.Repository {
+	t.Helper()
+	return repository.NewRepository(newTestDB(t))
+}
+
+// newTestDB migrates a fresh in-memory SQLite database, audited like the server's
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Every connection to an in-memory database opens a new, empty one
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	migrator, err := database.NewMigrator(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migrator.Up(); err != nil {
+		t.Fatal(err)
+	}
+	if err := audit.RegisterCallbacks(db); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+// createTestPatient adds a patient seen by a new therapist and returns both
+func createTestPatient(t *testing.T, repo *repository.Repository) (*models.Patient, *models.Staff) {
+	t.Helper()
+	staff := &models.Staff{ID: uuid.NewString(), Name: "Therapist", Role: models.RoleTherapist, JoinDate: time.Now()}
+	if err := repo.Staff.Create(staff); err != nil {
+		t.Fatal(err)
+	}
+	active := true
+	patient := &models.Patient{ID: uuid.NewString(), Name: "Patient", Active: &active, StaffID: &staff.ID, JoinDate: time.Now()}
+	if err := repo.Patient.Create(patient); err != nil {
+		t.Fatal(err)
+	}
+	return patient, staff
+}
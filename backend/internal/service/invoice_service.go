@@ -0,0 +1,450 @@
+package service
+
+// backend/internal/service/invoice_service.go
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrInvoiceNotFound   = errors.New("invoice not found")
+	ErrGuardianNotFound  = errors.New("guardian not found")
+	ErrNothingToInvoice  = errors.New("no completed sessions to invoice in this period")
+	ErrInvoiceNotOpen    = errors.New("only open invoices can take payments")
+	ErrInvoiceHasPayment = errors.New("invoices with payments can't be voided")
+)
+
+// InvoiceRequest asks for an invoice of a patient's completed sessions between two dates,
+// both included. GuardianID can be left out when the patient has a single guardian.
+type InvoiceRequest struct {
+	From       string
+	To         string
+	GuardianID string
+}
+
+// GuardianBalance is what a guardian owes over their open invoices, in paise
+type GuardianBalance struct {
+	GuardianID  string            `json:"guardian_id"`
+	Name        string            `json:"name"`
+	Outstanding int64             `json:"outstanding"`
+	Overdue     int64             `json:"overdue"`
+	Invoices    []*models.Invoice `json:"invoices"`
+}
+
+// AgingBuckets splits outstanding balances by how many days past due they are, in paise
+type AgingBuckets struct {
+	Current    int64 `json:"current"` // Not due yet
+	Days1To30  int64 `json:"days_1_30"`
+	Days31To60 int64 `json:"days_31_60"`
+	Days61To90 int64 `json:"days_61_90"`
+	Over90     int64 `json:"over_90"`
+	Total      int64 `json:"total"`
+}
+
+func (b *AgingBuckets) add(balance int64, daysOverdue int) {
+	switch {
+	case daysOverdue <= 0:
+		b.Current += balance
+	case daysOverdue <= 30:
+		b.Days1To30 += balance
+	case daysOverdue <= 60:
+		b.Days31To60 += balance
+	case daysOverdue <= 90:
+		b.Days61To90 += balance
+	default:
+		b.Over90 += balance
+	}
+	b.Total += balance
+}
+
+// AgingRow is one guardian's outstanding balance
+type AgingRow struct {
+	GuardianID string `json:"guardian_id"`
+	Name       string `json:"name"`
+	AgingBuckets
+}
+
+// AgingReport lists receivables by guardian, largest balance first
+type AgingReport struct {
+	AsOf   string       `json:"as_of"`
+	Rows   []*AgingRow  `json:"rows"`
+	Totals AgingBuckets `json:"totals"`
+}
+
+type InvoiceServiceInterface interface {
+	Generate(caller *models.Viewer, patientID string, request InvoiceRequest) (*models.Invoice, error)
+	ListByPatient(patientID string) ([]*models.Invoice, error)
+	GetByID(id int) (*models.Invoice, error)
+	Void(id int) (*models.Invoice, error)
+	RecordPayment(caller *models.Viewer, id int, payment *models.Payment) (*models.Invoice, error)
+	GuardianBalance(guardianID string) (*GuardianBalance, error)
+	Aging(now time.Time) (*AgingReport, error)
+}
+
+type InvoiceService struct {
+	repo     *repository.Repository
+	pricing  *PricingService
+	location *time.Location
+	terms    int
+}
+
+func NewInvoiceService(repo *repository.Repository, scheduling config.Scheduling, billing config.Billing) InvoiceServiceInterface {
+	return &InvoiceService{
+		repo:     repo,
+		pricing:  &PricingService{repo: repo, location: scheduling.Location()},
+		location: scheduling.Location(),
+		terms:    billing.PaymentTermDays,
+	}
+}
+
+// Generate invoices a patient's completed sessions in a period that aren't on another
+// invoice yet, one line per session priced from the rate cards. Completed sessions have
+// ended with activities recorded.
+func (s *InvoiceService) Generate(caller *models.Viewer, patientID string, request InvoiceRequest) (*models.Invoice, error) {
+	patient, err := s.repo.Patient.FindByID(patientID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+	guardianID, err := s.billTo(patientID, request.GuardianID)
+	if err != nil {
+		return nil, err
+	}
+	from, err := time.ParseInLocation(dateLayout, request.From, s.location)
+	if err != nil {
+		return nil, errors.New("from must look like 2026-01-31")
+	}
+	to, err := time.ParseInLocation(dateLayout, request.To, s.location)
+	if err != nil {
+		return nil, errors.New("to must look like 2026-01-31")
+	}
+	if to.Before(from) {
+		return nil, errors.New("to must not be before from")
+	}
+	to = to.AddDate(0, 0, 1)
+	pricer, err := s.pricing.pricerFor(patient)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().In(s.location)
+	invoice := &models.Invoice{
+		PatientID:   patientID,
+		GuardianID:  guardianID,
+		Status:      models.InvoiceOpen,
+		IssueDate:   today.Format(dateLayout),
+		DueDate:     today.AddDate(0, 0, s.terms).Format(dateLayout),
+		CreatedByID: caller.StaffID,
+	}
+	unpaid := false
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		// Invoices for the patient are generated one at a time, so no session is invoiced twice
+		if err := repo.Patient.Lock(patientID); err != nil {
+			return err
+		}
+		sessions, err := billable(repo, patientID, from, to, time.Now())
+		if err != nil {
+			return err
+		}
+		if len(sessions) == 0 {
+			return ErrNothingToInvoice
+		}
+
+		sessionIDs := make([]string, 0, len(sessions))
+		for _, session := range sessions {
+			minutes := int(math.Round(session.EndTime.Sub(session.StartTime).Minutes()))
+			branchID := session.BranchID
+			if branchID == nil {
+				branchID = patient.PrimaryBranchID
+			}
+			start := session.StartTime.In(s.location)
+			quote, err := pricer.quote(branchID, minutes, start.Format(dateLayout))
+			if err != nil {
+				return err
+			}
+			line := models.InvoiceLine{
+				SessionID:      &session.ID,
+				Description:    fmt.Sprintf("%s session on %s", quote.TherapyType, start.Format("2 Jan 2006, 15:04")),
+				Minutes:        minutes,
+				RateCardID:     &quote.RateCardID,
+				UnitPrice:      quote.Price,
+				DiscountRuleID: quote.DiscountRuleID,
+				Discount:       quote.Discount,
+				Amount:         quote.Amount,
+			}
+			if quote.DiscountName != "" {
+				line.Description += " (" + quote.DiscountName + ")"
+			}
+			invoice.Lines = append(invoice.Lines, line)
+			invoice.Total += line.Amount
+			sessionIDs = append(sessionIDs, session.ID)
+		}
+
+		if err := repo.Invoice.Create(invoice); err != nil {
+			return err
+		}
+		return repo.Session.SetPaymentReceived(sessionIDs, &unpaid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByID(invoice.ID)
+}
+
+// ListByPatient lists a patient's invoices, newest first
+func (s *InvoiceService) ListByPatient(patientID string) ([]*models.Invoice, error) {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+	return s.repo.Invoice.FindByPatientID(patientID)
+}
+
+// GetByID gets an invoice with its lines and payments
+func (s *InvoiceService) GetByID(id int) (*models.Invoice, error) {
+	invoice, err := s.repo.Invoice.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvoiceNotFound
+	}
+	return invoice, err
+}
+
+// Void cancels an open invoice without payments, so its sessions can be invoiced again
+func (s *InvoiceService) Void(id int) (*models.Invoice, error) {
+	err := s.repo.Transaction(func(repo *repository.Repository) error {
+		// Lock the invoice so a payment recorded meanwhile isn't voided with it
+		invoice, err := repo.Invoice.FindForUpdate(id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvoiceNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if invoice.Status == models.InvoiceVoid {
+			return nil
+		}
+		if invoice.Paid > 0 {
+			return ErrInvoiceHasPayment
+		}
+
+		if err := repo.Invoice.Update(id, map[string]interface{}{
+			"status":    models.InvoiceVoid,
+			"voided_at": time.Now(),
+		}); err != nil {
+			return err
+		}
+		return repo.Session.SetPaymentReceived(invoiceSessions(invoice), nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByID(id)
+}
+
+// RecordPayment records money received against an open invoice. An invoice is paid once
+// its payments cover the total, and its sessions are then marked as paid.
+func (s *InvoiceService) RecordPayment(caller *models.Viewer, id int, payment *models.Payment) (*models.Invoice, error) {
+	if payment.Amount <= 0 {
+		return nil, errors.New("payment amount must be more than zero")
+	}
+	switch payment.Method {
+	case models.PaymentCash, models.PaymentUPI, models.PaymentCard:
+	default:
+		return nil, errors.New("payment method must be cash, upi or card")
+	}
+	if payment.ReceivedAt.IsZero() {
+		payment.ReceivedAt = time.Now()
+	}
+	if payment.ReceivedAt.After(time.Now()) {
+		return nil, errors.New("payments can't be received in the future")
+	}
+
+	err := s.repo.Transaction(func(repo *repository.Repository) error {
+		// Lock the invoice so concurrent payments can't both fit the same balance
+		invoice, err := repo.Invoice.FindForUpdate(id)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrInvoiceNotFound
+		}
+		if err != nil {
+			return err
+		}
+		if invoice.Status != models.InvoiceOpen {
+			return ErrInvoiceNotOpen
+		}
+		if payment.Amount > invoice.Balance() {
+			return fmt.Errorf("payment is more than the balance of %d paise", invoice.Balance())
+		}
+
+		payment.ID = 0
+		payment.InvoiceID = id
+		payment.ReceivedByID = caller.StaffID
+		if err := repo.Invoice.AddPayment(payment); err != nil {
+			return err
+		}
+		updates := map[string]interface{}{"paid": invoice.Paid + payment.Amount}
+		if invoice.Paid+payment.Amount < invoice.Total {
+			return repo.Invoice.Update(id, updates)
+		}
+		updates["status"] = models.InvoicePaid
+		if err := repo.Invoice.Update(id, updates); err != nil {
+			return err
+		}
+		paid := true
+		return repo.Session.SetPaymentReceived(invoiceSessions(invoice), &paid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByID(id)
+}
+
+// GuardianBalance totals what a guardian owes over their open invoices, oldest first
+func (s *InvoiceService) GuardianBalance(guardianID string) (*GuardianBalance, error) {
+	guardian, err := s.repo.Guardian.FindByID(guardianID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGuardianNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	invoices, err := s.repo.Invoice.FindByGuardianID(guardianID, models.InvoiceOpen)
+	if err != nil {
+		return nil, err
+	}
+
+	today := time.Now().In(s.location).Format(dateLayout)
+	balance := &GuardianBalance{GuardianID: guardian.ID, Name: guardian.Name, Invoices: invoices}
+	for _, invoice := range invoices {
+		balance.Outstanding += invoice.Balance()
+		if invoice.DueDate < today {
+			balance.Overdue += invoice.Balance()
+		}
+	}
+	return balance, nil
+}
+
+// Aging groups the balances of open invoices by guardian and by how long they're overdue
+func (s *InvoiceService) Aging(now time.Time) (*AgingReport, error) {
+	invoices, err := s.repo.Invoice.FindByStatus(models.InvoiceOpen)
+	if err != nil {
+		return nil, err
+	}
+
+	local := now.In(s.location)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	report := &AgingReport{AsOf: today.Format(dateLayout), Rows: []*AgingRow{}}
+	rows := map[string]*AgingRow{}
+	for _, invoice := range invoices {
+		row, ok := rows[invoice.GuardianID]
+		if !ok {
+			guardian, err := s.repo.Guardian.FindByID(invoice.GuardianID)
+			if err != nil {
+				return nil, err
+			}
+			row = &AgingRow{GuardianID: guardian.ID, Name: guardian.Name}
+			rows[invoice.GuardianID] = row
+			report.Rows = append(report.Rows, row)
+		}
+
+		due, err := time.Parse(dateLayout, invoice.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		daysOverdue := int(today.Sub(due).Hours() / 24)
+		row.add(invoice.Balance(), daysOverdue)
+		report.Totals.add(invoice.Balance(), daysOverdue)
+	}
+
+	slices.SortStableFunc(report.Rows, func(a, b *AgingRow) int {
+		return int(b.Total - a.Total)
+	})
+	return report, nil
+}
+
+// billTo checks the guardian an invoice is billed to, defaulting to the patient's only guardian
+func (s *InvoiceService) billTo(patientID, guardianID string) (string, error) {
+	if guardianID != "" {
+		isGuardian, err := s.repo.Guardian.IsGuardianOf(guardianID, patientID)
+		if err != nil {
+			return "", err
+		}
+		if !isGuardian {
+			return "", errors.New("invoices can only be billed to one of the patient's guardians")
+		}
+		return guardianID, nil
+	}
+
+	guardians, err := s.repo.Guardian.FindByPatient(patientID)
+	if err != nil {
+		return "", err
+	}
+	switch len(*guardians) {
+	case 0:
+		return "", errors.New("patient has no guardian to bill")
+	case 1:
+		return (*guardians)[0].ID, nil
+	default:
+		return "", errors.New("patient has several guardians, so guardian_id is required")
+	}
+}
+
+// billable finds a patient's sessions starting from from until before to that have ended
+// with activities recorded and aren't on an invoice yet
+func billable(repo *repository.Repository, patientID string, from, to, now time.Time) ([]*models.Session, error) {
+	sessions, err := repo.Session.FindByPatientID(patientID)
+	if err != nil {
+		return nil, err
+	}
+	var ended []*models.Session
+	var ids []string
+	for _, session := range sessions {
+		if session.StartTime.Before(from) || !session.StartTime.Before(to) || session.EndTime.After(now) {
+			continue
+		}
+		ended = append(ended, session)
+		ids = append(ids, session.ID)
+	}
+
+	recorded, err := repo.Activity.CountBySessionIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	invoiced, err := repo.Invoice.FindInvoicedSessionIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	var billable []*models.Session
+	for _, session := range ended {
+		if recorded[session.ID] > 0 && !slices.Contains(invoiced, session.ID) {
+			billable = append(billable, session)
+		}
+	}
+	slices.SortFunc(billable, func(a, b *models.Session) int {
+		return a.StartTime.Compare(b.StartTime)
+	})
+	return billable, nil
+}
+
+func invoiceSessions(invoice *models.Invoice) []string {
+	var ids []string
+	for _, line := range invoice.Lines {
+		if line.SessionID != nil {
+			ids = append(ids, *line.SessionID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,97 @@
+package service
+
+// backend/internal/service/invoice_service_test.go
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestInvoiceSessionsAndPaymentsOnce(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	therapyType := "TRM"
+	if err := repo.Patient.Update(patient.ID, map[string]interface{}{"therapy_types": therapyType}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Guardian.Create(&models.Guardian{ID: uuid.NewString(), Name: "Guardian", Patients: []*models.Patient{patient}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.RateCard.Create(&models.RateCard{TherapyType: therapyType, MinMinutes: 0, MaxMinutes: 120, Price: 100000, EffectiveFrom: "2000-01-01"}); err != nil {
+		t.Fatal(err)
+	}
+	location, _ := time.LoadLocation("Asia/Kolkata")
+	for days := 1; days <= 2; days++ {
+		start := time.Now().AddDate(0, 0, -days).Truncate(time.Hour)
+		session := &models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(time.Hour)}
+		if err := repo.Session.Create(session); err != nil {
+			t.Fatal(err)
+		}
+		if err := repo.Activity.Create(&models.Activity{ID: uuid.NewString(), SessionID: &session.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	service := NewInvoiceService(repo, config.Scheduling{Timezone: "Asia/Kolkata"}, config.Billing{PaymentTermDays: 15})
+	caller := &models.Viewer{StaffID: staff.ID, Role: models.RoleAdmin}
+	today := time.Now().In(location)
+	request := InvoiceRequest{From: today.AddDate(0, 0, -7).Format(dateLayout), To: today.Format(dateLayout)}
+
+	invoice, err := service.Generate(caller, patient.ID, request)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if len(invoice.Lines) != 2 || invoice.Total != 200000 {
+		t.Fatalf("invoice has %d lines totalling %d, want 2 totalling 200000", len(invoice.Lines), invoice.Total)
+	}
+	if _, err := service.Generate(caller, patient.ID, request); !errors.Is(err, ErrNothingToInvoice) {
+		t.Errorf("Generate() again error = %v, want %v", err, ErrNothingToInvoice)
+	}
+
+	// Voiding it, even twice, frees its sessions to be invoiced again
+	for i := 0; i < 2; i++ {
+		voided, err := service.Void(invoice.ID)
+		if err != nil || voided.Status != models.InvoiceVoid {
+			t.Fatalf("Void() = %v, %v, want the invoice voided", voided, err)
+		}
+	}
+	if invoice, err = service.Generate(caller, patient.ID, request); err != nil {
+		t.Fatalf("Generate() after voiding error = %v", err)
+	}
+	if _, err := service.Void(0); !errors.Is(err, ErrInvoiceNotFound) {
+		t.Errorf("Void() of an unknown invoice error = %v, want %v", err, ErrInvoiceNotFound)
+	}
+
+	payments := []struct {
+		amount  int64
+		wantErr bool
+		status  models.InvoiceStatus
+	}{
+		{150000, false, models.InvoiceOpen},
+		{100000, true, models.InvoiceOpen},
+		{50000, false, models.InvoicePaid},
+		{1, true, models.InvoicePaid},
+	}
+	for _, tt := range payments {
+		updated, err := service.RecordPayment(caller, invoice.ID, &models.Payment{Amount: tt.amount, Method: models.PaymentCash})
+		if (err != nil) != tt.wantErr {
+			t.Fatalf("RecordPayment(%d) error = %v, want error %v", tt.amount, err, tt.wantErr)
+		}
+		if err != nil {
+			continue
+		}
+		if updated.Status != tt.status {
+			t.Errorf("after paying %d the invoice is %s, want %s", tt.amount, updated.Status, tt.status)
+		}
+	}
+
+	if _, err := service.Void(invoice.ID); !errors.Is(err, ErrInvoiceHasPayment) {
+		t.Errorf("Void() of a paid invoice error = %v, want %v", err, ErrInvoiceHasPayment)
+	}
+}
@@ -0,0 +1,326 @@
+package service
+
+// backend/internal/service/medication_record_service.go
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// doseWindow is how early a scheduled dose can be given, and how late it can get before it's overdue
+const doseWindow = 30 * time.Minute
+
+var ErrDoseRecorded = errors.New("the dose has already been recorded")
+
+// DueDose is a scheduled dose still to be given at a branch today
+type DueDose struct {
+	PatientID   string           `json:"patient_id"`
+	PatientName string           `json:"patient_name"`
+	Medicine    *models.Medicine `json:"medicine"`
+	DueAt       time.Time        `json:"due_at"`
+	Overdue     bool             `json:"overdue"` // More than half an hour past due
+}
+
+type MedicationRecordServiceInterface interface {
+	ListByPatient(patientID, from, to string) ([]*models.MedicationAdministration, error)
+	Record(caller *models.Viewer, medicineID string, dose *models.MedicationAdministration, now time.Time) (*models.MedicationAdministration, error)
+	DueNow(branchID int, now time.Time) ([]*DueDose, error)
+}
+
+type MedicationRecordService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewMedicationRecordService(repo *repository.Repository, scheduling config.Scheduling) MedicationRecordServiceInterface {
+	return &MedicationRecordService{repo: repo, location: scheduling.Location()}
+}
+
+// List the doses given to or refused by a patient between the from and to dates, both
+// included, earliest first
+func (s *MedicationRecordService) ListByPatient(patientID, from, to string) ([]*models.MedicationAdministration, error) {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+	start, end, err := dateRange(s.location, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.MedicationAdministration.FindByPatientID(patientID, start, end)
+}
+
+// Record logs a dose of a medicine as given or refused, by default now and at the patient's
+// primary branch. Doses of scheduled medicines say which dose time they were due at, and
+// each dose time is recorded once; doses of other medicines are given as needed.
+func (s *MedicationRecordService) Record(caller *models.Viewer, medicineID string, dose *models.MedicationAdministration, now time.Time) (*models.MedicationAdministration, error) {
+	medicine, err := s.repo.Medicine.FindByID(medicineID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMedicineNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	patient, err := s.repo.Patient.FindByID(medicine.PatientID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMedicineNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !medicine.Active {
+		return nil, ErrMedicineDiscontinued
+	}
+
+	if dose.AdministeredAt.IsZero() {
+		dose.AdministeredAt = now
+	}
+	if dose.AdministeredAt.After(now) {
+		return nil, errors.New("doses can't be recorded ahead of time")
+	}
+	if err := checkDose(medicine, dose); err != nil {
+		return nil, err
+	}
+
+	if dose.BranchID == 0 {
+		if patient.PrimaryBranchID == nil {
+			return nil, errors.New("branch is required when the patient has no primary branch")
+		}
+		dose.BranchID = *patient.PrimaryBranchID
+	}
+	if _, err := s.repo.Branch.GetBranchByID(dose.BranchID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBranchNotFound
+		}
+		return nil, err
+	}
+
+	if scheduled(medicine) {
+		if dose.DueAt == nil {
+			return nil, errors.New("doses of scheduled medicines need the time they were due")
+		}
+		if !s.isDoseTime(medicine, *dose.DueAt) {
+			return nil, errors.New("the due time must be one of the medicine's dose times while it's prescribed")
+		}
+		if dose.AdministeredAt.Before(dose.DueAt.Add(-doseWindow)) {
+			return nil, errors.New("doses can't be given more than half an hour before they're due")
+		}
+		due := dose.DueAt.UTC()
+		dose.DueAt = &due
+		if _, err := s.repo.MedicationAdministration.FindDose(medicine.ID, due); err == nil {
+			return nil, ErrDoseRecorded
+		} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	} else {
+		if dose.DueAt != nil {
+			return nil, errors.New("only doses of scheduled medicines have a due time")
+		}
+		if !prescribedOn(medicine, dose.AdministeredAt.In(s.location).Format(dateLayout)) {
+			return nil, errors.New("the medicine isn't prescribed on that day")
+		}
+	}
+
+	dose.MedicineID = medicine.ID
+	dose.PatientID = medicine.PatientID
+	dose.AdministeredAt = dose.AdministeredAt.UTC()
+	dose.RecordedByID = caller.StaffID
+	if err := s.repo.MedicationAdministration.Create(dose); err != nil {
+		// Saving fails on the unique dose when another request recorded it since the check above
+		if dose.DueAt != nil {
+			if _, findErr := s.repo.MedicationAdministration.FindDose(medicine.ID, *dose.DueAt); findErr == nil {
+				return nil, ErrDoseRecorded
+			}
+		}
+		return nil, err
+	}
+	return dose, nil
+}
+
+// DueNow lists the scheduled doses still to be given today at a branch, earliest first. They're
+// the doses of patients with a session at the branch today that fall within its operating hours,
+// from half an hour before they're due until they're recorded. Closed branches have none.
+func (s *MedicationRecordService) DueNow(branchID int, now time.Time) ([]*DueDose, error) {
+	if _, err := s.repo.Branch.GetBranchByID(branchID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBranchNotFound
+		}
+		return nil, err
+	}
+
+	local := now.In(s.location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.location)
+	date := day.Format(dateLayout)
+	due := []*DueDose{}
+
+	closures, err := s.repo.BranchClosure.FindByBranch(branchID, date, date)
+	if err != nil {
+		return nil, err
+	}
+	if len(closures) > 0 {
+		return due, nil
+	}
+	opening, closing := 0, 24*60
+	hours, err := s.repo.OperatingHours.FindByBranchAndDay(branchID, int16(local.Weekday()))
+	if err == nil {
+		if hours.IsClosed {
+			return due, nil
+		}
+		if opening, err = minuteOfDay(hours.OpenTime); err != nil {
+			return nil, err
+		}
+		if closing, err = minuteOfDay(hours.CloseTime); err != nil {
+			return nil, err
+		}
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	sessions, err := s.repo.Session.FindByDateRange(branchID, day.UTC(), day.AddDate(0, 0, 1).UTC())
+	if err != nil {
+		return nil, err
+	}
+	patients := map[string]*models.Patient{}
+	var patientIDs []string
+	for _, session := range sessions {
+		if _, ok := patients[session.PatientID]; ok {
+			continue
+		}
+		patient, err := s.repo.Patient.FindByID(session.PatientID)
+		if err != nil {
+			return nil, err
+		}
+		patients[patient.ID] = patient
+		patientIDs = append(patientIDs, patient.ID)
+	}
+
+	medicines, err := s.repo.Medicine.FindScheduled(patientIDs)
+	if err != nil {
+		return nil, err
+	}
+	medicineIDs := make([]string, 0, len(medicines))
+	for _, medicine := range medicines {
+		medicineIDs = append(medicineIDs, medicine.ID)
+	}
+	recorded, err := s.repo.MedicationAdministration.FindDueBetween(medicineIDs, day.UTC(), day.AddDate(0, 0, 1).UTC())
+	if err != nil {
+		return nil, err
+	}
+	given := map[string]bool{}
+	for _, dose := range recorded {
+		given[doseKey(dose.MedicineID, *dose.DueAt)] = true
+	}
+
+	for _, medicine := range medicines {
+		if !scheduled(medicine) || !prescribedOn(medicine, date) {
+			continue
+		}
+		times, err := doseTimes(*medicine.DoseTimes)
+		if err != nil {
+			continue
+		}
+		for _, minute := range times {
+			if minute < opening || minute >= closing {
+				continue
+			}
+			dueAt := time.Date(day.Year(), day.Month(), day.Day(), minute/60, minute%60, 0, 0, s.location)
+			if dueAt.After(now.Add(doseWindow)) || given[doseKey(medicine.ID, dueAt)] {
+				continue
+			}
+			due = append(due, &DueDose{
+				PatientID:   medicine.PatientID,
+				PatientName: patients[medicine.PatientID].Name,
+				Medicine:    medicine,
+				DueAt:       dueAt,
+				Overdue:     now.After(dueAt.Add(doseWindow)),
+			})
+		}
+	}
+	sort.SliceStable(due, func(i, j int) bool {
+		if !due[i].DueAt.Equal(due[j].DueAt) {
+			return due[i].DueAt.Before(due[j].DueAt)
+		}
+		return due[i].PatientName < due[j].PatientName
+	})
+	return due, nil
+}
+
+// isDoseTime reports whether a time is one of a medicine's local dose times on a day it's prescribed
+func (s *MedicationRecordService) isDoseTime(medicine *models.Medicine, at time.Time) bool {
+	local := at.In(s.location)
+	if local.Second() != 0 || local.Nanosecond() != 0 || !prescribedOn(medicine, local.Format(dateLayout)) {
+		return false
+	}
+	times, err := doseTimes(*medicine.DoseTimes)
+	if err != nil {
+		return false
+	}
+	for _, minute := range times {
+		if local.Hour()*60+local.Minute() == minute {
+			return true
+		}
+	}
+	return false
+}
+
+// scheduled reports whether a medicine's doses are due at set times, rather than taken as needed
+func scheduled(medicine *models.Medicine) bool {
+	return medicine.Frequency != nil && *medicine.Frequency != models.FrequencyAsNeeded && medicine.DoseTimes != nil
+}
+
+// prescribedOn reports whether a date falls within a medicine's course. Medicines without
+// structured dosing have no course and are always prescribed, until they're discontinued.
+func prescribedOn(medicine *models.Medicine, date string) bool {
+	if !medicine.Active {
+		return false
+	}
+	if medicine.StartDate != nil && date < *medicine.StartDate {
+		return false
+	}
+	return medicine.EndDate == nil || date <= *medicine.EndDate
+}
+
+// checkDose validates a dose's status. Given doses default to the prescribed amount; refused
+// ones record none, but need a note of why.
+func checkDose(medicine *models.Medicine, dose *models.MedicationAdministration) error {
+	if dose.Notes != nil {
+		notes := strings.TrimSpace(*dose.Notes)
+		dose.Notes = &notes
+		if notes == "" {
+			dose.Notes = nil
+		}
+	}
+
+	switch dose.Status {
+	case models.DoseGiven:
+		if dose.DoseAmount == nil && dose.DoseUnit == nil {
+			dose.DoseAmount, dose.DoseUnit = medicine.DoseAmount, medicine.DoseUnit
+		}
+		if dose.DoseAmount == nil || *dose.DoseAmount <= 0 || dose.DoseUnit == nil || strings.TrimSpace(*dose.DoseUnit) == "" {
+			return errors.New("given doses need an amount and unit when the medicine has no structured dosing")
+		}
+	case models.DoseRefused:
+		if dose.Notes == nil {
+			return errors.New("refused doses need a note of why")
+		}
+		dose.DoseAmount, dose.DoseUnit = nil, nil
+	default:
+		return errors.New("status must be given or refused")
+	}
+	return nil
+}
+
+func doseKey(medicineID string, dueAt time.Time) string {
+	return fmt.Sprintf("%s@%d", medicineID, dueAt.Unix())
+}
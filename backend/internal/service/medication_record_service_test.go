@@ -0,0 +1,267 @@
+package service
+
+// backend/internal/service/medication_record_service_test.go
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+func TestPrescribedOn(t *testing.T) {
+	start, end := "2026-03-01", "2026-03-10"
+	tests := []struct {
+		name     string
+		medicine models.Medicine
+		date     string
+		want     bool
+	}{
+		{"no course", models.Medicine{Active: true}, "2020-01-01", true},
+		{"discontinued", models.Medicine{Active: false}, "2026-03-05", false},
+		{"before the course", models.Medicine{Active: true, StartDate: &start, EndDate: &end}, "2026-02-28", false},
+		{"first day", models.Medicine{Active: true, StartDate: &start, EndDate: &end}, "2026-03-01", true},
+		{"last day", models.Medicine{Active: true, StartDate: &start, EndDate: &end}, "2026-03-10", true},
+		{"after the course", models.Medicine{Active: true, StartDate: &start, EndDate: &end}, "2026-03-11", false},
+		{"open ended", models.Medicine{Active: true, StartDate: &start}, "2030-01-01", true},
+	}
+	for _, tt := range tests {
+		if got := prescribedOn(&tt.medicine, tt.date); got != tt.want {
+			t.Errorf("%s: prescribedOn(%s) = %v, want %v", tt.name, tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestIsDoseTime(t *testing.T) {
+	location, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	service := &MedicationRecordService{location: location}
+	start, times := "2026-03-01", "09:00,21:00"
+	medicine := &models.Medicine{Active: true, StartDate: &start, DoseTimes: &times}
+
+	tests := []struct {
+		at   time.Time
+		want bool
+	}{
+		{time.Date(2026, 3, 2, 9, 0, 0, 0, location), true},
+		{time.Date(2026, 3, 2, 3, 30, 0, 0, time.UTC), true}, // 09:00 in Kolkata
+		{time.Date(2026, 3, 2, 21, 0, 0, 0, location), true},
+		{time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC), false},
+		{time.Date(2026, 3, 2, 9, 1, 0, 0, location), false},
+		{time.Date(2026, 3, 2, 9, 0, 30, 0, location), false},
+		{time.Date(2026, 2, 28, 9, 0, 0, 0, location), false}, // Before the course
+	}
+	for _, tt := range tests {
+		if got := service.isDoseTime(medicine, tt.at); got != tt.want {
+			t.Errorf("isDoseTime(%v) = %v, want %v", tt.at, got, tt.want)
+		}
+	}
+}
+
+// medicationFixture is a patient with a session at a branch on Monday 2 March 2026, taking a
+// medicine twice a day at 09:00 and 14:00 and another as needed
+type medicationFixture struct {
+	repo      *repository.Repository
+	records   MedicationRecordServiceInterface
+	branch    *models.Branch
+	caller    *models.Viewer
+	scheduled *models.Medicine
+	asNeeded  *models.Medicine
+}
+
+func newMedicationFixture(t *testing.T, hours ...*models.OperatingHours) *medicationFixture {
+	t.Helper()
+	repo := newTestRepository(t)
+	scheduling := config.Scheduling{Timezone: "UTC"}
+	branches := NewBranchService(repo, scheduling)
+	branch, err := branches.Create(&models.Branch{OpeningDate: time.Now(), Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hours) > 0 {
+		if _, err := branches.SetHours(branch.ID, hours); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	patient, staff := createTestPatient(t, repo)
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	if err := repo.Session.Create(&models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, BranchID: &branch.ID, StartTime: start, EndTime: start.Add(2 * time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	medicines := NewMedicineService(repo, scheduling)
+	amount, unit, route, startDate := 5.0, "mg", models.RouteOral, "2026-03-01"
+	twice, asNeeded, times := models.FrequencyTwiceDaily, models.FrequencyAsNeeded, "09:00,14:00"
+	scheduled, err := medicines.Create(&models.Medicine{Name: "Melatonin", PatientID: patient.ID, PrescriberID: staff.ID,
+		DoseAmount: &amount, DoseUnit: &unit, Route: &route, Frequency: &twice, DoseTimes: &times, StartDate: &startDate}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := medicines.Create(&models.Medicine{Name: "Paracetamol", PatientID: patient.ID, PrescriberID: staff.ID,
+		DoseAmount: &amount, DoseUnit: &unit, Route: &route, Frequency: &asNeeded, StartDate: &startDate}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &medicationFixture{
+		repo:      repo,
+		records:   NewMedicationRecordService(repo, scheduling),
+		branch:    branch,
+		caller:    &models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist},
+		scheduled: scheduled,
+		asNeeded:  other,
+	}
+}
+
+func TestRecordDose(t *testing.T) {
+	at := func(hour, minute int) *time.Time {
+		when := time.Date(2026, 3, 2, hour, minute, 0, 0, time.UTC)
+		return &when
+	}
+	refused := "Spat it out"
+
+	tests := []struct {
+		name     string
+		asNeeded bool
+		status   models.DoseStatus
+		dueAt    *time.Time
+		given    *time.Time
+		notes    *string
+		wantErr  bool
+	}{
+		{name: "scheduled dose on time", status: models.DoseGiven, dueAt: at(9, 0), given: at(9, 10)},
+		{name: "scheduled dose half an hour early", status: models.DoseGiven, dueAt: at(14, 0), given: at(13, 30)},
+		{name: "refused with a note", status: models.DoseRefused, dueAt: at(9, 0), given: at(9, 5), notes: &refused},
+		{name: "refused without a note", status: models.DoseRefused, dueAt: at(9, 0), given: at(9, 5), wantErr: true},
+		{name: "scheduled dose without a due time", status: models.DoseGiven, given: at(9, 0), wantErr: true},
+		{name: "due time that isn't a dose time", status: models.DoseGiven, dueAt: at(10, 0), given: at(10, 0), wantErr: true},
+		{name: "too early", status: models.DoseGiven, dueAt: at(14, 0), given: at(13, 0), wantErr: true},
+		{name: "ahead of time", status: models.DoseGiven, dueAt: at(14, 0), given: at(14, 0), wantErr: true},
+		{name: "as needed", asNeeded: true, status: models.DoseGiven, given: at(11, 0)},
+		{name: "as needed with a due time", asNeeded: true, status: models.DoseGiven, dueAt: at(9, 0), given: at(11, 0), wantErr: true},
+		{name: "unknown status", status: "skipped", dueAt: at(9, 0), given: at(9, 0), wantErr: true},
+	}
+	now := *at(13, 45)
+	for _, tt := range tests {
+		f := newMedicationFixture(t)
+		medicine := f.scheduled
+		if tt.asNeeded {
+			medicine = f.asNeeded
+		}
+		dose, err := f.records.Record(f.caller, medicine.ID, &models.MedicationAdministration{
+			BranchID:       f.branch.ID,
+			Status:         tt.status,
+			DueAt:          tt.dueAt,
+			AdministeredAt: *tt.given,
+			Notes:          tt.notes,
+		}, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Record error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if given := tt.status == models.DoseGiven; given != (dose.DoseAmount != nil && *dose.DoseAmount == 5) {
+			t.Errorf("%s: recorded amount %v, want the prescribed amount only when given", tt.name, dose.DoseAmount)
+		}
+	}
+
+	// Each dose time is recorded once, whether it was given or refused
+	f := newMedicationFixture(t)
+	if _, err := f.records.Record(f.caller, f.scheduled.ID, &models.MedicationAdministration{BranchID: f.branch.ID, Status: models.DoseRefused, DueAt: at(9, 0), Notes: &refused}, now); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.records.Record(f.caller, f.scheduled.ID, &models.MedicationAdministration{BranchID: f.branch.ID, Status: models.DoseGiven, DueAt: at(9, 0)}, now); !errors.Is(err, ErrDoseRecorded) {
+		t.Errorf("recording the dose again error = %v, want %v", err, ErrDoseRecorded)
+	}
+
+	// Even when another request records it between the check and saving
+	racing := *f.repo
+	racing.MedicationAdministration = &racingDoses{MedicationAdministrationRepository: f.repo.MedicationAdministration}
+	records := NewMedicationRecordService(&racing, config.Scheduling{Timezone: "UTC"})
+	if _, err := records.Record(f.caller, f.scheduled.ID, &models.MedicationAdministration{BranchID: f.branch.ID, Status: models.DoseGiven, DueAt: at(9, 0)}, now); !errors.Is(err, ErrDoseRecorded) {
+		t.Errorf("recording the dose at the same time error = %v, want %v", err, ErrDoseRecorded)
+	}
+}
+
+// racingDoses misses the first dose it's asked to find, as if it were recorded just after
+type racingDoses struct {
+	repository.MedicationAdministrationRepository
+	looked bool
+}
+
+func (r *racingDoses) FindDose(medicineID string, dueAt time.Time) (*models.MedicationAdministration, error) {
+	if !r.looked {
+		r.looked = true
+		return nil, gorm.ErrRecordNotFound
+	}
+	return r.MedicationAdministrationRepository.FindDose(medicineID, dueAt)
+}
+
+func TestDueNow(t *testing.T) {
+	at := func(hour, minute int) time.Time { return time.Date(2026, 3, 2, hour, minute, 0, 0, time.UTC) }
+	monday := int16(time.Monday)
+
+	tests := []struct {
+		name     string
+		hours    []*models.OperatingHours
+		recorded []time.Time // Due times of doses already recorded
+		now      time.Time
+		want     []time.Time
+		overdue  []bool
+	}{
+		{name: "before the window", now: at(8, 29)},
+		{name: "within the window", now: at(8, 30), want: []time.Time{at(9, 0)}, overdue: []bool{false}},
+		{name: "overdue", now: at(9, 31), want: []time.Time{at(9, 0)}, overdue: []bool{true}},
+		{name: "both due", now: at(13, 45), want: []time.Time{at(9, 0), at(14, 0)}, overdue: []bool{true, false}},
+		{name: "recorded doses drop off", recorded: []time.Time{at(9, 0)}, now: at(13, 45), want: []time.Time{at(14, 0)}, overdue: []bool{false}},
+		{
+			name:    "outside the branch hours",
+			hours:   []*models.OperatingHours{{DayOfWeek: monday, OpenTime: "10:00", CloseTime: "17:00"}},
+			now:     at(13, 45),
+			want:    []time.Time{at(14, 0)},
+			overdue: []bool{false},
+		},
+		{name: "branch closed", hours: []*models.OperatingHours{{DayOfWeek: monday, IsClosed: true}}, now: at(13, 45)},
+		{name: "the next day", now: at(33, 45)},
+	}
+	for _, tt := range tests {
+		f := newMedicationFixture(t, tt.hours...)
+		for _, dueAt := range tt.recorded {
+			if _, err := f.records.Record(f.caller, f.scheduled.ID, &models.MedicationAdministration{BranchID: f.branch.ID, Status: models.DoseGiven, DueAt: &dueAt}, tt.now); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		due, err := f.records.DueNow(f.branch.ID, tt.now)
+		if err != nil {
+			t.Errorf("%s: DueNow error = %v", tt.name, err)
+			continue
+		}
+		if len(due) != len(tt.want) {
+			t.Errorf("%s: %d doses due, want %d", tt.name, len(due), len(tt.want))
+			continue
+		}
+		for i, dose := range due {
+			if !dose.DueAt.Equal(tt.want[i]) || dose.Overdue != tt.overdue[i] || dose.Medicine.ID != f.scheduled.ID {
+				t.Errorf("%s: %s due at %v (overdue %v), want %s at %v (overdue %v)", tt.name,
+					dose.Medicine.Name, dose.DueAt, dose.Overdue, f.scheduled.Name, tt.want[i], tt.overdue[i])
+			}
+		}
+	}
+
+	f := newMedicationFixture(t)
+	if _, err := f.records.DueNow(f.branch.ID+100, at(9, 0)); !errors.Is(err, ErrBranchNotFound) {
+		t.Errorf("an unknown branch error = %v, want %v", err, ErrBranchNotFound)
+	}
+}
@@ -0,0 +1,445 @@
+package service
+
+// backend/internal/service/medicine_service.go
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrMedicineNotFound     = errors.New("medicine not found")
+	ErrMedicineDiscontinued = errors.New("the medicine has been discontinued")
+	ErrRemindersNotAllowed  = errors.New("only admins can view another doctor's prescription reminders")
+	ErrMedicineHasHistory   = errors.New("medicines with doses or prescription changes recorded can't be deleted; discontinue them instead")
+)
+
+// PrescriptionReminder is a medicine whose supply runs out, or whose prescription expires,
+// within the days asked for or already has
+type PrescriptionReminder struct {
+	Medicine    *models.Medicine `json:"medicine"`
+	PatientName string           `json:"patient_name"`
+	Due         string           `json:"due"` // refill or renewal
+	DueDate     string           `json:"due_date"`
+	DaysLeft    int              `json:"days_left"` // Negative once overdue
+}
+
+// dosesPerDay is how many dose times each scheduled frequency has
+var dosesPerDay = map[models.DoseFrequency]int{
+	models.FrequencyOnceDaily:       1,
+	models.FrequencyTwiceDaily:      2,
+	models.FrequencyThreeTimesDaily: 3,
+	models.FrequencyFourTimesDaily:  4,
+}
+
+type MedicineServiceInterface interface {
+	ListByPatient(patientID string) ([]*models.Medicine, error)
+	Create(medicine *models.Medicine, reason string) (*models.Medicine, error)
+	GetByID(id string) (*models.Medicine, error)
+	Update(caller *models.Viewer, id string, updates map[string]interface{}, reason string) (*models.Medicine, error)
+	Discontinue(caller *models.Viewer, id string, reason string) (*models.Medicine, error)
+	Delete(id string) error
+	Timeline(patientID string) ([]*models.PrescriptionEvent, error)
+	Reminders(caller *models.Viewer, staffID string, days int, now time.Time) ([]*PrescriptionReminder, error)
+}
+
+type MedicineService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewMedicineService(repo *repository.Repository, scheduling config.Scheduling) MedicineServiceInterface {
+	return &MedicineService{repo: repo, location: scheduling.Location()}
+}
+
+// List the medicines prescribed to a patient
+func (s *MedicineService) ListByPatient(patientID string) ([]*models.Medicine, error) {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+	return s.repo.Medicine.FindByPatientID(patientID)
+}
+
+// Prescribe a new medicine, starting its prescription history. The reason is optional.
+func (s *MedicineService) Create(medicine *models.Medicine, reason string) (*models.Medicine, error) {
+	if medicine.Name == "" {
+		return nil, errors.New("medicine name is required")
+	}
+	if err := checkDosing(medicine); err != nil {
+		return nil, err
+	}
+	if err := checkDueDates(medicine); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.Patient.FindByID(medicine.PatientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+
+	medicine.ID = uuid.NewString()
+	medicine.Active = true
+	err := s.repo.Transaction(func(repo *repository.Repository) error {
+		if err := repo.Medicine.Create(medicine); err != nil {
+			return err
+		}
+		return recordPrescription(repo, medicine, models.PrescriptionStarted, medicine.PrescriberID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return medicine, nil
+}
+
+// Get a medicine by ID. Medicines of patients outside the caller's caseload aren't found.
+func (s *MedicineService) GetByID(id string) (*models.Medicine, error) {
+	medicine, err := s.repo.Medicine.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMedicineNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.Patient.FindByID(medicine.PatientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrMedicineNotFound
+		}
+		return nil, err
+	}
+	return medicine, nil
+}
+
+// Update a medicine. The patient and prescriber of a prescription can't be changed, and
+// discontinued medicines can't be changed at all. Changes to the prescription itself, rather
+// than its refill and renewal dates, are recorded as an adjustment by the caller and need a reason.
+func (s *MedicineService) Update(caller *models.Viewer, id string, updates map[string]interface{}, reason string) (*models.Medicine, error) {
+	existing, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !existing.Active {
+		return nil, ErrMedicineDiscontinued
+	}
+
+	delete(updates, "id")
+	delete(updates, "patient_id")
+	delete(updates, "prescriber_id")
+	delete(updates, "active")
+	// The dosing is checked as it ends up, since an update can change any part of it
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		if err := repo.Medicine.Update(id, updates); err != nil {
+			return err
+		}
+		medicine, err := repo.Medicine.FindByID(id)
+		if err != nil {
+			return err
+		}
+		if err := checkDosing(medicine); err != nil {
+			return err
+		}
+		if err := checkDueDates(medicine); err != nil {
+			return err
+		}
+		if reflect.DeepEqual(prescriptionOf(existing), prescriptionOf(medicine)) {
+			return nil
+		}
+		if strings.TrimSpace(reason) == "" {
+			return errors.New("a reason is required to change a prescription")
+		}
+		return recordPrescription(repo, medicine, models.PrescriptionAdjusted, caller.StaffID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByID(id)
+}
+
+// Discontinue stops a medicine, recording why. It stays in the patient's history, but no
+// more doses are due or can be recorded.
+func (s *MedicineService) Discontinue(caller *models.Viewer, id string, reason string) (*models.Medicine, error) {
+	medicine, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if !medicine.Active {
+		return nil, ErrMedicineDiscontinued
+	}
+	if strings.TrimSpace(reason) == "" {
+		return nil, errors.New("a reason is required to discontinue a medicine")
+	}
+
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		if err := repo.Medicine.Update(id, map[string]interface{}{"active": false}); err != nil {
+			return err
+		}
+		medicine.Active = false
+		return recordPrescription(repo, medicine, models.PrescriptionDiscontinued, caller.StaffID, reason)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByID(id)
+}
+
+// Delete a medicine prescribed by mistake. Medicines with doses recorded, or changed since they
+// were started, are part of the patient's record and can only be discontinued.
+func (s *MedicineService) Delete(id string) error {
+	if _, err := s.GetByID(id); err != nil {
+		return err
+	}
+	return s.repo.Transaction(func(repo *repository.Repository) error {
+		doses, err := repo.MedicationAdministration.CountByMedicineID(id)
+		if err != nil {
+			return err
+		}
+		if doses > 0 {
+			return ErrMedicineHasHistory
+		}
+		latest, err := repo.PrescriptionEvent.FindLatest(id)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if latest != nil && (latest.Version > 1 || latest.Kind != models.PrescriptionStarted) {
+			return ErrMedicineHasHistory
+		}
+		if err := repo.PrescriptionEvent.DeleteByMedicineID(id); err != nil {
+			return err
+		}
+		return repo.Medicine.Delete(id)
+	})
+}
+
+// Timeline lists the prescription events of all of a patient's medicines, earliest first
+func (s *MedicineService) Timeline(patientID string) ([]*models.PrescriptionEvent, error) {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("patient not found")
+		}
+		return nil, err
+	}
+	return s.repo.PrescriptionEvent.FindByPatientID(patientID)
+}
+
+// Reminders lists the refills and renewals due within days of today for the active medicines
+// a doctor prescribed, including overdue ones, soonest first. Doctors see their own; admins
+// can see anyone's. Medicines whose course has ended aren't included.
+func (s *MedicineService) Reminders(caller *models.Viewer, staffID string, days int, now time.Time) ([]*PrescriptionReminder, error) {
+	if caller.StaffID != staffID && caller.Role != models.RoleAdmin {
+		return nil, ErrRemindersNotAllowed
+	}
+	if days < 0 || days > 90 {
+		return nil, errors.New("days must be between 0 and 90")
+	}
+	if _, err := s.repo.Staff.FindByID(staffID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("staff member not found")
+		}
+		return nil, err
+	}
+
+	medicines, err := s.repo.Medicine.FindByPrescriberID(staffID)
+	if err != nil {
+		return nil, err
+	}
+	local := now.In(s.location)
+	today := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, time.UTC)
+	until := today.AddDate(0, 0, days).Format(dateLayout)
+
+	reminders := []*PrescriptionReminder{}
+	for _, medicine := range medicines {
+		if !medicine.Active || (medicine.EndDate != nil && *medicine.EndDate < today.Format(dateLayout)) {
+			continue
+		}
+		dates := map[string]*string{"refill": medicine.RefillDate, "renewal": medicine.RenewalDate}
+		for due, date := range dates {
+			if date == nil || *date > until {
+				delete(dates, due)
+			}
+		}
+		if len(dates) == 0 {
+			continue
+		}
+		patient, err := s.repo.Patient.FindByID(medicine.PatientID)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// No longer in the caller's caseload
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for due, date := range dates {
+			day, err := time.Parse(dateLayout, *date)
+			if err != nil {
+				return nil, err
+			}
+			reminders = append(reminders, &PrescriptionReminder{
+				Medicine:    medicine,
+				PatientName: patient.Name,
+				Due:         due,
+				DueDate:     *date,
+				DaysLeft:    int(day.Sub(today).Hours() / 24),
+			})
+		}
+	}
+	sort.Slice(reminders, func(i, j int) bool {
+		if reminders[i].DueDate != reminders[j].DueDate {
+			return reminders[i].DueDate < reminders[j].DueDate
+		}
+		if reminders[i].PatientName != reminders[j].PatientName {
+			return reminders[i].PatientName < reminders[j].PatientName
+		}
+		return reminders[i].Due < reminders[j].Due
+	})
+	return reminders, nil
+}
+
+// recordPrescription records a medicine's prescription as it now stands, as its next version
+func recordPrescription(repo *repository.Repository, medicine *models.Medicine, kind models.PrescriptionChange, prescriberID, reason string) error {
+	version := 1
+	latest, err := repo.PrescriptionEvent.FindLatest(medicine.ID)
+	if err == nil {
+		version = latest.Version + 1
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	event := prescriptionOf(medicine)
+	event.MedicineID = medicine.ID
+	event.Version = version
+	event.PatientID = medicine.PatientID
+	event.Kind = kind
+	event.PrescriberID = prescriberID
+	if reason = strings.TrimSpace(reason); reason != "" {
+		event.Reason = &reason
+	}
+	return repo.PrescriptionEvent.Create(event)
+}
+
+// prescriptionOf is the part of a medicine its prescription events keep
+func prescriptionOf(medicine *models.Medicine) *models.PrescriptionEvent {
+	return &models.PrescriptionEvent{
+		Name:       medicine.Name,
+		BrandName:  medicine.BrandName,
+		Dosage:     medicine.Dosage,
+		DoseAmount: medicine.DoseAmount,
+		DoseUnit:   medicine.DoseUnit,
+		Route:      medicine.Route,
+		Frequency:  medicine.Frequency,
+		DoseTimes:  medicine.DoseTimes,
+		StartDate:  medicine.StartDate,
+		EndDate:    medicine.EndDate,
+	}
+}
+
+// checkDueDates validates a medicine's refill and renewal dates, which are both optional
+func checkDueDates(medicine *models.Medicine) error {
+	if medicine.RefillDate != nil {
+		if _, err := time.Parse(dateLayout, *medicine.RefillDate); err != nil {
+			return errors.New("refill date must look like 2026-01-31")
+		}
+	}
+	if medicine.RenewalDate != nil {
+		if _, err := time.Parse(dateLayout, *medicine.RenewalDate); err != nil {
+			return errors.New("renewal date must look like 2026-01-31")
+		}
+	}
+	return nil
+}
+
+// checkDosing validates a medicine's structured dosing. Medicines with only free text dosage
+// have none; otherwise the amount, unit, route, frequency and start date are all required.
+func checkDosing(medicine *models.Medicine) error {
+	if medicine.DoseAmount == nil && medicine.DoseUnit == nil && medicine.Route == nil && medicine.Frequency == nil &&
+		medicine.DoseTimes == nil && medicine.StartDate == nil && medicine.EndDate == nil {
+		return nil
+	}
+
+	switch {
+	case medicine.DoseAmount == nil || *medicine.DoseAmount <= 0:
+		return errors.New("structured dosing needs a dose amount above zero")
+	case medicine.DoseUnit == nil || strings.TrimSpace(*medicine.DoseUnit) == "":
+		return errors.New("structured dosing needs a dose unit")
+	case medicine.Route == nil:
+		return errors.New("structured dosing needs a route")
+	case medicine.Frequency == nil:
+		return errors.New("structured dosing needs a frequency")
+	case medicine.StartDate == nil:
+		return errors.New("structured dosing needs a start date")
+	}
+
+	switch *medicine.Route {
+	case models.RouteOral, models.RouteSublingual, models.RouteTopical, models.RouteInhaled,
+		models.RouteNasal, models.RouteRectal, models.RouteInjection:
+	default:
+		return errors.New("route must be oral, sublingual, topical, inhaled, nasal, rectal or injection")
+	}
+
+	start, err := time.Parse(dateLayout, *medicine.StartDate)
+	if err != nil {
+		return errors.New("start date must look like 2026-01-31")
+	}
+	if medicine.EndDate != nil {
+		end, err := time.Parse(dateLayout, *medicine.EndDate)
+		if err != nil {
+			return errors.New("end date must look like 2026-01-31")
+		}
+		if end.Before(start) {
+			return errors.New("end date must not be before the start date")
+		}
+	}
+
+	if *medicine.Frequency == models.FrequencyAsNeeded {
+		if medicine.DoseTimes != nil {
+			return errors.New("medicines taken as needed have no dose times")
+		}
+		return nil
+	}
+	doses, ok := dosesPerDay[*medicine.Frequency]
+	if !ok {
+		return errors.New("frequency must be once_daily, twice_daily, three_times_daily, four_times_daily or as_needed")
+	}
+	if medicine.DoseTimes == nil {
+		return fmt.Errorf("%s medicines need %d dose times, such as 09:00,14:00", *medicine.Frequency, doses)
+	}
+	times, err := doseTimes(*medicine.DoseTimes)
+	if err != nil {
+		return err
+	}
+	if len(times) != doses {
+		return fmt.Errorf("%s medicines need %d dose times, such as 09:00,14:00", *medicine.Frequency, doses)
+	}
+	return nil
+}
+
+// doseTimes reads dose times such as 09:00,14:00 as minutes after midnight. They must be in order.
+func doseTimes(value string) ([]int, error) {
+	var times []int
+	for _, part := range strings.Split(value, ",") {
+		minute, err := minuteOfDay(part)
+		if err != nil {
+			return nil, errors.New("dose times must look like 09:00,14:00")
+		}
+		if len(times) > 0 && minute <= times[len(times)-1] {
+			return nil, errors.New("dose times must be in order through the day")
+		}
+		times = append(times, minute)
+	}
+	return times, nil
+}
@@ -0,0 +1,274 @@
+package service
+
+// backend/internal/service/medicine_service_test.go
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestDoseTimes(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []int
+		wantErr bool
+	}{
+		{"09:00", []int{540}, false},
+		{"08:00,14:30,21:00", []int{480, 870, 1260}, false},
+		{"00:00,23:59", []int{0, 1439}, false},
+		{"14:00,09:00", nil, true},
+		{"09:00,09:00", nil, true},
+		{"9am", nil, true},
+		{"", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := doseTimes(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("doseTimes(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("doseTimes(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestCheckDosing(t *testing.T) {
+	amount, unit, start, end := 5.0, "mg", "2026-03-01", "2026-02-01"
+	route := models.RouteOral
+	twice, asNeeded := models.FrequencyTwiceDaily, models.FrequencyAsNeeded
+	twoTimes, oneTime := "09:00,21:00", "09:00"
+	dosing := func(frequency *models.DoseFrequency, times *string) models.Medicine {
+		return models.Medicine{DoseAmount: &amount, DoseUnit: &unit, Route: &route, Frequency: frequency, DoseTimes: times, StartDate: &start}
+	}
+	with := func(medicine models.Medicine, change func(medicine *models.Medicine)) models.Medicine {
+		change(&medicine)
+		return medicine
+	}
+
+	tests := []struct {
+		name     string
+		medicine models.Medicine
+		wantErr  bool
+	}{
+		{"free text dosage only", models.Medicine{}, false},
+		{"scheduled", dosing(&twice, &twoTimes), false},
+		{"as needed", dosing(&asNeeded, nil), false},
+		{"as needed with dose times", dosing(&asNeeded, &oneTime), true},
+		{"too few dose times", dosing(&twice, &oneTime), true},
+		{"no dose times", dosing(&twice, nil), true},
+		{"no route", with(dosing(&twice, &twoTimes), func(m *models.Medicine) { m.Route = nil }), true},
+		{"no start date", with(dosing(&twice, &twoTimes), func(m *models.Medicine) { m.StartDate = nil }), true},
+		{"ends before it starts", with(dosing(&twice, &twoTimes), func(m *models.Medicine) { m.EndDate = &end }), true},
+	}
+	for _, tt := range tests {
+		if err := checkDosing(&tt.medicine); (err != nil) != tt.wantErr {
+			t.Errorf("%s: checkDosing error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestDeleteMedicineWithHistory(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, doctor := createTestPatient(t, repo)
+	service := NewMedicineService(repo, config.Scheduling{Timezone: "UTC"})
+	caller := &models.Viewer{StaffID: doctor.ID, Role: models.RoleDoctor}
+
+	tests := []struct {
+		name    string
+		history func(medicine *models.Medicine) error
+		wantErr error
+	}{
+		{"only started", func(*models.Medicine) error { return nil }, nil},
+		{"adjusted", func(medicine *models.Medicine) error {
+			_, err := service.Update(caller, medicine.ID, map[string]interface{}{"dosage": "10mg"}, "Too drowsy")
+			return err
+		}, ErrMedicineHasHistory},
+		{"dose given", func(medicine *models.Medicine) error {
+			return repo.MedicationAdministration.Create(&models.MedicationAdministration{
+				MedicineID:     medicine.ID,
+				PatientID:      medicine.PatientID,
+				Status:         models.DoseGiven,
+				AdministeredAt: time.Now(),
+				RecordedByID:   doctor.ID,
+			})
+		}, ErrMedicineHasHistory},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			medicine, err := service.Create(&models.Medicine{Name: "Melatonin", PatientID: patient.ID, PrescriberID: doctor.ID}, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.history(medicine); err != nil {
+				t.Fatal(err)
+			}
+			if err := service.Delete(medicine.ID); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Delete() error = %v, want %v", err, tt.wantErr)
+			}
+			_, err = service.GetByID(medicine.ID)
+			if deleted := errors.Is(err, ErrMedicineNotFound); deleted != (tt.wantErr == nil) {
+				t.Errorf("medicine deleted = %v, want %v", deleted, tt.wantErr == nil)
+			}
+		})
+	}
+}
+
+func TestPrescriptionVersions(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, doctor := createTestPatient(t, repo)
+	service := NewMedicineService(repo, config.Scheduling{Timezone: "UTC"})
+	caller := &models.Viewer{StaffID: doctor.ID, Role: models.RoleDoctor}
+	medicine, err := service.Create(&models.Medicine{Name: "Melatonin", PatientID: patient.ID, PrescriberID: doctor.ID}, "Trouble sleeping")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		updates  map[string]interface{}
+		reason   string
+		discard  bool // Discontinue rather than update
+		wantKind models.PrescriptionChange
+		wantErr  bool
+	}{
+		{name: "dosage changed", updates: map[string]interface{}{"dosage": "10mg"}, reason: "Too drowsy", wantKind: models.PrescriptionAdjusted},
+		{name: "dosage changed without a reason", updates: map[string]interface{}{"dosage": "5mg"}, wantErr: true},
+		{name: "refill date only", updates: map[string]interface{}{"refill_date": "2026-04-01"}},
+		{name: "patient can't be moved", updates: map[string]interface{}{"patient_id": "someone-else"}},
+		{name: "discontinued", discard: true, reason: "No longer needed", wantKind: models.PrescriptionDiscontinued},
+		{name: "changed once discontinued", updates: map[string]interface{}{"dosage": "5mg"}, reason: "Restart", wantErr: true},
+		{name: "discontinued again", discard: true, reason: "Again", wantErr: true},
+	}
+	versions := 1
+	for _, tt := range tests {
+		if tt.discard {
+			_, err = service.Discontinue(caller, medicine.ID, tt.reason)
+		} else {
+			_, err = service.Update(caller, medicine.ID, tt.updates, tt.reason)
+		}
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+
+		events, err := service.Timeline(patient.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.wantKind != "" {
+			versions++
+		}
+		if len(events) != versions {
+			t.Errorf("%s: %d prescription events, want %d", tt.name, len(events), versions)
+			continue
+		}
+		latest := events[len(events)-1]
+		if latest.Version != versions || (tt.wantKind != "" && (latest.Kind != tt.wantKind || latest.Reason == nil || *latest.Reason != tt.reason)) {
+			t.Errorf("%s: latest event is version %d, %s, want version %d, %s because %q", tt.name, latest.Version, latest.Kind, versions, tt.wantKind, tt.reason)
+		}
+	}
+
+	events, err := service.Timeline(patient.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 || events[0].Kind != models.PrescriptionStarted || events[0].Dosage != nil || *events[1].Dosage != "10mg" || events[1].PatientID != patient.ID {
+		t.Errorf("timeline = %+v, want started, adjusted to 10mg and discontinued", events)
+	}
+}
+
+func TestPrescriptionReminders(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, doctor := createTestPatient(t, repo)
+	service := NewMedicineService(repo, config.Scheduling{Timezone: "UTC"})
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	prescribe := func(name string, refill, renewal, end *string, discontinued bool) {
+		t.Helper()
+		medicine, err := service.Create(&models.Medicine{Name: name, PatientID: patient.ID, PrescriberID: doctor.ID, RefillDate: refill, RenewalDate: renewal}, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if end != nil {
+			if err := repo.Medicine.Update(medicine.ID, map[string]interface{}{"end_date": *end}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if discontinued {
+			if _, err := service.Discontinue(&models.Viewer{StaffID: doctor.ID, Role: models.RoleDoctor}, medicine.ID, "Stopped"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	date := func(value string) *string { return &value }
+	prescribe("Overdue refill", date("2026-03-08"), nil, nil, false)
+	prescribe("Refill and renewal", date("2026-03-12"), date("2026-03-20"), nil, false)
+	prescribe("Far off", date("2026-05-01"), nil, nil, false)
+	prescribe("Course ended", date("2026-03-11"), nil, date("2026-03-09"), false)
+	prescribe("Discontinued", date("2026-03-11"), nil, nil, true)
+
+	other := &models.Staff{ID: uuid.NewString(), Name: "Another doctor", Role: models.RoleDoctor, JoinDate: now}
+	if err := repo.Staff.Create(other); err != nil {
+		t.Fatal(err)
+	}
+
+	type reminder struct {
+		medicine, due string
+		daysLeft      int
+	}
+	tests := []struct {
+		name    string
+		caller  models.Viewer
+		days    int
+		want    []reminder
+		wantErr error
+	}{
+		{
+			name:   "within a week",
+			caller: models.Viewer{StaffID: doctor.ID, Role: models.RoleDoctor},
+			days:   7,
+			want:   []reminder{{"Overdue refill", "refill", -2}, {"Refill and renewal", "refill", 2}},
+		},
+		{
+			name:   "within a fortnight",
+			caller: models.Viewer{StaffID: doctor.ID, Role: models.RoleDoctor},
+			days:   14,
+			want:   []reminder{{"Overdue refill", "refill", -2}, {"Refill and renewal", "refill", 2}, {"Refill and renewal", "renewal", 10}},
+		},
+		{
+			name:   "only overdue",
+			caller: models.Viewer{StaffID: other.ID, Role: models.RoleAdmin},
+			want:   []reminder{{"Overdue refill", "refill", -2}},
+		},
+		{name: "another doctor's", caller: models.Viewer{StaffID: other.ID, Role: models.RoleDoctor}, days: 7, wantErr: ErrRemindersNotAllowed},
+		{name: "too far ahead", caller: models.Viewer{StaffID: doctor.ID, Role: models.RoleDoctor}, days: 91, wantErr: errors.New("days must be between 0 and 90")},
+	}
+	for _, tt := range tests {
+		reminders, err := service.Reminders(&tt.caller, doctor.ID, tt.days, now)
+		if tt.wantErr != nil {
+			if err == nil || err.Error() != tt.wantErr.Error() {
+				t.Errorf("%s: Reminders error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: Reminders error = %v", tt.name, err)
+			continue
+		}
+		var got []reminder
+		for _, r := range reminders {
+			got = append(got, reminder{r.Medicine.Name, r.Due, r.DaysLeft})
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: reminders = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,744 @@
+package service
+
+// backend/internal/service/onboarding_service.go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrAssessmentNotFound = errors.New("assessment not found")
+	ErrQuestionNotFound   = errors.New("question not found")
+	ErrResponseNotFound   = errors.New("onboarding response not found")
+
+	ErrAdministrationNotFound  = errors.New("assessment administration not found")
+	ErrAdministrationCompleted = errors.New("assessment administration is completed")
+)
+
+// ResponseAnswer is the answer given to an onboarding question. Score questions take a
+// score, yes/no questions an answer of yes or no, and text questions a free text answer.
+type ResponseAnswer struct {
+	Score  *float64
+	Answer *string
+	Notes  *string
+}
+
+// ScoreSummary totals the latest answers given during an administration of an assessment
+type ScoreSummary struct {
+	PatientID        string         `json:"patient_id"`
+	AssessmentID     int            `json:"assessment_id"`
+	Assessment       string         `json:"assessment"`
+	AdministrationID int            `json:"administration_id"`
+	AdministeredAt   time.Time      `json:"administered_at"`
+	Score            float64        `json:"score"`
+	MaxScore         float64        `json:"max_score"`
+	Answered         int            `json:"answered"`
+	Questions        int            `json:"questions"`
+	Groups           []*GroupTotal  `json:"groups"`
+	Milestones       []*DomainLevel `json:"milestones,omitempty"`
+}
+
+// GroupTotal is the score of one group of questions. Free text questions aren't scored,
+// but count towards Answered and Questions.
+type GroupTotal struct {
+	Number    int     `json:"number"`
+	Name      *string `json:"name"`
+	Score     float64 `json:"score"`
+	MaxScore  float64 `json:"max_score"`
+	Answered  int     `json:"answered"`
+	Questions int     `json:"questions"`
+}
+
+// DomainLevel is the milestone level reached in a domain, 0 when the first level isn't passed
+type DomainLevel struct {
+	Domain   string  `json:"domain"`
+	Level    int     `json:"level"`
+	Label    *string `json:"label"`
+	Score    float64 `json:"score"`
+	MaxScore float64 `json:"max_score"`
+}
+
+// ScoreComparison sets two administrations of an assessment side by side. Changes are
+// current minus baseline, so a positive change is progress.
+type ScoreComparison struct {
+	PatientID    string              `json:"patient_id"`
+	AssessmentID int                 `json:"assessment_id"`
+	Assessment   string              `json:"assessment"`
+	Baseline     AdministrationTotal `json:"baseline"`
+	Current      AdministrationTotal `json:"current"`
+	Change       float64             `json:"change"`
+	Groups       []*GroupChange      `json:"groups"`
+	Questions    []*QuestionDelta    `json:"questions"`
+	Milestones   []*DomainChange     `json:"milestones,omitempty"`
+}
+
+type AdministrationTotal struct {
+	AdministrationID int       `json:"administration_id"`
+	AdministeredAt   time.Time `json:"administered_at"`
+	Score            float64   `json:"score"`
+	MaxScore         float64   `json:"max_score"`
+	Answered         int       `json:"answered"`
+}
+
+type GroupChange struct {
+	Number   int     `json:"number"`
+	Name     *string `json:"name"`
+	Baseline float64 `json:"baseline"`
+	Current  float64 `json:"current"`
+	Change   float64 `json:"change"`
+	MaxScore float64 `json:"max_score"`
+}
+
+// QuestionDelta compares the answers to one question. Scores are nil when the question
+// wasn't answered, or is answered in free text.
+type QuestionDelta struct {
+	QuestionID     int      `json:"question_id"`
+	Code           string   `json:"code"`
+	Text           string   `json:"text"`
+	Group          int      `json:"group"`
+	Baseline       *float64 `json:"baseline"`
+	Current        *float64 `json:"current"`
+	Change         *float64 `json:"change"`
+	BaselineAnswer *string  `json:"baseline_answer"`
+	CurrentAnswer  *string  `json:"current_answer"`
+}
+
+type DomainChange struct {
+	Domain   string `json:"domain"`
+	Baseline int    `json:"baseline"`
+	Current  int    `json:"current"`
+}
+
+type OnboardingServiceInterface interface {
+	ListAssessments() ([]*models.Assessment, error)
+	Questions(assessmentID int) ([]*models.OnboardingQuestion, error)
+	ListResponses(patientID string, assessmentID int) ([]*models.OnboardingResponse, error)
+	Record(response *models.OnboardingResponse, answer ResponseAnswer) (*models.OnboardingResponse, error)
+	Answer(id int, staffID string, answer ResponseAnswer) (*models.OnboardingResponse, error)
+	Scores(patientID string, assessmentID int) (*ScoreSummary, error)
+
+	StartAdministration(administration *models.AssessmentAdministration) (*models.AssessmentAdministration, error)
+	ListAdministrations(patientID string, assessmentID int) ([]*models.AssessmentAdministration, error)
+	GetAdministration(id int) (*models.AssessmentAdministration, error)
+	UpdateAdministration(id int, updates map[string]interface{}) (*models.AssessmentAdministration, error)
+	AdministrationResponses(id int) ([]*models.OnboardingResponse, error)
+	AdministrationScores(id int) (*ScoreSummary, error)
+	Compare(id, baselineID int) (*ScoreComparison, error)
+}
+
+type OnboardingService struct {
+	repo *repository.Repository
+}
+
+func NewOnboardingService(repo *repository.Repository) OnboardingServiceInterface {
+	return &OnboardingService{repo: repo}
+}
+
+// List every assessment
+func (s *OnboardingService) ListAssessments() ([]*models.Assessment, error) {
+	return s.repo.Assessment.GetAll()
+}
+
+// List the active questions of an assessment in order
+func (s *OnboardingService) Questions(assessmentID int) ([]*models.OnboardingQuestion, error) {
+	if _, err := s.assessment(assessmentID); err != nil {
+		return nil, err
+	}
+	return s.repo.OnboardingQuestion.FindByAssessmentID(assessmentID)
+}
+
+// List a patient's responses, to a single assessment unless assessmentID is 0
+func (s *OnboardingService) ListResponses(patientID string, assessmentID int) ([]*models.OnboardingResponse, error) {
+	if err := s.checkPatient(patientID); err != nil {
+		return nil, err
+	}
+	if assessmentID == 0 {
+		return s.repo.OnboardingResponse.FindByPatientID(patientID)
+	}
+	if _, err := s.assessment(assessmentID); err != nil {
+		return nil, err
+	}
+	return s.repo.OnboardingResponse.FindByPatientAndAssessment(patientID, assessmentID)
+}
+
+// Record a patient's answer to a question of an administration in progress
+func (s *OnboardingService) Record(response *models.OnboardingResponse, answer ResponseAnswer) (*models.OnboardingResponse, error) {
+	if err := s.checkPatient(response.PatientID); err != nil {
+		return nil, err
+	}
+	if response.AdministrationID == nil {
+		return nil, errors.New("administration ID is required")
+	}
+	administration, err := s.GetAdministration(*response.AdministrationID)
+	if err != nil {
+		return nil, err
+	}
+	if administration.PatientID != response.PatientID {
+		return nil, errors.New("administration does not belong to the specified patient")
+	}
+	if administration.Status == models.AdministrationCompleted {
+		return nil, ErrAdministrationCompleted
+	}
+
+	question, err := s.repo.OnboardingQuestion.FindByID(response.QuestionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrQuestionNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if question.AssessmentID != administration.AssessmentID {
+		return nil, errors.New("question is not part of the assessment being administered")
+	}
+	if question.Retired {
+		return nil, errors.New("question has been retired")
+	}
+
+	assessment, err := s.assessment(question.AssessmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyAnswer(response, question, assessment, answer); err != nil {
+		return nil, err
+	}
+
+	response.ID = 0
+	response.ResponseDate = time.Now()
+	if err := s.repo.OnboardingResponse.Create(response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Answer fills in or corrects a response, such as a placeholder created when the
+// administration started. The staff member answering becomes the response's author.
+func (s *OnboardingService) Answer(id int, staffID string, answer ResponseAnswer) (*models.OnboardingResponse, error) {
+	response, err := s.response(id)
+	if err != nil {
+		return nil, err
+	}
+	if response.AdministrationID != nil {
+		administration, err := s.GetAdministration(*response.AdministrationID)
+		if err != nil {
+			return nil, err
+		}
+		if administration.Status == models.AdministrationCompleted {
+			return nil, ErrAdministrationCompleted
+		}
+	}
+
+	question, err := s.repo.OnboardingQuestion.FindByID(response.QuestionID)
+	if err != nil {
+		return nil, err
+	}
+	assessment, err := s.assessment(question.AssessmentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyAnswer(response, question, assessment, answer); err != nil {
+		return nil, err
+	}
+
+	err = s.repo.OnboardingResponse.Update(id, map[string]interface{}{
+		"score":         response.Score,
+		"answer":        response.Answer,
+		"notes":         response.Notes,
+		"staff_id":      staffID,
+		"response_date": time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.response(id)
+}
+
+// Scores the patient's most recent administration of an assessment
+func (s *OnboardingService) Scores(patientID string, assessmentID int) (*ScoreSummary, error) {
+	administrations, err := s.ListAdministrations(patientID, assessmentID)
+	if err != nil {
+		return nil, err
+	}
+	if len(administrations) == 0 {
+		return nil, ErrAdministrationNotFound
+	}
+	return s.AdministrationScores(administrations[0].ID)
+}
+
+// StartAdministration begins a sitting of an assessment, with an empty response to each of its questions
+func (s *OnboardingService) StartAdministration(administration *models.AssessmentAdministration) (*models.AssessmentAdministration, error) {
+	if err := s.checkPatient(administration.PatientID); err != nil {
+		return nil, err
+	}
+	if _, err := s.assessment(administration.AssessmentID); err != nil {
+		return nil, err
+	}
+
+	administration.ID = 0
+	administration.Status = models.AdministrationInProgress
+	if administration.AdministeredAt.IsZero() {
+		administration.AdministeredAt = time.Now()
+	}
+	if err := s.repo.AssessmentAdministration.Create(administration); err != nil {
+		return nil, err
+	}
+	if err := s.repo.OnboardingResponse.CreateInitialOnboardingResponses(administration); err != nil {
+		return nil, err
+	}
+	return administration, nil
+}
+
+// List a patient's administrations, of a single assessment unless assessmentID is 0, newest first
+func (s *OnboardingService) ListAdministrations(patientID string, assessmentID int) ([]*models.AssessmentAdministration, error) {
+	if err := s.checkPatient(patientID); err != nil {
+		return nil, err
+	}
+	if assessmentID != 0 {
+		if _, err := s.assessment(assessmentID); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.AssessmentAdministration.FindByPatientID(patientID, assessmentID)
+}
+
+// Get an administration. Administrations of patients outside the caller's caseload aren't found.
+func (s *OnboardingService) GetAdministration(id int) (*models.AssessmentAdministration, error) {
+	administration, err := s.repo.AssessmentAdministration.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAdministrationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPatient(administration.PatientID); err != nil {
+		if err.Error() == "patient not found" {
+			return nil, ErrAdministrationNotFound
+		}
+		return nil, err
+	}
+	return administration, nil
+}
+
+// UpdateAdministration changes the date, notes or status of an administration.
+// Completing it locks its responses, and setting it back in progress reopens them.
+func (s *OnboardingService) UpdateAdministration(id int, updates map[string]interface{}) (*models.AssessmentAdministration, error) {
+	if _, err := s.GetAdministration(id); err != nil {
+		return nil, err
+	}
+
+	allowed := map[string]interface{}{}
+	if value, ok := updates["notes"]; ok {
+		allowed["notes"] = value
+	}
+	if value, ok := updates["administered_at"]; ok {
+		administeredAt, err := time.Parse(time.RFC3339, fmt.Sprint(value))
+		if err != nil {
+			return nil, errors.New("administered_at must be a date-time like 2025-01-31T10:00:00Z")
+		}
+		allowed["administered_at"] = administeredAt
+	}
+	if value, ok := updates["status"]; ok {
+		switch status := models.AdministrationStatus(fmt.Sprint(value)); status {
+		case models.AdministrationInProgress, models.AdministrationCompleted:
+			allowed["status"] = status
+		default:
+			return nil, errors.New("status must be in_progress or completed")
+		}
+	}
+
+	if len(allowed) > 0 {
+		if err := s.repo.AssessmentAdministration.Update(id, allowed); err != nil {
+			return nil, err
+		}
+	}
+	return s.GetAdministration(id)
+}
+
+// List the responses recorded during an administration
+func (s *OnboardingService) AdministrationResponses(id int) ([]*models.OnboardingResponse, error) {
+	if _, err := s.GetAdministration(id); err != nil {
+		return nil, err
+	}
+	return s.repo.OnboardingResponse.FindByAdministrationID(id)
+}
+
+// AdministrationScores totals the latest answer to every active question of an
+// administration, by group and overall, and places the patient at a milestone level
+// in each domain when the assessment defines milestones
+func (s *OnboardingService) AdministrationScores(id int) (*ScoreSummary, error) {
+	administration, err := s.GetAdministration(id)
+	if err != nil {
+		return nil, err
+	}
+	assessment, err := s.assessment(administration.AssessmentID)
+	if err != nil {
+		return nil, err
+	}
+	questions, err := s.repo.OnboardingQuestion.FindByAssessmentID(assessment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, _, err := s.score(administration, assessment, questions)
+	return summary, err
+}
+
+// Compare an administration with an earlier baseline administration of the same assessment
+func (s *OnboardingService) Compare(id, baselineID int) (*ScoreComparison, error) {
+	current, err := s.GetAdministration(id)
+	if err != nil {
+		return nil, err
+	}
+	baseline, err := s.GetAdministration(baselineID)
+	if err != nil {
+		return nil, err
+	}
+	if current.PatientID != baseline.PatientID || current.AssessmentID != baseline.AssessmentID {
+		return nil, errors.New("administrations must be of the same assessment and patient")
+	}
+
+	assessment, err := s.assessment(current.AssessmentID)
+	if err != nil {
+		return nil, err
+	}
+	questions, err := s.repo.OnboardingQuestion.FindByAssessmentID(assessment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	before, beforeAnswers, err := s.score(baseline, assessment, questions)
+	if err != nil {
+		return nil, err
+	}
+	after, afterAnswers, err := s.score(current, assessment, questions)
+	if err != nil {
+		return nil, err
+	}
+
+	comparison := &ScoreComparison{
+		PatientID:    current.PatientID,
+		AssessmentID: assessment.ID,
+		Assessment:   assessment.Name,
+		Baseline:     totalOf(before),
+		Current:      totalOf(after),
+		Change:       after.Score - before.Score,
+	}
+
+	// Both summaries cover the same questions, so their groups and domains line up
+	for i, group := range after.Groups {
+		comparison.Groups = append(comparison.Groups, &GroupChange{
+			Number:   group.Number,
+			Name:     group.Name,
+			Baseline: before.Groups[i].Score,
+			Current:  group.Score,
+			Change:   group.Score - before.Groups[i].Score,
+			MaxScore: group.MaxScore,
+		})
+	}
+	for i, domain := range after.Milestones {
+		comparison.Milestones = append(comparison.Milestones, &DomainChange{
+			Domain:   domain.Domain,
+			Baseline: before.Milestones[i].Level,
+			Current:  domain.Level,
+		})
+	}
+
+	for _, question := range questions {
+		delta := &QuestionDelta{
+			QuestionID: question.ID,
+			Code:       question.Code,
+			Text:       question.Text,
+			Group:      question.Group,
+		}
+		if response, ok := beforeAnswers[question.ID]; ok {
+			delta.Baseline, delta.BaselineAnswer = response.Score, response.Answer
+		}
+		if response, ok := afterAnswers[question.ID]; ok {
+			delta.Current, delta.CurrentAnswer = response.Score, response.Answer
+		}
+		if delta.Baseline != nil && delta.Current != nil {
+			change := *delta.Current - *delta.Baseline
+			delta.Change = &change
+		}
+		comparison.Questions = append(comparison.Questions, delta)
+	}
+	return comparison, nil
+}
+
+// score totals an administration's answers to questions and returns the answers it counted
+func (s *OnboardingService) score(administration *models.AssessmentAdministration, assessment *models.Assessment, questions []*models.OnboardingQuestion) (*ScoreSummary, map[int]*models.OnboardingResponse, error) {
+	responses, err := s.repo.OnboardingResponse.FindByAdministrationID(administration.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	answers := latestAnswers(responses)
+
+	summary := &ScoreSummary{
+		PatientID:        administration.PatientID,
+		AssessmentID:     assessment.ID,
+		Assessment:       assessment.Name,
+		AdministrationID: administration.ID,
+		AdministeredAt:   administration.AdministeredAt,
+	}
+	if err := scoreQuestions(summary, assessment, questions, answers); err != nil {
+		return nil, nil, err
+	}
+	return summary, answers, nil
+}
+
+func totalOf(summary *ScoreSummary) AdministrationTotal {
+	return AdministrationTotal{
+		AdministrationID: summary.AdministrationID,
+		AdministeredAt:   summary.AdministeredAt,
+		Score:            summary.Score,
+		MaxScore:         summary.MaxScore,
+		Answered:         summary.Answered,
+	}
+}
+
+// scoreQuestions adds up the answers to questions into summary
+func scoreQuestions(summary *ScoreSummary, assessment *models.Assessment, questions []*models.OnboardingQuestion, answers map[int]*models.OnboardingResponse) error {
+	scoring, err := decodeScoring(assessment)
+	if err != nil {
+		return err
+	}
+
+	groups := map[int]*GroupTotal{}
+	levels := map[string]map[int]*levelTotal{}
+	for _, question := range questions {
+		group, ok := groups[question.Group]
+		if !ok {
+			group = &GroupTotal{Number: question.Group, Name: question.GroupName}
+			groups[question.Group] = group
+			summary.Groups = append(summary.Groups, group)
+		}
+		group.Questions++
+		summary.Questions++
+
+		response, answered := answers[question.ID]
+		if answered {
+			group.Answered++
+			summary.Answered++
+		}
+		if question.AnswerType == models.AnswerText {
+			continue
+		}
+
+		full := maxScore(question, scoring)
+		score := 0.0
+		if answered && response.Score != nil {
+			score = *response.Score
+		}
+		group.Score += score
+		group.MaxScore += full
+		summary.Score += score
+		summary.MaxScore += full
+
+		if question.Domain != nil && question.MilestoneLevel != nil {
+			if levels[*question.Domain] == nil {
+				levels[*question.Domain] = map[int]*levelTotal{}
+			}
+			total := levels[*question.Domain][*question.MilestoneLevel]
+			if total == nil {
+				total = &levelTotal{}
+				levels[*question.Domain][*question.MilestoneLevel] = total
+			}
+			total.score += score
+			total.max += full
+		}
+	}
+
+	if scoring.Milestones != nil {
+		summary.Milestones = milestoneLevels(scoring.Milestones, levels)
+	}
+	return nil
+}
+
+// levelTotal adds up the scores of a domain's questions at one milestone level
+type levelTotal struct {
+	score, max float64
+}
+
+// milestoneLevels places the patient at the highest level passed in each domain, counting up
+// from the first level. Levels without questions in a domain are skipped.
+func milestoneLevels(milestones *models.MilestoneScoring, levels map[string]map[int]*levelTotal) []*DomainLevel {
+	ordered := append([]models.MilestoneLevel(nil), milestones.Levels...)
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].Level < ordered[j].Level
+	})
+
+	domains := make([]string, 0, len(levels))
+	for domain := range levels {
+		domains = append(domains, domain)
+	}
+	sort.Strings(domains)
+
+	results := make([]*DomainLevel, 0, len(domains))
+	for _, domain := range domains {
+		result := &DomainLevel{Domain: domain}
+		passing := true
+		for _, level := range ordered {
+			total, ok := levels[domain][level.Level]
+			if !ok {
+				continue
+			}
+			result.Score += total.score
+			result.MaxScore += total.max
+			if passing && total.max > 0 && total.score >= milestones.Mastery*total.max {
+				label := level.Label
+				result.Level, result.Label = level.Level, &label
+				continue
+			}
+			passing = false
+		}
+		results = append(results, result)
+	}
+	return results
+}
+
+// latestAnswers picks the most recent answered response to each question. Responses are ordered oldest first.
+func latestAnswers(responses []*models.OnboardingResponse) map[int]*models.OnboardingResponse {
+	latest := map[int]*models.OnboardingResponse{}
+	for _, response := range responses {
+		if response.Score == nil && response.Answer == nil {
+			continue
+		}
+		latest[response.QuestionID] = response
+	}
+	return latest
+}
+
+// applyAnswer checks an answer against the question's type and the assessment's scale and sets it on the response
+func applyAnswer(response *models.OnboardingResponse, question *models.OnboardingQuestion, assessment *models.Assessment, answer ResponseAnswer) error {
+	scoring, err := decodeScoring(assessment)
+	if err != nil {
+		return err
+	}
+	full := maxScore(question, scoring)
+
+	response.Notes = answer.Notes
+	switch question.AnswerType {
+	case models.AnswerYesNo:
+		if answer.Answer == nil {
+			return errors.New("answer must be yes or no")
+		}
+		value := strings.ToLower(strings.TrimSpace(*answer.Answer))
+		score := 0.0
+		switch value {
+		case "yes":
+			score = full
+		case "no":
+		default:
+			return errors.New("answer must be yes or no")
+		}
+		response.Answer, response.Score = &value, &score
+
+	case models.AnswerText:
+		if answer.Answer == nil || strings.TrimSpace(*answer.Answer) == "" {
+			return errors.New("answer is required")
+		}
+		response.Answer, response.Score = answer.Answer, nil
+
+	default:
+		if answer.Score == nil {
+			return errors.New("score is required")
+		}
+		if err := checkScore(*answer.Score, full, question.MaxScore == nil, scoring.Scale); err != nil {
+			return err
+		}
+		response.Score, response.Answer = answer.Score, nil
+	}
+	return nil
+}
+
+// checkScore accepts scores between 0 and max. Questions scored on the assessment's scale only accept its values.
+func checkScore(score, full float64, onScale bool, scale []models.ScoreLevel) error {
+	if onScale && len(scale) > 0 {
+		values := make([]string, 0, len(scale))
+		for _, level := range scale {
+			if level.Value == score {
+				return nil
+			}
+			values = append(values, fmt.Sprint(level.Value))
+		}
+		return fmt.Errorf("score must be one of %s", strings.Join(values, ", "))
+	}
+	if score < 0 || score > full {
+		return fmt.Errorf("score must be between 0 and %v", full)
+	}
+	return nil
+}
+
+// maxScore is the question's own maximum, or else the top of the assessment's scale, or 1
+func maxScore(question *models.OnboardingQuestion, scoring models.AssessmentScoring) float64 {
+	if question.MaxScore != nil {
+		return *question.MaxScore
+	}
+	top := 0.0
+	for _, level := range scoring.Scale {
+		top = math.Max(top, level.Value)
+	}
+	if top == 0 {
+		return 1
+	}
+	return top
+}
+
+func decodeScoring(assessment *models.Assessment) (models.AssessmentScoring, error) {
+	var scoring models.AssessmentScoring
+	if assessment.Scoring == nil {
+		return scoring, nil
+	}
+	if err := json.Unmarshal([]byte(*assessment.Scoring), &scoring); err != nil {
+		return scoring, fmt.Errorf("invalid scoring for %s: %w", assessment.Name, err)
+	}
+	return scoring, nil
+}
+
+func (s *OnboardingService) assessment(id int) (*models.Assessment, error) {
+	assessment, err := s.repo.Assessment.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrAssessmentNotFound
+	}
+	return assessment, err
+}
+
+// response finds a response whose patient is in the caller's caseload
+func (s *OnboardingService) response(id int) (*models.OnboardingResponse, error) {
+	response, err := s.repo.OnboardingResponse.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrResponseNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPatient(response.PatientID); err != nil {
+		if err.Error() == "patient not found" {
+			return nil, ErrResponseNotFound
+		}
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *OnboardingService) checkPatient(patientID string) error {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("patient not found")
+		}
+		return err
+	}
+	return nil
+}
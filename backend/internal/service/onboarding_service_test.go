@@ -0,0 +1,297 @@
+package service
+
+// backend/internal/service/onboarding_service_test.go
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/catalog"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// importTestAssessment imports a small milestone assessment scored 0 to 2, and returns it
+// with its questions by code
+func importTestAssessment(t *testing.T, db *gorm.DB) (*models.Assessment, map[string]*models.OnboardingQuestion) {
+	t.Helper()
+	one, two := 1, 2
+	test := &catalog.Catalog{
+		Name:    "Milestones",
+		Version: 1,
+		File:    "milestones.yaml",
+		Scoring: models.AssessmentScoring{
+			Scale: []models.ScoreLevel{{Value: 0, Label: "No"}, {Value: 1, Label: "Partly"}, {Value: 2, Label: "Yes"}},
+			Milestones: &models.MilestoneScoring{
+				Mastery: 0.5,
+				Levels:  []models.MilestoneLevel{{Level: 1, Label: "Level 1"}, {Level: 2, Label: "Level 2"}},
+			},
+		},
+		Groups: []catalog.Group{
+			{Number: 1, Name: "Mand", Domain: "Mand", Questions: []catalog.Question{
+				{Code: "M1", Text: "Requests items", Type: models.AnswerScore, Domain: "Mand", Level: &one},
+				{Code: "M2", Text: "Requests actions", Type: models.AnswerScore, Domain: "Mand", Level: &two},
+			}},
+			{Number: 2, Name: "Listener", Questions: []catalog.Question{
+				{Code: "L1", Text: "Follows instructions", Type: models.AnswerYesNo, Domain: "Listener", Level: &one},
+				{Code: "T1", Text: "Observations", Type: models.AnswerText},
+			}},
+		},
+	}
+
+	if _, err := catalog.NewImporter(db).Import([]*catalog.Catalog{test}, false); err != nil {
+		t.Fatal(err)
+	}
+	repo := repository.NewRepository(db)
+	assessment, err := repo.Assessment.FindByName(test.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	questions, err := repo.OnboardingQuestion.FindByAssessmentID(assessment.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byCode := map[string]*models.OnboardingQuestion{}
+	for _, question := range questions {
+		byCode[question.Code] = question
+	}
+	return assessment, byCode
+}
+
+// startTestAdministration starts a sitting of the assessment for the patient
+func startTestAdministration(t *testing.T, service OnboardingServiceInterface, patient *models.Patient, staff *models.Staff, assessment *models.Assessment) *models.AssessmentAdministration {
+	t.Helper()
+	administration, err := service.StartAdministration(&models.AssessmentAdministration{
+		PatientID:      patient.ID,
+		AssessmentID:   assessment.ID,
+		AdministeredBy: staff.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return administration
+}
+
+func TestOnboardingScores(t *testing.T) {
+	score := func(value float64) ResponseAnswer { return ResponseAnswer{Score: &value} }
+	answer := func(value string) ResponseAnswer { return ResponseAnswer{Answer: &value} }
+	label := func(value string) *string { return &value }
+
+	// answered pairs a question code with the answer given to it, in the order they're given
+	type answered struct {
+		code   string
+		answer ResponseAnswer
+	}
+	tests := []struct {
+		name       string
+		answers    []answered
+		groups     []GroupTotal
+		milestones []DomainLevel
+	}{
+		{
+			name: "nothing answered",
+			groups: []GroupTotal{
+				{Number: 1, Name: label("Mand"), Score: 0, MaxScore: 4, Answered: 0, Questions: 2},
+				{Number: 2, Name: label("Listener"), Score: 0, MaxScore: 2, Answered: 0, Questions: 2},
+			},
+			milestones: []DomainLevel{
+				{Domain: "Listener", Level: 0, Score: 0, MaxScore: 2},
+				{Domain: "Mand", Level: 0, Score: 0, MaxScore: 4},
+			},
+		},
+		{
+			name:    "first level passed",
+			answers: []answered{{"M1", score(2)}, {"M2", score(0)}, {"L1", answer("Yes")}, {"T1", answer("Points at the window")}},
+			groups: []GroupTotal{
+				{Number: 1, Name: label("Mand"), Score: 2, MaxScore: 4, Answered: 2, Questions: 2},
+				{Number: 2, Name: label("Listener"), Score: 2, MaxScore: 2, Answered: 2, Questions: 2},
+			},
+			milestones: []DomainLevel{
+				{Domain: "Listener", Level: 1, Label: label("Level 1"), Score: 2, MaxScore: 2},
+				{Domain: "Mand", Level: 1, Label: label("Level 1"), Score: 2, MaxScore: 4},
+			},
+		},
+		{
+			name:    "level after a gap isn't reached",
+			answers: []answered{{"M1", score(0)}, {"M2", score(2)}, {"L1", answer("no")}},
+			groups: []GroupTotal{
+				{Number: 1, Name: label("Mand"), Score: 2, MaxScore: 4, Answered: 2, Questions: 2},
+				{Number: 2, Name: label("Listener"), Score: 0, MaxScore: 2, Answered: 1, Questions: 2},
+			},
+			milestones: []DomainLevel{
+				{Domain: "Listener", Level: 0, Score: 0, MaxScore: 2},
+				{Domain: "Mand", Level: 0, Score: 2, MaxScore: 4},
+			},
+		},
+		{
+			name:    "latest answer counts",
+			answers: []answered{{"M1", score(0)}, {"M2", score(1)}, {"M1", score(1)}},
+			groups: []GroupTotal{
+				{Number: 1, Name: label("Mand"), Score: 2, MaxScore: 4, Answered: 2, Questions: 2},
+				{Number: 2, Name: label("Listener"), Score: 0, MaxScore: 2, Answered: 0, Questions: 2},
+			},
+			milestones: []DomainLevel{
+				{Domain: "Listener", Level: 0, Score: 0, MaxScore: 2},
+				{Domain: "Mand", Level: 2, Label: label("Level 2"), Score: 2, MaxScore: 4},
+			},
+		},
+	}
+
+	db := newTestDB(t)
+	repo := repository.NewRepository(db)
+	assessment, questions := importTestAssessment(t, db)
+	service := NewOnboardingService(repo)
+	for _, tt := range tests {
+		patient, staff := createTestPatient(t, repo)
+		administration := startTestAdministration(t, service, patient, staff, assessment)
+		for _, a := range tt.answers {
+			response := &models.OnboardingResponse{QuestionID: questions[a.code].ID, PatientID: patient.ID, StaffID: staff.ID, AdministrationID: &administration.ID}
+			if _, err := service.Record(response, a.answer); err != nil {
+				t.Fatalf("%s: recording %s: %v", tt.name, a.code, err)
+			}
+		}
+
+		summary, err := service.Scores(patient.ID, assessment.ID)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var groups []GroupTotal
+		for _, group := range summary.Groups {
+			groups = append(groups, *group)
+		}
+		if !reflect.DeepEqual(groups, tt.groups) {
+			t.Errorf("%s: groups = %+v, want %+v", tt.name, groups, tt.groups)
+		}
+		var milestones []DomainLevel
+		for _, milestone := range summary.Milestones {
+			milestones = append(milestones, *milestone)
+		}
+		if !reflect.DeepEqual(milestones, tt.milestones) {
+			t.Errorf("%s: milestones = %+v, want %+v", tt.name, milestones, tt.milestones)
+		}
+	}
+}
+
+func TestOnboardingRecordChecksAnswers(t *testing.T) {
+	score := func(value float64) *float64 { return &value }
+	text := func(value string) *string { return &value }
+
+	tests := []struct {
+		code      string
+		answer    ResponseAnswer
+		wantScore *float64
+		wantErr   string
+	}{
+		{"M1", ResponseAnswer{Score: score(1)}, score(1), ""},
+		{"M1", ResponseAnswer{Score: score(1.5)}, nil, "score must be one of 0, 1, 2"},
+		{"M1", ResponseAnswer{}, nil, "score is required"},
+		{"L1", ResponseAnswer{Answer: text(" YES ")}, score(2), ""},
+		{"L1", ResponseAnswer{Answer: text("no")}, score(0), ""},
+		{"L1", ResponseAnswer{Answer: text("maybe")}, nil, "answer must be yes or no"},
+		{"T1", ResponseAnswer{Answer: text("Points at the window")}, nil, ""},
+		{"T1", ResponseAnswer{Answer: text("  ")}, nil, "answer is required"},
+	}
+
+	db := newTestDB(t)
+	repo := repository.NewRepository(db)
+	assessment, questions := importTestAssessment(t, db)
+	patient, staff := createTestPatient(t, repo)
+	service := NewOnboardingService(repo)
+	administration := startTestAdministration(t, service, patient, staff, assessment)
+	for _, tt := range tests {
+		response := &models.OnboardingResponse{QuestionID: questions[tt.code].ID, PatientID: patient.ID, StaffID: staff.ID, AdministrationID: &administration.ID}
+		recorded, err := service.Record(response, tt.answer)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("%s %+v: error = %v, want %q", tt.code, tt.answer, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %+v: %v", tt.code, tt.answer, err)
+			continue
+		}
+		if !reflect.DeepEqual(recorded.Score, tt.wantScore) {
+			t.Errorf("%s %+v: score = %v, want %v", tt.code, tt.answer, recorded.Score, tt.wantScore)
+		}
+	}
+}
+
+func TestOnboardingCompare(t *testing.T) {
+	score := func(value float64) ResponseAnswer { return ResponseAnswer{Score: &value} }
+	answer := func(value string) ResponseAnswer { return ResponseAnswer{Answer: &value} }
+	change := func(value float64) *float64 { return &value }
+
+	db := newTestDB(t)
+	repo := repository.NewRepository(db)
+	assessment, questions := importTestAssessment(t, db)
+	patient, staff := createTestPatient(t, repo)
+	service := NewOnboardingService(repo)
+
+	record := func(administration *models.AssessmentAdministration, answers map[string]ResponseAnswer) {
+		t.Helper()
+		for code, a := range answers {
+			response := &models.OnboardingResponse{QuestionID: questions[code].ID, PatientID: patient.ID, StaffID: staff.ID, AdministrationID: &administration.ID}
+			if _, err := service.Record(response, a); err != nil {
+				t.Fatalf("recording %s: %v", code, err)
+			}
+		}
+	}
+
+	baseline := startTestAdministration(t, service, patient, staff, assessment)
+	record(baseline, map[string]ResponseAnswer{"M1": score(1), "M2": score(0), "L1": answer("no")})
+	if _, err := service.UpdateAdministration(baseline.ID, map[string]interface{}{"status": "completed"}); err != nil {
+		t.Fatal(err)
+	}
+	current := startTestAdministration(t, service, patient, staff, assessment)
+	record(current, map[string]ResponseAnswer{"M1": score(2), "M2": score(1), "T1": answer("Points at the window")})
+
+	// A completed administration takes no more answers
+	late := &models.OnboardingResponse{QuestionID: questions["M2"].ID, PatientID: patient.ID, StaffID: staff.ID, AdministrationID: &baseline.ID}
+	if _, err := service.Record(late, score(2)); !errors.Is(err, ErrAdministrationCompleted) {
+		t.Errorf("recording into a completed administration error = %v, want %v", err, ErrAdministrationCompleted)
+	}
+
+	comparison, err := service.Compare(current.ID, baseline.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if comparison.Baseline.Score != 1 || comparison.Current.Score != 3 || comparison.Change != 2 {
+		t.Errorf("total %v to %v changed %v, want 1 to 3 changed 2", comparison.Baseline.Score, comparison.Current.Score, comparison.Change)
+	}
+
+	groups := []GroupChange{
+		{Number: 1, Baseline: 1, Current: 3, Change: 2, MaxScore: 4},
+		{Number: 2, Baseline: 0, Current: 0, Change: 0, MaxScore: 2},
+	}
+	for i, want := range groups {
+		got := comparison.Groups[i]
+		if got.Number != want.Number || got.Baseline != want.Baseline || got.Current != want.Current || got.Change != want.Change || got.MaxScore != want.MaxScore {
+			t.Errorf("group %d = %+v, want %+v", want.Number, *got, want)
+		}
+	}
+
+	deltas := map[string]*float64{"M1": change(1), "M2": change(1), "L1": nil, "T1": nil}
+	for _, delta := range comparison.Questions {
+		if want := deltas[delta.Code]; !reflect.DeepEqual(delta.Change, want) {
+			t.Errorf("question %s changed %v, want %v", delta.Code, delta.Change, want)
+		}
+	}
+
+	milestones := []DomainChange{{Domain: "Listener", Baseline: 0, Current: 0}, {Domain: "Mand", Baseline: 1, Current: 2}}
+	var got []DomainChange
+	for _, milestone := range comparison.Milestones {
+		got = append(got, *milestone)
+	}
+	if !reflect.DeepEqual(got, milestones) {
+		t.Errorf("milestones = %+v, want %+v", got, milestones)
+	}
+
+	other, otherStaff := createTestPatient(t, repo)
+	if _, err := service.Compare(startTestAdministration(t, service, other, otherStaff, assessment).ID, baseline.ID); err == nil {
+		t.Error("comparing administrations of different patients succeeded, want an error")
+	}
+}
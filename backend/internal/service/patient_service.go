@@ -0,0 +1,112 @@
+package service
+
+// backend/internal/service/patient_service.go
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+type PatientServiceInterface interface {
+	List(limit, offset int) ([]*models.Patient, int64, error)
+	Create(patient *models.Patient) (*models.Patient, error)
+	GetByID(id string) (*models.Patient, error)
+	Update(id string, updates map[string]interface{}) (*models.Patient, error)
+	Delete(id string) error
+	GetSessions(patientID string, limit, offset int) ([]*models.Session, int64, error)
+}
+
+type PatientService struct {
+	repo *repository.Repository
+}
+
+func NewPatientService(repo *repository.Repository) PatientServiceInterface {
+	return &PatientService{repo: repo}
+}
+
+// List patients by name
+func (s *PatientService) List(limit, offset int) ([]*models.Patient, int64, error) {
+	return s.repo.Patient.List(limit, offset)
+}
+
+// Create registers a patient, who's active and joins today unless they say otherwise
+func (s *PatientService) Create(patient *models.Patient) (*models.Patient, error) {
+	patient.Name = strings.TrimSpace(patient.Name)
+	if patient.Name == "" {
+		return nil, errors.New("patient name is required")
+	}
+	if patient.JoinDate.IsZero() {
+		patient.JoinDate = time.Now()
+	}
+	if patient.Active == nil {
+		active := true
+		patient.Active = &active
+	}
+
+	patient.ID = uuid.NewString()
+	if err := s.repo.Patient.Create(patient); err != nil {
+		return nil, err
+	}
+	return patient, nil
+}
+
+// Get a patient by ID. Patients outside the caller's caseload aren't found.
+func (s *PatientService) GetByID(id string) (*models.Patient, error) {
+	patient, err := s.repo.Patient.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("patient not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patient, nil
+}
+
+// Update a patient
+func (s *PatientService) Update(id string, updates map[string]interface{}) (*models.Patient, error) {
+	if _, err := s.GetByID(id); err != nil {
+		return nil, err
+	}
+	delete(updates, "id")
+	if name, ok := updates["name"]; ok {
+		if name, _ := name.(string); strings.TrimSpace(name) == "" {
+			return nil, errors.New("patient name is required")
+		}
+	}
+
+	if err := s.repo.Patient.Update(id, updates); err != nil {
+		return nil, err
+	}
+	return s.GetByID(id)
+}
+
+// Delete a patient
+func (s *PatientService) Delete(id string) error {
+	if _, err := s.GetByID(id); err != nil {
+		return err
+	}
+	return s.repo.Patient.Delete(id)
+}
+
+// GetSessions lists a patient's sessions, newest first
+func (s *PatientService) GetSessions(patientID string, limit, offset int) ([]*models.Session, int64, error) {
+	if _, err := s.GetByID(patientID); err != nil {
+		return nil, 0, err
+	}
+	sessions, err := s.repo.Session.FindByPatientID(patientID)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.After(sessions[j].StartTime)
+	})
+	return page(sessions, limit, offset), int64(len(sessions)), nil
+}
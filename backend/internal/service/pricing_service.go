@@ -0,0 +1,379 @@
+package service
+
+// backend/internal/service/pricing_service.go
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrRateCardNotFound     = errors.New("rate card not found")
+	ErrRateCardOverlap      = errors.New("rate card overlaps another for the same therapy type, branch and date")
+	ErrRateCardInvoiced     = errors.New("rate card has been used on invoices")
+	ErrDiscountRuleNotFound = errors.New("discount rule not found")
+)
+
+// therapyTypes are the values Patient.TherapyTypes can take
+var therapyTypes = []string{"TRM", "Group Therapy"}
+
+// Quote is the price of a session for a patient, in paise
+type Quote struct {
+	TherapyType    string `json:"therapy_type"`
+	BranchID       *int   `json:"branch_id"`
+	Minutes        int    `json:"minutes"`
+	RateCardID     int    `json:"rate_card_id"`
+	Price          int64  `json:"price"`
+	DiscountRuleID *int   `json:"discount_rule_id"`
+	DiscountName   string `json:"discount_name,omitempty"`
+	Discount       int64  `json:"discount"`
+	Amount         int64  `json:"amount"`
+}
+
+type PricingServiceInterface interface {
+	RateCards(therapyType string) ([]*models.RateCard, error)
+	CreateRateCard(card *models.RateCard) (*models.RateCard, error)
+	DeleteRateCard(id int) error
+	DiscountRules() ([]*models.DiscountRule, error)
+	CreateDiscountRule(rule *models.DiscountRule) (*models.DiscountRule, error)
+	UpdateDiscountRule(id int, rule *models.DiscountRule) (*models.DiscountRule, error)
+	SetPatientDiscount(patientID string, ruleID int) (*models.PatientDiscount, error)
+	RemovePatientDiscount(patientID string) error
+	Estimate(patientID string, branchID *int, minutes int, date string) (*Quote, error)
+}
+
+type PricingService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewPricingService(repo *repository.Repository, scheduling config.Scheduling) PricingServiceInterface {
+	return &PricingService{repo: repo, location: scheduling.Location()}
+}
+
+// RateCards lists the rate cards, or only a therapy type's when it isn't empty
+func (s *PricingService) RateCards(therapyType string) ([]*models.RateCard, error) {
+	return s.repo.RateCard.FindAll(therapyType)
+}
+
+// CreateRateCard adds a rate card. Prices change by adding a card for the same band with a
+// later effective date, so past sessions keep the price they had.
+func (s *PricingService) CreateRateCard(card *models.RateCard) (*models.RateCard, error) {
+	if !slices.Contains(therapyTypes, card.TherapyType) {
+		return nil, errors.New("therapy type must be TRM or Group Therapy")
+	}
+	if card.BranchID != nil {
+		if _, err := s.repo.Branch.GetBranchByID(*card.BranchID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, ErrBranchNotFound
+			}
+			return nil, err
+		}
+	}
+	if card.MinMinutes < 0 || card.MaxMinutes < card.MinMinutes {
+		return nil, errors.New("max minutes must not be less than min minutes")
+	}
+	if card.Price < 0 {
+		return nil, errors.New("price can't be negative")
+	}
+	if _, err := time.Parse(dateLayout, card.EffectiveFrom); err != nil {
+		return nil, errors.New("effective from must look like 2026-01-31")
+	}
+
+	cards, err := s.repo.RateCard.FindAll(card.TherapyType)
+	if err != nil {
+		return nil, err
+	}
+	for _, other := range cards {
+		if sameBranch(other.BranchID, card.BranchID) && other.EffectiveFrom == card.EffectiveFrom &&
+			other.MinMinutes <= card.MaxMinutes && card.MinMinutes <= other.MaxMinutes {
+			return nil, ErrRateCardOverlap
+		}
+	}
+
+	card.ID = 0
+	if err := s.repo.RateCard.Create(card); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// DeleteRateCard removes a rate card that hasn't priced any invoice yet
+func (s *PricingService) DeleteRateCard(id int) error {
+	if _, err := s.repo.RateCard.FindByID(id); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrRateCardNotFound
+		}
+		return err
+	}
+	invoiced, err := s.repo.RateCard.IsInvoiced(id)
+	if err != nil {
+		return err
+	}
+	if invoiced {
+		return ErrRateCardInvoiced
+	}
+	return s.repo.RateCard.Delete(id)
+}
+
+// DiscountRules lists every discount rule
+func (s *PricingService) DiscountRules() ([]*models.DiscountRule, error) {
+	return s.repo.Discount.FindRules("")
+}
+
+// CreateDiscountRule adds a discount rule
+func (s *PricingService) CreateDiscountRule(rule *models.DiscountRule) (*models.DiscountRule, error) {
+	if rule.Kind != models.DiscountSibling && rule.Kind != models.DiscountHardship {
+		return nil, errors.New("discount kind must be sibling or hardship")
+	}
+	if err := checkDiscountRule(rule); err != nil {
+		return nil, err
+	}
+
+	rule.ID = 0
+	if err := s.repo.Discount.CreateRule(rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// UpdateDiscountRule changes a rule's name, percentage and whether it's active. Invoices
+// already issued keep the discount they were given.
+func (s *PricingService) UpdateDiscountRule(id int, rule *models.DiscountRule) (*models.DiscountRule, error) {
+	if _, err := s.findDiscountRule(id); err != nil {
+		return nil, err
+	}
+	if err := checkDiscountRule(rule); err != nil {
+		return nil, err
+	}
+
+	err := s.repo.Discount.UpdateRule(id, map[string]interface{}{
+		"name":    rule.Name,
+		"percent": rule.Percent,
+		"active":  rule.Active,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.findDiscountRule(id)
+}
+
+// SetPatientDiscount gives a patient a hardship discount, replacing any they had
+func (s *PricingService) SetPatientDiscount(patientID string, ruleID int) (*models.PatientDiscount, error) {
+	if _, err := s.findPatient(patientID); err != nil {
+		return nil, err
+	}
+	rule, err := s.findDiscountRule(ruleID)
+	if err != nil {
+		return nil, err
+	}
+	if rule.Kind != models.DiscountHardship {
+		return nil, errors.New("only hardship discounts are given to patients; sibling discounts apply by themselves")
+	}
+	if !rule.Active {
+		return nil, errors.New("discount rule is inactive")
+	}
+
+	discount := &models.PatientDiscount{PatientID: patientID, DiscountRuleID: ruleID}
+	if err := s.repo.Discount.SetForPatient(discount); err != nil {
+		return nil, err
+	}
+	return s.repo.Discount.FindByPatientID(patientID)
+}
+
+// RemovePatientDiscount takes a patient's hardship discount away
+func (s *PricingService) RemovePatientDiscount(patientID string) error {
+	if _, err := s.findPatient(patientID); err != nil {
+		return err
+	}
+	return s.repo.Discount.DeleteForPatient(patientID)
+}
+
+// Estimate prices a session for a patient. The branch defaults to the patient's primary
+// branch and the date to today.
+func (s *PricingService) Estimate(patientID string, branchID *int, minutes int, date string) (*Quote, error) {
+	patient, err := s.findPatient(patientID)
+	if err != nil {
+		return nil, err
+	}
+	if minutes <= 0 {
+		return nil, errors.New("minutes must be more than zero")
+	}
+	if date == "" {
+		date = time.Now().In(s.location).Format(dateLayout)
+	} else if _, err := time.Parse(dateLayout, date); err != nil {
+		return nil, errors.New("date must look like 2026-01-31")
+	}
+	if branchID == nil {
+		branchID = patient.PrimaryBranchID
+	}
+
+	pricer, err := s.pricerFor(patient)
+	if err != nil {
+		return nil, err
+	}
+	return pricer.quote(branchID, minutes, date)
+}
+
+// pricer prices one patient's sessions
+type pricer struct {
+	therapyType string
+	cards       []*models.RateCard
+	discount    *models.DiscountRule
+}
+
+// pricerFor loads the rate cards for a patient's therapy type and the discount they get
+func (s *PricingService) pricerFor(patient *models.Patient) (*pricer, error) {
+	if patient.TherapyTypes == nil || *patient.TherapyTypes == "" {
+		return nil, errors.New("patient has no therapy type to price sessions by")
+	}
+	cards, err := s.repo.RateCard.FindAll(*patient.TherapyTypes)
+	if err != nil {
+		return nil, err
+	}
+	discount, err := s.discountFor(patient)
+	if err != nil {
+		return nil, err
+	}
+	return &pricer{therapyType: *patient.TherapyTypes, cards: cards, discount: discount}, nil
+}
+
+// discountFor finds the largest active discount a patient qualifies for, if any
+func (s *PricingService) discountFor(patient *models.Patient) (*models.DiscountRule, error) {
+	var best *models.DiscountRule
+	consider := func(rule *models.DiscountRule) {
+		if rule.Active && (best == nil || rule.Percent > best.Percent) {
+			best = rule
+		}
+	}
+
+	given, err := s.repo.Discount.FindByPatientID(patient.ID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if given != nil {
+		consider(&given.DiscountRule)
+	}
+
+	siblingRules, err := s.repo.Discount.FindRules(models.DiscountSibling)
+	if err != nil {
+		return nil, err
+	}
+	if len(siblingRules) == 0 {
+		return best, nil
+	}
+	hasSibling, err := s.hasActiveSibling(patient.ID)
+	if err != nil {
+		return nil, err
+	}
+	if hasSibling {
+		for _, rule := range siblingRules {
+			consider(rule)
+		}
+	}
+	return best, nil
+}
+
+// hasActiveSibling reports whether any of a patient's guardians has another active patient
+func (s *PricingService) hasActiveSibling(patientID string) (bool, error) {
+	guardians, err := s.repo.Guardian.FindByPatient(patientID)
+	if err != nil {
+		return false, err
+	}
+	for _, guardian := range *guardians {
+		children, err := s.repo.Guardian.FindChildren(guardian.ID)
+		if err != nil {
+			return false, err
+		}
+		for _, child := range children {
+			if child.ID != patientID && (child.Active == nil || *child.Active) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// quote prices a session of the given length at a branch on a date. A card for the branch
+// beats one for every branch, and among those the latest effective date wins.
+func (p *pricer) quote(branchID *int, minutes int, date string) (*Quote, error) {
+	var card *models.RateCard
+	for _, candidate := range p.cards {
+		if candidate.MinMinutes > minutes || candidate.MaxMinutes < minutes || candidate.EffectiveFrom > date {
+			continue
+		}
+		if candidate.BranchID != nil && !sameBranch(candidate.BranchID, branchID) {
+			continue
+		}
+		switch {
+		case card == nil:
+			card = candidate
+		case (candidate.BranchID != nil) != (card.BranchID != nil):
+			if candidate.BranchID != nil {
+				card = candidate
+			}
+		case candidate.EffectiveFrom > card.EffectiveFrom:
+			card = candidate
+		}
+	}
+	if card == nil {
+		return nil, fmt.Errorf("no rate card prices a %d minute %s session at this branch on %s", minutes, p.therapyType, date)
+	}
+
+	quote := &Quote{
+		TherapyType: p.therapyType,
+		BranchID:    branchID,
+		Minutes:     minutes,
+		RateCardID:  card.ID,
+		Price:       card.Price,
+	}
+	if p.discount != nil {
+		quote.DiscountRuleID = &p.discount.ID
+		quote.DiscountName = p.discount.Name
+		quote.Discount = int64(math.Round(float64(card.Price) * float64(p.discount.Percent) / 100))
+	}
+	quote.Amount = quote.Price - quote.Discount
+	return quote, nil
+}
+
+func (s *PricingService) findPatient(patientID string) (*models.Patient, error) {
+	patient, err := s.repo.Patient.FindByID(patientID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("patient not found")
+	}
+	return patient, err
+}
+
+func (s *PricingService) findDiscountRule(id int) (*models.DiscountRule, error) {
+	rule, err := s.repo.Discount.FindRuleByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrDiscountRuleNotFound
+	}
+	return rule, err
+}
+
+func checkDiscountRule(rule *models.DiscountRule) error {
+	if rule.Name == "" {
+		return errors.New("discount name is required")
+	}
+	if rule.Percent <= 0 || rule.Percent > 100 {
+		return errors.New("discount percent must be from 1 to 100")
+	}
+	return nil
+}
+
+func sameBranch(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
@@ -0,0 +1,183 @@
+package service
+
+// backend/internal/service/pricing_service_test.go
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+func TestQuote(t *testing.T) {
+	branch, otherBranch := 1, 2
+	cards := []*models.RateCard{
+		{ID: 1, MinMinutes: 0, MaxMinutes: 60, Price: 100000, EffectiveFrom: "2026-01-01"},
+		{ID: 2, MinMinutes: 0, MaxMinutes: 60, Price: 120000, EffectiveFrom: "2026-04-01"},
+		{ID: 3, MinMinutes: 0, MaxMinutes: 60, Price: 90000, EffectiveFrom: "2026-01-01", BranchID: &branch},
+		{ID: 4, MinMinutes: 61, MaxMinutes: 120, Price: 180000, EffectiveFrom: "2026-01-01"},
+	}
+	hardship := &models.DiscountRule{ID: 7, Name: "Hardship", Kind: models.DiscountHardship, Percent: 15, Active: true}
+
+	tests := []struct {
+		name     string
+		branchID *int
+		minutes  int
+		date     string
+		discount *models.DiscountRule
+		card     int
+		amount   int64
+		wantErr  bool
+	}{
+		{name: "card for every branch", minutes: 45, date: "2026-03-31", card: 1, amount: 100000},
+		{name: "later price once effective", minutes: 45, date: "2026-04-01", card: 2, amount: 120000},
+		{name: "branch card beats a later general one", branchID: &branch, minutes: 60, date: "2026-05-01", card: 3, amount: 90000},
+		{name: "other branch uses the general card", branchID: &otherBranch, minutes: 60, date: "2026-05-01", card: 2, amount: 120000},
+		{name: "longer band", minutes: 90, date: "2026-05-01", card: 4, amount: 180000},
+		{name: "discounted", minutes: 45, date: "2026-03-31", discount: hardship, card: 1, amount: 85000},
+		{name: "longer than every band", minutes: 150, date: "2026-05-01", wantErr: true},
+		{name: "before any card", minutes: 45, date: "2025-12-31", wantErr: true},
+	}
+	for _, tt := range tests {
+		p := &pricer{therapyType: "TRM", cards: cards, discount: tt.discount}
+		quote, err := p.quote(tt.branchID, tt.minutes, tt.date)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: quote error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if quote.RateCardID != tt.card || quote.Amount != tt.amount || quote.Price-quote.Discount != quote.Amount {
+			t.Errorf("%s: quote = card %d, %d - %d = %d, want card %d for %d", tt.name,
+				quote.RateCardID, quote.Price, quote.Discount, quote.Amount, tt.card, tt.amount)
+		}
+		if (tt.discount != nil) != (quote.DiscountRuleID != nil) {
+			t.Errorf("%s: discount rule = %v, want %v", tt.name, quote.DiscountRuleID, tt.discount)
+		}
+	}
+}
+
+func TestPricingDiscounts(t *testing.T) {
+	tests := []struct {
+		name           string
+		sibling        bool // The patient's guardian has another patient
+		siblingActive  bool
+		hardship       int // Percent given to the patient, or 0 for none
+		hardshipActive bool
+		siblingRuleOff bool
+		wantPercent    int
+		wantName       string
+	}{
+		{name: "no discount"},
+		{name: "active sibling", sibling: true, siblingActive: true, wantPercent: 10, wantName: "Sibling"},
+		{name: "inactive sibling", sibling: true},
+		{name: "sibling rule switched off", sibling: true, siblingActive: true, siblingRuleOff: true},
+		{name: "hardship", hardship: 25, hardshipActive: true, wantPercent: 25, wantName: "Hardship"},
+		{name: "larger of sibling and hardship", sibling: true, siblingActive: true, hardship: 5, hardshipActive: true, wantPercent: 10, wantName: "Sibling"},
+		{name: "hardship rule switched off later", hardship: 25},
+	}
+	for _, tt := range tests {
+		repo := newTestRepository(t)
+		service := NewPricingService(repo, config.Scheduling{Timezone: "UTC"})
+		patient := createPricedPatient(t, repo)
+		if _, err := service.CreateRateCard(&models.RateCard{TherapyType: "TRM", MinMinutes: 0, MaxMinutes: 60, Price: 100000, EffectiveFrom: "2026-01-01"}); err != nil {
+			t.Fatal(err)
+		}
+
+		children := []*models.Patient{patient}
+		if tt.sibling {
+			sibling := createPricedPatient(t, repo)
+			if !tt.siblingActive {
+				if err := repo.Patient.Update(sibling.ID, map[string]interface{}{"active": false}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			children = append(children, sibling)
+		}
+		if err := repo.Guardian.Create(&models.Guardian{ID: uuid.NewString(), Name: "Guardian", Patients: children}); err != nil {
+			t.Fatal(err)
+		}
+
+		sibling, err := service.CreateDiscountRule(&models.DiscountRule{Name: "Sibling", Kind: models.DiscountSibling, Percent: 10, Active: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tt.siblingRuleOff {
+			if _, err := service.UpdateDiscountRule(sibling.ID, &models.DiscountRule{Name: "Sibling", Percent: 10, Active: false}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if tt.hardship > 0 {
+			rule, err := service.CreateDiscountRule(&models.DiscountRule{Name: "Hardship", Kind: models.DiscountHardship, Percent: tt.hardship, Active: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := service.SetPatientDiscount(patient.ID, rule.ID); err != nil {
+				t.Fatal(err)
+			}
+			if !tt.hardshipActive {
+				if _, err := service.UpdateDiscountRule(rule.ID, &models.DiscountRule{Name: "Hardship", Percent: tt.hardship, Active: false}); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		quote, err := service.Estimate(patient.ID, nil, 45, "2026-03-02")
+		if err != nil {
+			t.Fatalf("%s: Estimate error = %v", tt.name, err)
+		}
+		if want := int64(100000 * tt.wantPercent / 100); quote.Discount != want || quote.DiscountName != tt.wantName {
+			t.Errorf("%s: discount = %s %d, want %s %d", tt.name, quote.DiscountName, quote.Discount, tt.wantName, want)
+		}
+	}
+}
+
+func TestCreateRateCard(t *testing.T) {
+	repo := newTestRepository(t)
+	service := NewPricingService(repo, config.Scheduling{Timezone: "UTC"})
+	if _, err := service.CreateRateCard(&models.RateCard{TherapyType: "TRM", MinMinutes: 0, MaxMinutes: 60, Price: 100000, EffectiveFrom: "2026-01-01"}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		card    models.RateCard
+		wantErr error
+	}{
+		{"later price for the same band", models.RateCard{TherapyType: "TRM", MinMinutes: 0, MaxMinutes: 60, Price: 110000, EffectiveFrom: "2026-04-01"}, nil},
+		{"next band", models.RateCard{TherapyType: "TRM", MinMinutes: 61, MaxMinutes: 120, Price: 180000, EffectiveFrom: "2026-01-01"}, nil},
+		{"other therapy type", models.RateCard{TherapyType: "Group Therapy", MinMinutes: 0, MaxMinutes: 60, Price: 60000, EffectiveFrom: "2026-01-01"}, nil},
+		{"overlapping band", models.RateCard{TherapyType: "TRM", MinMinutes: 30, MaxMinutes: 90, Price: 150000, EffectiveFrom: "2026-01-01"}, ErrRateCardOverlap},
+		{"unknown therapy type", models.RateCard{TherapyType: "Music", MinMinutes: 0, MaxMinutes: 60, Price: 100000, EffectiveFrom: "2026-01-01"}, errors.New("therapy type must be TRM or Group Therapy")},
+		{"band ending before it starts", models.RateCard{TherapyType: "TRM", MinMinutes: 200, MaxMinutes: 180, Price: 100000, EffectiveFrom: "2026-01-01"}, errors.New("max minutes must not be less than min minutes")},
+	}
+	for _, tt := range tests {
+		_, err := service.CreateRateCard(&tt.card)
+		if tt.wantErr == nil {
+			if err != nil {
+				t.Errorf("%s: CreateRateCard error = %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.wantErr.Error() {
+			t.Errorf("%s: CreateRateCard error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+// createPricedPatient adds a TRM patient
+func createPricedPatient(t *testing.T, repo *repository.Repository) *models.Patient {
+	t.Helper()
+	patient, _ := createTestPatient(t, repo)
+	therapyType := "TRM"
+	if err := repo.Patient.Update(patient.ID, map[string]interface{}{"therapy_types": therapyType}); err != nil {
+		t.Fatal(err)
+	}
+	patient.TherapyTypes = &therapyType
+	return patient
+}
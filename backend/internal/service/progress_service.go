@@ -0,0 +1,262 @@
+package service
+
+// backend/internal/service/progress_service.go
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// defaultProgressBuckets is how many weeks or months progress covers when no start date is given
+const defaultProgressBuckets = 12
+
+// maxProgressBuckets keeps a progress request from covering years of weeks at once
+const maxProgressBuckets = 104
+
+// responseScores places each response level on a scale of 1 to 3 so buckets can be compared
+var responseScores = map[models.ResponseLevel]float64{
+	"low":    1,
+	"medium": 2,
+	"high":   3,
+}
+
+// PatientProgress is how a patient responded in sessions and activities over time. Only
+// sessions that have started count, each in the week or month it starts in.
+type PatientProgress struct {
+	PatientID   string           `json:"patient_id"`
+	TherapyType *string          `json:"therapy_type"`
+	Period      TimesheetPeriod  `json:"period"`
+	StartDate   string           `json:"start_date"`
+	EndDate     string           `json:"end_date"` // Included
+	Buckets     []ProgressBucket `json:"buckets"`
+}
+
+// ProgressBucket is one week or month of progress. Every week or month in the range has a
+// bucket, even when the patient had no sessions in it.
+type ProgressBucket struct {
+	StartDate  string                       `json:"start_date"`
+	EndDate    string                       `json:"end_date"` // Included
+	Sessions   int                          `json:"sessions"`
+	Responses  map[models.ResponseLevel]int `json:"responses"`
+	Score      *float64                     `json:"score"` // Average session response, low 1 to high 3
+	Activities []*ActivityProgress          `json:"activities"`
+}
+
+// ActivityProgress is how the patient responded to one kind of activity within a bucket.
+// Activities are grouped by description, ignoring case and surrounding spaces.
+type ActivityProgress struct {
+	Description string                       `json:"description"`
+	Count       int                          `json:"count"`
+	Responses   map[models.ResponseLevel]int `json:"responses"`
+	Score       *float64                     `json:"score"` // Average activity response, low 1 to high 3
+}
+
+type ProgressServiceInterface interface {
+	Progress(patientID string, period TimesheetPeriod, from, to string, now time.Time) (*PatientProgress, error)
+}
+
+type ProgressService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewProgressService(repo *repository.Repository, scheduling config.Scheduling) ProgressServiceInterface {
+	return &ProgressService{repo: repo, location: scheduling.Location()}
+}
+
+// Progress buckets a patient's session and activity responses by week or month from from
+// until to. to defaults to today and from to twelve weeks or months before it.
+func (s *ProgressService) Progress(patientID string, period TimesheetPeriod, from, to string, now time.Time) (*PatientProgress, error) {
+	if period != PeriodWeek && period != PeriodMonth {
+		return nil, errors.New("period must be week or month")
+	}
+	patient, err := s.repo.Patient.FindByID(patientID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("patient not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	last := now.In(s.location)
+	if to != "" {
+		if last, err = time.ParseInLocation(dateLayout, to, s.location); err != nil {
+			return nil, errors.New("to must look like 2026-01-31")
+		}
+	}
+	end := nextPeriod(period, periodStart(period, last))
+	start := addPeriods(period, end, -defaultProgressBuckets)
+	if from != "" {
+		first, err := time.ParseInLocation(dateLayout, from, s.location)
+		if err != nil {
+			return nil, errors.New("from must look like 2026-01-31")
+		}
+		if first.After(last) {
+			return nil, errors.New("from must not be after to")
+		}
+		start = periodStart(period, first)
+	}
+	if addPeriods(period, start, maxProgressBuckets).Before(end) {
+		return nil, errors.New("progress can cover at most 104 weeks or months")
+	}
+
+	until := end
+	if now.Before(until) {
+		until = now
+	}
+	sessions, err := s.repo.Session.FindByPatientBetween(patientID, start.UTC(), until.UTC())
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		ids = append(ids, session.ID)
+	}
+	activities, err := s.repo.Activity.FindBySessionIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &PatientProgress{
+		PatientID:   patientID,
+		TherapyType: patient.TherapyTypes,
+		Period:      period,
+		StartDate:   start.Format(dateLayout),
+		EndDate:     end.AddDate(0, 0, -1).Format(dateLayout),
+		Buckets:     []ProgressBucket{},
+	}
+	var tallies []*progressTally
+	for bucketStart := start; bucketStart.Before(end); bucketStart = nextPeriod(period, bucketStart) {
+		tallies = append(tallies, newProgressTally(bucketStart, nextPeriod(period, bucketStart)))
+	}
+
+	// Sessions come earliest first, so each falls in the bucket of the one before it or a later one
+	bySession := map[string]*progressTally{}
+	bucket := 0
+	for _, session := range sessions {
+		for session.StartTime.In(s.location).Compare(tallies[bucket].end) >= 0 {
+			bucket++
+		}
+		tally := tallies[bucket]
+		tally.sessions++
+		tally.responses.add(session.Response)
+		bySession[session.ID] = tally
+	}
+	for _, activity := range activities {
+		if activity.SessionID == nil || activity.Description == nil || strings.TrimSpace(*activity.Description) == "" {
+			continue
+		}
+		tally, ok := bySession[*activity.SessionID]
+		if !ok {
+			continue
+		}
+		tally.activity(*activity.Description).add(activity.ResponseLevel)
+	}
+
+	for _, tally := range tallies {
+		progress.Buckets = append(progress.Buckets, tally.bucket())
+	}
+	return progress, nil
+}
+
+// progressTally collects the responses of one bucket
+type progressTally struct {
+	start, end time.Time
+	sessions   int
+	responses  responseTally
+	activities map[string]*activityTally
+}
+
+func newProgressTally(start, end time.Time) *progressTally {
+	return &progressTally{
+		start:      start,
+		end:        end,
+		responses:  responseTally{counts: map[models.ResponseLevel]int{}},
+		activities: map[string]*activityTally{},
+	}
+}
+
+// activity finds the tally for activities with a description, labelled as first seen
+func (t *progressTally) activity(description string) *activityTally {
+	key := strings.ToLower(strings.TrimSpace(description))
+	tally, ok := t.activities[key]
+	if !ok {
+		tally = &activityTally{
+			description: strings.TrimSpace(description),
+			responses:   responseTally{counts: map[models.ResponseLevel]int{}},
+		}
+		t.activities[key] = tally
+	}
+	return tally
+}
+
+func (t *progressTally) bucket() ProgressBucket {
+	bucket := ProgressBucket{
+		StartDate:  t.start.Format(dateLayout),
+		EndDate:    t.end.AddDate(0, 0, -1).Format(dateLayout),
+		Sessions:   t.sessions,
+		Responses:  t.responses.counts,
+		Score:      t.responses.score(),
+		Activities: []*ActivityProgress{},
+	}
+	for _, tally := range t.activities {
+		bucket.Activities = append(bucket.Activities, &ActivityProgress{
+			Description: tally.description,
+			Count:       tally.count,
+			Responses:   tally.responses.counts,
+			Score:       tally.responses.score(),
+		})
+	}
+	sort.Slice(bucket.Activities, func(i, j int) bool {
+		return strings.ToLower(bucket.Activities[i].Description) < strings.ToLower(bucket.Activities[j].Description)
+	})
+	return bucket
+}
+
+type activityTally struct {
+	description string
+	count       int
+	responses   responseTally
+}
+
+func (t *activityTally) add(level *models.ResponseLevel) {
+	t.count++
+	if level != nil {
+		t.responses.add(*level)
+	}
+}
+
+// responseTally counts response levels and sums their scores. Levels without a score are
+// counted but left out of the average.
+type responseTally struct {
+	counts map[models.ResponseLevel]int
+	total  float64
+	scored int
+}
+
+func (t *responseTally) add(level models.ResponseLevel) {
+	if level == "" {
+		return
+	}
+	t.counts[level]++
+	if score, ok := responseScores[level]; ok {
+		t.total += score
+		t.scored++
+	}
+}
+
+func (t *responseTally) score() *float64 {
+	if t.scored == 0 {
+		return nil
+	}
+	score := round(t.total / float64(t.scored))
+	return &score
+}
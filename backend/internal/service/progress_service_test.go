@@ -0,0 +1,146 @@
+package service
+
+// backend/internal/service/progress_service_test.go
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestProgress(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	other, _ := createTestPatient(t, repo)
+
+	// activity is a description and response level; an empty level records no response
+	type activity struct {
+		description string
+		level       models.ResponseLevel
+	}
+	book := func(patientID string, start time.Time, response models.ResponseLevel, activities ...activity) {
+		t.Helper()
+		session := &models.Session{ID: uuid.NewString(), PatientID: patientID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(time.Hour), Response: response}
+		if err := repo.Session.Create(session); err != nil {
+			t.Fatal(err)
+		}
+		for _, a := range activities {
+			description := a.description
+			record := &models.Activity{ID: uuid.NewString(), SessionID: &session.ID, Description: &description}
+			if a.level != "" {
+				level := a.level
+				record.ResponseLevel = &level
+			}
+			if err := repo.Activity.Create(record); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 10, 0, 0, 0, time.UTC) }
+	book(patient.ID, day(2), "high", activity{"Matching", "high"}, activity{" matching ", "low"}, activity{"Puzzles", "medium"})
+	book(patient.ID, day(4), "low")
+	book(patient.ID, day(16), "medium", activity{"Puzzles", ""}, activity{"  ", "high"})
+	book(patient.ID, day(30), "high") // After now, so not counted yet
+	book(other.ID, day(3), "low")
+
+	service := NewProgressService(repo, config.Scheduling{Timezone: "UTC"})
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	score := func(value float64) *float64 { return &value }
+
+	// bucket is what a bucket should hold; activities map descriptions to count and score
+	type bucket struct {
+		start      string
+		sessions   int
+		score      *float64
+		activities map[string]ActivityProgress
+	}
+	tests := []struct {
+		name     string
+		period   TimesheetPeriod
+		from, to string
+		start    string
+		buckets  []bucket
+		count    int // Buckets expected, when only the last few are listed
+		wantErr  bool
+	}{
+		{
+			name:   "weekly",
+			period: PeriodWeek,
+			from:   "2026-03-04",
+			to:     "2026-03-20",
+			start:  "2026-03-02",
+			buckets: []bucket{
+				{"2026-03-02", 2, score(2), map[string]ActivityProgress{
+					"Matching": {Count: 2, Score: score(2)},
+					"Puzzles":  {Count: 1, Score: score(2)},
+				}},
+				{"2026-03-09", 0, nil, map[string]ActivityProgress{}},
+				{"2026-03-16", 1, score(2), map[string]ActivityProgress{
+					"Puzzles": {Count: 1},
+				}},
+			},
+			count: 3,
+		},
+		{
+			name:   "monthly by default",
+			period: PeriodMonth,
+			start:  "2025-04-01",
+			buckets: []bucket{
+				{"2026-02-01", 0, nil, map[string]ActivityProgress{}},
+				{"2026-03-01", 3, score(2), map[string]ActivityProgress{
+					"Matching": {Count: 2, Score: score(2)},
+					"Puzzles":  {Count: 2, Score: score(2)},
+				}},
+			},
+			count: 12,
+		},
+		{name: "unknown period", period: "day", wantErr: true},
+		{name: "from after to", period: PeriodWeek, from: "2026-03-20", to: "2026-03-01", wantErr: true},
+		{name: "too many weeks", period: PeriodWeek, from: "2020-01-01", wantErr: true},
+	}
+	for _, tt := range tests {
+		progress, err := service.Progress(patient.ID, tt.period, tt.from, tt.to, now)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Progress error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if progress.StartDate != tt.start || len(progress.Buckets) != tt.count {
+			t.Errorf("%s: %d buckets from %s, want %d from %s", tt.name, len(progress.Buckets), progress.StartDate, tt.count, tt.start)
+			continue
+		}
+
+		buckets := progress.Buckets[len(progress.Buckets)-len(tt.buckets):]
+		for i, want := range tt.buckets {
+			got := buckets[i]
+			if got.StartDate != want.start || got.Sessions != want.sessions || !equalScores(got.Score, want.score) {
+				t.Errorf("%s: bucket %s has %d sessions scoring %v, want %s with %d scoring %v", tt.name,
+					got.StartDate, got.Sessions, got.Score, want.start, want.sessions, want.score)
+			}
+			if len(got.Activities) != len(want.activities) {
+				t.Errorf("%s: bucket %s has %d activities, want %d", tt.name, got.StartDate, len(got.Activities), len(want.activities))
+				continue
+			}
+			for _, activity := range got.Activities {
+				expected, ok := want.activities[activity.Description]
+				if !ok || activity.Count != expected.Count || !equalScores(activity.Score, expected.Score) {
+					t.Errorf("%s: bucket %s has %s %d times scoring %v, want %d scoring %v", tt.name, got.StartDate,
+						activity.Description, activity.Count, activity.Score, expected.Count, expected.Score)
+				}
+			}
+		}
+	}
+}
+
+func equalScores(a, b *float64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -17,17 +17,138 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for AssessmentAdministrationStatus.
+const (
+	AssessmentAdministrationStatusCompleted  AssessmentAdministrationStatus = "completed"
+	AssessmentAdministrationStatusInProgress AssessmentAdministrationStatus = "in_progress"
+)
+
+// Defines values for AssessmentQuestionAnswerType.
+const (
+	Score AssessmentQuestionAnswerType = "score"
+	Text  AssessmentQuestionAnswerType = "text"
+	YesNo AssessmentQuestionAnswerType = "yes_no"
+)
+
+// Defines values for BehaviorIncidentFunctionHypothesis.
+const (
+	Attention BehaviorIncidentFunctionHypothesis = "attention"
+	Automatic BehaviorIncidentFunctionHypothesis = "automatic"
+	Escape    BehaviorIncidentFunctionHypothesis = "escape"
+	Tangible  BehaviorIncidentFunctionHypothesis = "tangible"
+	Unknown   BehaviorIncidentFunctionHypothesis = "unknown"
+)
+
+// Defines values for BehaviorIncidentIntensity.
+const (
+	BehaviorIncidentIntensityMild     BehaviorIncidentIntensity = "mild"
+	BehaviorIncidentIntensityModerate BehaviorIncidentIntensity = "moderate"
+	BehaviorIncidentIntensitySevere   BehaviorIncidentIntensity = "severe"
+)
+
+// Defines values for BehaviorMeasurementMethod.
+const (
+	Duration        BehaviorMeasurementMethod = "duration"
+	Frequency       BehaviorMeasurementMethod = "frequency"
+	PartialInterval BehaviorMeasurementMethod = "partial_interval"
+	WholeInterval   BehaviorMeasurementMethod = "whole_interval"
+)
+
+// Defines values for BranchHoursPolicy.
+const (
+	Enforce BranchHoursPolicy = "enforce"
+	Warn    BranchHoursPolicy = "warn"
+)
+
+// Defines values for DiscountRuleKind.
+const (
+	Hardship DiscountRuleKind = "hardship"
+	Sibling  DiscountRuleKind = "sibling"
+)
+
+// Defines values for InvoiceStatus.
+const (
+	Open InvoiceStatus = "open"
+	Paid InvoiceStatus = "paid"
+	Void InvoiceStatus = "void"
+)
+
+// Defines values for MedicationDoseStatus.
+const (
+	Given   MedicationDoseStatus = "given"
+	Refused MedicationDoseStatus = "refused"
+)
+
+// Defines values for MedicineFrequency.
+const (
+	AsNeeded        MedicineFrequency = "as_needed"
+	FourTimesDaily  MedicineFrequency = "four_times_daily"
+	OnceDaily       MedicineFrequency = "once_daily"
+	ThreeTimesDaily MedicineFrequency = "three_times_daily"
+	TwiceDaily      MedicineFrequency = "twice_daily"
+)
+
+// Defines values for MedicineRoute.
+const (
+	Inhaled    MedicineRoute = "inhaled"
+	Injection  MedicineRoute = "injection"
+	Nasal      MedicineRoute = "nasal"
+	Oral       MedicineRoute = "oral"
+	Rectal     MedicineRoute = "rectal"
+	Sublingual MedicineRoute = "sublingual"
+	Topical    MedicineRoute = "topical"
+)
+
+// Defines values for MedicineReminderDue.
+const (
+	Refill  MedicineReminderDue = "refill"
+	Renewal MedicineReminderDue = "renewal"
+)
+
 // Defines values for PatientTherapyTypes.
 const (
-	GroupTherapy PatientTherapyTypes = "Group Therapy"
-	TRM          PatientTherapyTypes = "TRM"
+	PatientTherapyTypesGroupTherapy PatientTherapyTypes = "Group Therapy"
+	PatientTherapyTypesTRM          PatientTherapyTypes = "TRM"
+)
+
+// Defines values for PaymentMethod.
+const (
+	PaymentMethodCard PaymentMethod = "card"
+	PaymentMethodCash PaymentMethod = "cash"
+	PaymentMethodUpi  PaymentMethod = "upi"
+)
+
+// Defines values for PaymentRequestMethod.
+const (
+	PaymentRequestMethodCard PaymentRequestMethod = "card"
+	PaymentRequestMethodCash PaymentRequestMethod = "cash"
+	PaymentRequestMethodUpi  PaymentRequestMethod = "upi"
+)
+
+// Defines values for PrescriptionEventKind.
+const (
+	PrescriptionEventKindAdjusted     PrescriptionEventKind = "adjusted"
+	PrescriptionEventKindDiscontinued PrescriptionEventKind = "discontinued"
+	PrescriptionEventKindStarted      PrescriptionEventKind = "started"
+)
+
+// Defines values for RateCardTherapyType.
+const (
+	RateCardTherapyTypeGroupTherapy RateCardTherapyType = "Group Therapy"
+	RateCardTherapyTypeTRM          RateCardTherapyType = "TRM"
+)
+
+// Defines values for ResponseProgressPeriod.
+const (
+	ResponseProgressPeriodMonth ResponseProgressPeriod = "month"
+	ResponseProgressPeriodWeek  ResponseProgressPeriod = "week"
 )
 
 // Defines values for SessionResponse.
 const (
-	High     SessionResponse = "High"
-	Low      SessionResponse = "Low"
-	Moderate SessionResponse = "Moderate"
+	SessionResponseHigh     SessionResponse = "High"
+	SessionResponseLow      SessionResponse = "Low"
+	SessionResponseModerate SessionResponse = "Moderate"
 )
 
 // Defines values for StaffRole.
@@ -38,6 +159,112 @@ const (
 	Therapist         StaffRole = "therapist"
 )
 
+// Defines values for StaffTimesheetFlag.
+const (
+	NoTarget StaffTimesheetFlag = "no_target"
+	OnTarget StaffTimesheetFlag = "on_target"
+	Over     StaffTimesheetFlag = "over"
+	Under    StaffTimesheetFlag = "under"
+)
+
+// Defines values for StaffTimesheetPeriod.
+const (
+	StaffTimesheetPeriodMonth StaffTimesheetPeriod = "month"
+	StaffTimesheetPeriodWeek  StaffTimesheetPeriod = "week"
+)
+
+// Defines values for TreatmentGoalStatus.
+const (
+	TreatmentGoalStatusActive       TreatmentGoalStatus = "active"
+	TreatmentGoalStatusDiscontinued TreatmentGoalStatus = "discontinued"
+	TreatmentGoalStatusMet          TreatmentGoalStatus = "met"
+)
+
+// Defines values for TreatmentPlanStatus.
+const (
+	TreatmentPlanStatusActive       TreatmentPlanStatus = "active"
+	TreatmentPlanStatusCompleted    TreatmentPlanStatus = "completed"
+	TreatmentPlanStatusDiscontinued TreatmentPlanStatus = "discontinued"
+	TreatmentPlanStatusDraft        TreatmentPlanStatus = "draft"
+)
+
+// Defines values for TreatmentTargetStatus.
+const (
+	Discontinued TreatmentTargetStatus = "discontinued"
+	InProgress   TreatmentTargetStatus = "in_progress"
+	Mastered     TreatmentTargetStatus = "mastered"
+	OnHold       TreatmentTargetStatus = "on_hold"
+)
+
+// Defines values for TrialOutcome.
+const (
+	Correct   TrialOutcome = "correct"
+	Incorrect TrialOutcome = "incorrect"
+	Prompted  TrialOutcome = "prompted"
+)
+
+// Defines values for TrialPromptLevel.
+const (
+	FullPhysical    TrialPromptLevel = "full_physical"
+	Gestural        TrialPromptLevel = "gestural"
+	Independent     TrialPromptLevel = "independent"
+	PartialPhysical TrialPromptLevel = "partial_physical"
+)
+
+// Defines values for GetGuardianChildrenPatientIdSessionsParamsWhen.
+const (
+	Past     GetGuardianChildrenPatientIdSessionsParamsWhen = "past"
+	Upcoming GetGuardianChildrenPatientIdSessionsParamsWhen = "upcoming"
+)
+
+// Defines values for GetPatientsPatientIdProgressParamsPeriod.
+const (
+	GetPatientsPatientIdProgressParamsPeriodMonth GetPatientsPatientIdProgressParamsPeriod = "month"
+	GetPatientsPatientIdProgressParamsPeriodWeek  GetPatientsPatientIdProgressParamsPeriod = "week"
+)
+
+// Defines values for GetRateCardsParamsTherapyType.
+const (
+	GroupTherapy GetRateCardsParamsTherapyType = "Group Therapy"
+	TRM          GetRateCardsParamsTherapyType = "TRM"
+)
+
+// Defines values for DeleteSessionSeriesIdSessionsSessionIdParamsScope.
+const (
+	DeleteSessionSeriesIdSessionsSessionIdParamsScopeFollowing DeleteSessionSeriesIdSessionsSessionIdParamsScope = "following"
+	DeleteSessionSeriesIdSessionsSessionIdParamsScopeThis      DeleteSessionSeriesIdSessionsSessionIdParamsScope = "this"
+)
+
+// Defines values for PutSessionSeriesIdSessionsSessionIdParamsScope.
+const (
+	PutSessionSeriesIdSessionsSessionIdParamsScopeFollowing PutSessionSeriesIdSessionsSessionIdParamsScope = "following"
+	PutSessionSeriesIdSessionsSessionIdParamsScopeThis      PutSessionSeriesIdSessionsSessionIdParamsScope = "this"
+)
+
+// Defines values for GetStaffIdTimesheetParamsPeriod.
+const (
+	GetStaffIdTimesheetParamsPeriodMonth GetStaffIdTimesheetParamsPeriod = "month"
+	GetStaffIdTimesheetParamsPeriodWeek  GetStaffIdTimesheetParamsPeriod = "week"
+)
+
+// Defines values for GetStaffIdTimesheetParamsFormat.
+const (
+	GetStaffIdTimesheetParamsFormatCsv  GetStaffIdTimesheetParamsFormat = "csv"
+	GetStaffIdTimesheetParamsFormatJson GetStaffIdTimesheetParamsFormat = "json"
+)
+
+// Defines values for GetTimesheetsParamsPeriod.
+const (
+	Month GetTimesheetsParamsPeriod = "month"
+	Week  GetTimesheetsParamsPeriod = "week"
+)
+
+// Defines values for GetTimesheetsParamsFormat.
+const (
+	GetTimesheetsParamsFormatCsv  GetTimesheetsParamsFormat = "csv"
+	GetTimesheetsParamsFormatJson GetTimesheetsParamsFormat = "json"
+)
+
 // Activity defines model for Activity.
 type Activity struct {
 	// Description A summarized description of the activity.
@@ -54,189 +281,1845 @@ type Activity struct {
 
 	// SessionId The associated session for the activity.
 	SessionId *string `json:"session_id,omitempty"`
+
+	// TargetId The treatment plan target the activity practises. It must be a target of the session patient's active plan that hasn't been discontinued.
+	TargetId *int `json:"target_id"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	Error string `json:"error"`
+// ActivityResponses defines model for ActivityResponses.
+type ActivityResponses struct {
+	Count       *int    `json:"count,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Responses Count of activities by response level.
+	Responses *map[string]int `json:"responses,omitempty"`
+
+	// Score Average activity response, from low 1 to high 3.
+	Score *float32 `json:"score"`
 }
 
-// PaginatedResponse defines model for PaginatedResponse.
-type PaginatedResponse struct {
-	Data       *[]map[string]interface{} `json:"data,omitempty"`
-	Pagination *struct {
-		Limit *int `json:"limit,omitempty"`
-		Page  *int `json:"page,omitempty"`
-		Pages *int `json:"pages,omitempty"`
-		Total *int `json:"total,omitempty"`
-	} `json:"pagination,omitempty"`
+// AdministrationComparison Two administrations of an assessment side by side. Changes are current minus baseline.
+type AdministrationComparison struct {
+	Assessment   *string                `json:"assessment,omitempty"`
+	AssessmentId *int                   `json:"assessment_id,omitempty"`
+	Baseline     *AdministrationSummary `json:"baseline,omitempty"`
+	Change       *float32               `json:"change,omitempty"`
+	Current      *AdministrationSummary `json:"current,omitempty"`
+	Groups       *[]GroupScoreChange    `json:"groups,omitempty"`
+	Milestones   *[]MilestoneChange     `json:"milestones,omitempty"`
+	PatientId    *string                `json:"patient_id,omitempty"`
+	Questions    *[]QuestionScoreChange `json:"questions,omitempty"`
 }
 
-// Patient defines model for Patient.
-type Patient struct {
-	// Active Represents the current patient status.
-	Active *bool `json:"active,omitempty"`
+// AdministrationRequest defines model for AdministrationRequest.
+type AdministrationRequest struct {
+	// AdministeredAt Defaults to now.
+	AdministeredAt *time.Time `json:"administered_at,omitempty"`
+	AssessmentId   int        `json:"assessment_id"`
+	Notes          *string    `json:"notes"`
+}
 
-	// CreatedAt Timestamp when the patient record was created.
-	CreatedAt *time.Time `json:"created_at,omitempty"`
+// AdministrationSummary defines model for AdministrationSummary.
+type AdministrationSummary struct {
+	AdministeredAt   *time.Time `json:"administered_at,omitempty"`
+	AdministrationId *int       `json:"administration_id,omitempty"`
+	Answered         *int       `json:"answered,omitempty"`
+	MaxScore         *float32   `json:"max_score,omitempty"`
+	Score            *float32   `json:"score,omitempty"`
+}
 
-	// Dob Patient Date of Birth
-	Dob openapi_types.Date `json:"dob"`
+// AffectedSessions A closure and the sessions it flagged for rescheduling.
+type AffectedSessions struct {
+	// Closure Whole days a branch is closed, such as a public holiday or an unplanned closure. Dates are in the clinic's timezone and both ends are included.
+	Closure  *BranchClosure `json:"closure,omitempty"`
+	Sessions *[]Session     `json:"sessions,omitempty"`
+}
 
-	// DoctorId The primary physician for the patient.
-	DoctorId *string `json:"doctor_id,omitempty"`
+// AgingBalances Outstanding balances in paise by how many days past their due date they are.
+type AgingBalances struct {
+	// Current Not due yet.
+	Current  *int64 `json:"current,omitempty"`
+	Days130  *int64 `json:"days_1_30,omitempty"`
+	Days3160 *int64 `json:"days_31_60,omitempty"`
+	Days6190 *int64 `json:"days_61_90,omitempty"`
+	Over90   *int64 `json:"over_90,omitempty"`
+	Total    *int64 `json:"total,omitempty"`
+}
 
-	// GuardianId ID of the patient's guardian.
-	GuardianId *string `json:"guardian_id,omitempty"`
+// Assessment defines model for Assessment.
+type Assessment struct {
+	Description *string `json:"description"`
+	Id          *int    `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
 
-	// Id A unique identifier for the patient.
-	Id *string `json:"id,omitempty"`
+	// Scoring JSON scoring metadata from the catalog, with the score scale and milestone levels.
+	Scoring *string `json:"scoring"`
 
-	// Name Full name of the patient.
-	Name string `json:"name"`
+	// Version Version of the catalog file last imported.
+	Version *int `json:"version,omitempty"`
+}
 
-	// PrescribedMedicines List of prescribed medicines
-	PrescribedMedicines *[]string `json:"prescribed_medicines,omitempty"`
+// AssessmentAdministration defines model for AssessmentAdministration.
+type AssessmentAdministration struct {
+	AdministeredAt *time.Time `json:"administered_at,omitempty"`
 
-	// StaffId The unique identifier of the assigned staff member.
-	StaffId      string               `json:"staff_id"`
-	TherapyTypes *PatientTherapyTypes `json:"therapy_types,omitempty"`
+	// AdministeredBy The staff member who started the administration.
+	AdministeredBy *string `json:"administered_by,omitempty"`
+	AssessmentId   *int    `json:"assessment_id,omitempty"`
+	Id             *int    `json:"id,omitempty"`
+	Notes          *string `json:"notes"`
+	PatientId      *string `json:"patient_id,omitempty"`
 
-	// UpdatedAt Timestamp when the patient record was last updated.
-	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Status Responses can't be recorded or changed once the administration is completed.
+	Status *AssessmentAdministrationStatus `json:"status,omitempty"`
 }
 
-// PatientTherapyTypes defines model for Patient.TherapyTypes.
-type PatientTherapyTypes string
+// AssessmentAdministrationStatus Responses can't be recorded or changed once the administration is completed.
+type AssessmentAdministrationStatus string
+
+// AssessmentQuestion defines model for AssessmentQuestion.
+type AssessmentQuestion struct {
+	AnswerType   *AssessmentQuestionAnswerType `json:"answer_type,omitempty"`
+	AssessmentId *int                          `json:"assessment_id,omitempty"`
+
+	// Code Stable identifier of the question within its assessment.
+	Code           *string  `json:"code,omitempty"`
+	Domain         *string  `json:"domain"`
+	Group          *int     `json:"group,omitempty"`
+	GroupName      *string  `json:"group_name"`
+	Id             *int     `json:"id,omitempty"`
+	MaxScore       *float32 `json:"max_score"`
+	MilestoneLevel *int     `json:"milestone_level"`
+	Position       *int     `json:"position,omitempty"`
+	Text           *string  `json:"text,omitempty"`
+}
 
-// Session defines model for Session.
-type Session struct {
-	// Description A summarized description of the overall session.
-	Description *string `json:"description,omitempty"`
+// AssessmentQuestionAnswerType defines model for AssessmentQuestion.AnswerType.
+type AssessmentQuestionAnswerType string
+
+// AssessmentScores Totals of the latest answer to each active question during an administration.
+type AssessmentScores struct {
+	AdministeredAt   *time.Time    `json:"administered_at,omitempty"`
+	AdministrationId *int          `json:"administration_id,omitempty"`
+	Answered         *int          `json:"answered,omitempty"`
+	Assessment       *string       `json:"assessment,omitempty"`
+	AssessmentId     *int          `json:"assessment_id,omitempty"`
+	Groups           *[]GroupScore `json:"groups,omitempty"`
+	MaxScore         *float32      `json:"max_score,omitempty"`
+
+	// Milestones Milestone level per domain, for assessments that define milestones.
+	Milestones *[]DomainMilestone `json:"milestones,omitempty"`
+	PatientId  *string            `json:"patient_id,omitempty"`
+	Questions  *int               `json:"questions,omitempty"`
+	Score      *float32           `json:"score,omitempty"`
+}
 
-	// EndTime The end time of the overall session.
-	EndTime *time.Time `json:"end_time,omitempty"`
+// BehaviorIncident An Antecedent-Behavior-Consequence record of a challenging behavior during a session.
+type BehaviorIncident struct {
+	// Antecedent What happened just before the behavior.
+	Antecedent string `json:"antecedent"`
+
+	// Behavior What was observed.
+	Behavior string `json:"behavior"`
+
+	// BehaviorType Such as aggression, self-injury or elopement.
+	BehaviorType string `json:"behavior_type"`
+
+	// Consequence What followed the behavior.
+	Consequence        string                              `json:"consequence"`
+	CreatedAt          *time.Time                          `json:"created_at,omitempty"`
+	DurationSeconds    *int                                `json:"duration_seconds"`
+	FunctionHypothesis *BehaviorIncidentFunctionHypothesis `json:"function_hypothesis,omitempty"`
+	Id                 *int                                `json:"id,omitempty"`
+	Intensity          BehaviorIncidentIntensity           `json:"intensity"`
+
+	// OccurredAt Defaults to the start of the session.
+	OccurredAt *time.Time `json:"occurred_at,omitempty"`
+
+	// PatientId The session's patient.
+	PatientId    *string `json:"patient_id,omitempty"`
+	RecordedById *string `json:"recorded_by_id,omitempty"`
+	SessionId    *string `json:"session_id,omitempty"`
+
+	// Setting Where it happened, such as the therapy room.
+	Setting       *string    `json:"setting,omitempty"`
+	StaffResponse *string    `json:"staff_response,omitempty"`
+	UpdatedAt     *time.Time `json:"updated_at,omitempty"`
+}
 
-	// Id The unique identifier for the session.
-	Id *string `json:"id,omitempty"`
+// BehaviorIncidentFunctionHypothesis defines model for BehaviorIncident.FunctionHypothesis.
+type BehaviorIncidentFunctionHypothesis string
 
-	// PatientId The unique patient identifier involved with the session.
-	PatientId *string `json:"patient_id,omitempty"`
+// BehaviorIncidentIntensity defines model for BehaviorIncident.Intensity.
+type BehaviorIncidentIntensity string
 
-	// PaymentReceived A representation of whether the session has been paid.
-	PaymentReceived *bool `json:"payment_received,omitempty"`
+// BehaviorIncidentSummary defines model for BehaviorIncidentSummary.
+type BehaviorIncidentSummary struct {
+	BehaviorType *string `json:"behavior_type"`
 
-	// Response A measurement of the patient's response to the treatment of the session.
-	Response *SessionResponse `json:"response,omitempty"`
+	// ByAntecedent Most frequent first.
+	ByAntecedent *[]IncidentTally `json:"by_antecedent,omitempty"`
 
-	// StaffId The unique staff identifier administering the session.
-	StaffId *string `json:"staff_id,omitempty"`
+	// ByBehaviorType Most frequent first.
+	ByBehaviorType *[]IncidentTally `json:"by_behavior_type,omitempty"`
 
-	// StartTime The start time of the overall session.
-	StartTime *time.Time `json:"start_time,omitempty"`
-}
+	// ByFunction Most frequent first.
+	ByFunction *[]IncidentTally `json:"by_function,omitempty"`
 
-// SessionResponse A measurement of the patient's response to the treatment of the session.
-type SessionResponse string
+	// ByTimeOfDay Incidents in each local hour of the day, all 24 of them, midnight first.
+	ByTimeOfDay *[]struct {
+		Count *int `json:"count,omitempty"`
+		Hour  *int `json:"hour,omitempty"`
+	} `json:"by_time_of_day,omitempty"`
+	From      *openapi_types.Date `json:"from"`
+	PatientId *string             `json:"patient_id,omitempty"`
+	To        *openapi_types.Date `json:"to"`
+	Total     *int                `json:"total,omitempty"`
+}
 
-// Staff defines model for Staff.
-type Staff struct {
-	// ExpectedHours The number of expected hours per week worked.
-	ExpectedHours *float32 `json:"expected_hours,omitempty"`
+// BehaviorMeasurement How one of a patient's behaviors is measured during sessions. Inactive measurements keep their data points but take no new ones.
+type BehaviorMeasurement struct {
+	Active *bool `json:"active,omitempty"`
 
-	// Id A unique identifier for the staff member.
-	Id string `json:"id"`
+	// Behavior Such as hand flapping.
+	Behavior    string     `json:"behavior"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	CreatedById *string    `json:"created_by_id,omitempty"`
 
-	// JoinDate Date of which the staff member commenced.
-	JoinDate *openapi_types.Date `json:"join_date,omitempty"`
+	// Definition Operational definition observers score against.
+	Definition *string `json:"definition"`
+	Id         *int    `json:"id,omitempty"`
 
-	// Name The name of the staff member.
-	Name string `json:"name"`
+	// IntervalSeconds Length of each interval. Required for interval recording, and only allowed for it.
+	IntervalSeconds *int `json:"interval_seconds"`
 
-	// Role The role of the staff member in the organization.
-	Role StaffRole `json:"role"`
+	// Method Can't change once sessions have recorded the measurement.
+	Method    BehaviorMeasurementMethod `json:"method"`
+	PatientId *string                   `json:"patient_id,omitempty"`
+	UpdatedAt *time.Time                `json:"updated_at,omitempty"`
 }
 
-// StaffRole The role of the staff member in the organization.
-type StaffRole string
+// BehaviorMeasurementMethod Can't change once sessions have recorded the measurement.
+type BehaviorMeasurementMethod string
 
-// ValidationError defines model for ValidationError.
-type ValidationError struct {
-	Errors []struct {
-		Message string `json:"message"`
-		Path    string `json:"path"`
-	} `json:"errors"`
-	Message string `json:"message"`
+// Branch defines model for Branch.
+type Branch struct {
+	Active      *bool   `json:"active,omitempty"`
+	Description *string `json:"description"`
+
+	// HoursPolicy Whether sessions outside the branch's operating hours are rejected or saved with a warning.
+	HoursPolicy *BranchHoursPolicy `json:"hours_policy,omitempty"`
+
+	// Id The unique identifier for the branch.
+	Id       *int    `json:"id,omitempty"`
+	Location *string `json:"location"`
+
+	// OpeningDate start date of operations for this branch.
+	OpeningDate *time.Time `json:"opening_date"`
 }
 
-// GetPatientsParams defines parameters for GetPatients.
-type GetPatientsParams struct {
-	Page  *int `form:"page,omitempty" json:"page,omitempty"`
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// BranchHoursPolicy Whether sessions outside the branch's operating hours are rejected or saved with a warning.
+type BranchHoursPolicy string
+
+// BranchClosed The closure a session falls on.
+type BranchClosed struct {
+	BranchId  *int                `json:"branch_id,omitempty"`
+	ClosureId *int                `json:"closure_id,omitempty"`
+	EndDate   *openapi_types.Date `json:"end_date,omitempty"`
+	Error     *string             `json:"error,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
 }
 
-// GetPatientsPatientIdSessionsParams defines parameters for GetPatientsPatientIdSessions.
-type GetPatientsPatientIdSessionsParams struct {
-	Page      *int                `form:"page,omitempty" json:"page,omitempty"`
-	Limit     *int                `form:"limit,omitempty" json:"limit,omitempty"`
-	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
-	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+// BranchClosure Whole days a branch is closed, such as a public holiday or an unplanned closure. Dates are in the clinic's timezone and both ends are included.
+type BranchClosure struct {
+	BranchId *int `json:"branch_id,omitempty"`
+
+	// EndDate Defaults to the start date.
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Holiday Whether the closure was imported from the bundled public holidays.
+	Holiday   *bool              `json:"holiday,omitempty"`
+	Id        *int               `json:"id,omitempty"`
+	Name      string             `json:"name"`
+	StartDate openapi_types.Date `json:"start_date"`
 }
 
-// GetSessionsParams defines parameters for GetSessions.
-type GetSessionsParams struct {
-	Page      *int                `form:"page,omitempty" json:"page,omitempty"`
-	Limit     *int                `form:"limit,omitempty" json:"limit,omitempty"`
-	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
-	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+// CalendarFeed A calendar subscription to a staff member's or patient's sessions. Exactly one of staff_id and patient_id is set.
+type CalendarFeed struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// CreatedById The staff member who created the feed. The feed only shows sessions they can see.
+	CreatedById *string    `json:"created_by_id,omitempty"`
+	Id          *int       `json:"id,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at"`
+	PatientId   *string    `json:"patient_id"`
+	RevokedAt   *time.Time `json:"revoked_at"`
+	StaffId     *string    `json:"staff_id"`
 }
 
-// GetStaffParams defines parameters for GetStaff.
-type GetStaffParams struct {
-	Page  *int `form:"page,omitempty" json:"page,omitempty"`
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// CalendarFeedToken A new calendar feed. The token is only shown once; revoke the feed and create another if it's lost or leaked.
+type CalendarFeedToken struct {
+	// Feed A calendar subscription to a staff member's or patient's sessions. Exactly one of staff_id and patient_id is set.
+	Feed  *CalendarFeed `json:"feed,omitempty"`
+	Token *string       `json:"token,omitempty"`
+
+	// Url The URL to subscribe to in a calendar app.
+	Url *string `json:"url,omitempty"`
 }
 
-// GetStaffIdSessionsParams defines parameters for GetStaffIdSessions.
-type GetStaffIdSessionsParams struct {
-	Page      *int                `form:"page,omitempty" json:"page,omitempty"`
-	Limit     *int                `form:"limit,omitempty" json:"limit,omitempty"`
-	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
-	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+// CancelRequest defines model for CancelRequest.
+type CancelRequest struct {
+	// SessionIds Sessions to cancel. All the sessions the closure flagged when left out.
+	SessionIds *[]string `json:"session_ids,omitempty"`
 }
 
-// GetStaffStaffIdSessionsSessionIdActivitiesParams defines parameters for GetStaffStaffIdSessionsSessionIdActivities.
-type GetStaffStaffIdSessionsSessionIdActivitiesParams struct {
-	Page  *int `form:"page,omitempty" json:"page,omitempty"`
-	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+// DataPointScore A data point with the figure it's graphed by. Only the figure of its measurement's method is set.
+type DataPointScore struct {
+	// DataPoint What was observed of a measured behavior in one session. Frequency measurements record a count, duration measurements a duration, and interval measurements the intervals observed and scored.
+	DataPoint *MeasurementDataPoint `json:"data_point,omitempty"`
+
+	// PercentOfIntervals Partial and whole interval measurements.
+	PercentOfIntervals *float32 `json:"percent_of_intervals"`
+
+	// PercentOfTime Duration measurements, of the time observed.
+	PercentOfTime *float32 `json:"percent_of_time"`
+
+	// RatePerHour Frequency measurements.
+	RatePerHour  *float32   `json:"rate_per_hour"`
+	SessionStart *time.Time `json:"session_start,omitempty"`
 }
 
-// PostPatientsJSONRequestBody defines body for PostPatients for application/json ContentType.
-type PostPatientsJSONRequestBody = Patient
+// DiscontinueRequest defines model for DiscontinueRequest.
+type DiscontinueRequest struct {
+	Reason string `json:"reason"`
+}
 
-// PutPatientsIdJSONRequestBody defines body for PutPatientsId for application/json ContentType.
-type PutPatientsIdJSONRequestBody = Patient
+// DiscountRule A percentage off session prices. Sibling discounts apply by themselves to patients who share a guardian with another active patient; hardship discounts apply to the patients they're given to, and several make a sliding scale. Only the largest discount a patient qualifies for applies.
+type DiscountRule struct {
+	Active    *bool      `json:"active,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	Id        *int       `json:"id,omitempty"`
 
-// PostSessionsJSONRequestBody defines body for PostSessions for application/json ContentType.
-type PostSessionsJSONRequestBody = Session
+	// Kind Can't be changed once the rule is created.
+	Kind    *DiscountRuleKind `json:"kind,omitempty"`
+	Name    string            `json:"name"`
+	Percent int               `json:"percent"`
+}
 
-// PutSessionsIdJSONRequestBody defines body for PutSessionsId for application/json ContentType.
-type PutSessionsIdJSONRequestBody = Session
+// DiscountRuleKind Can't be changed once the rule is created.
+type DiscountRuleKind string
 
-// PostStaffJSONRequestBody defines body for PostStaff for application/json ContentType.
-type PostStaffJSONRequestBody = Staff
+// DomainMilestone defines model for DomainMilestone.
+type DomainMilestone struct {
+	Domain *string `json:"domain,omitempty"`
+	Label  *string `json:"label"`
 
-// PutStaffIdJSONRequestBody defines body for PutStaffId for application/json ContentType.
-type PutStaffIdJSONRequestBody = Staff
+	// Level Highest milestone level passed, or 0 if the first level isn't passed.
+	Level    *int     `json:"level,omitempty"`
+	MaxScore *float32 `json:"max_score,omitempty"`
+	Score    *float32 `json:"score,omitempty"`
+}
 
-// PostStaffStaffIdSessionsSessionIdActivitiesJSONRequestBody defines body for PostStaffStaffIdSessionsSessionIdActivities for application/json ContentType.
-type PostStaffStaffIdSessionsSessionIdActivitiesJSONRequestBody = Activity
+// Error defines model for Error.
+type Error struct {
+	Error string `json:"error"`
+}
 
-// PutStaffStaffIdSessionsSessionIdActivitiesIdJSONRequestBody defines body for PutStaffStaffIdSessionsSessionIdActivitiesId for application/json ContentType.
-type PutStaffStaffIdSessionsSessionIdActivitiesIdJSONRequestBody = Activity
+// Forbidden defines model for Forbidden.
+type Forbidden struct {
+	// AllowedRoles The roles permitted to perform the operation.
+	AllowedRoles *[]string `json:"allowed_roles,omitempty"`
+	Error        string    `json:"error"`
+
+	// Operation The operation that was denied, as "METHOD /path".
+	Operation string `json:"operation"`
+
+	// Role The role of the caller.
+	Role *string `json:"role,omitempty"`
+}
+
+// GroupScore defines model for GroupScore.
+type GroupScore struct {
+	Answered  *int     `json:"answered,omitempty"`
+	MaxScore  *float32 `json:"max_score,omitempty"`
+	Name      *string  `json:"name"`
+	Number    *int     `json:"number,omitempty"`
+	Questions *int     `json:"questions,omitempty"`
+	Score     *float32 `json:"score,omitempty"`
+}
+
+// GroupScoreChange defines model for GroupScoreChange.
+type GroupScoreChange struct {
+	Baseline *float32 `json:"baseline,omitempty"`
+	Change   *float32 `json:"change,omitempty"`
+	Current  *float32 `json:"current,omitempty"`
+	MaxScore *float32 `json:"max_score,omitempty"`
+	Name     *string  `json:"name"`
+	Number   *int     `json:"number,omitempty"`
+}
+
+// Guardian defines model for Guardian.
+type Guardian struct {
+	// Email Guardian's email address (optional).
+	Email *string `json:"email"`
+
+	// Id A unique identifier for the patient.
+	Id *string `json:"id,omitempty"`
+
+	// Name Full name of the patient.
+	Name *string `json:"name,omitempty"`
+
+	// PhoneNumber Guardian's phone number (optional).
+	PhoneNumber *string `json:"phone_number"`
+}
+
+// GuardianAccount What a guardian owes over their open invoices, in paise.
+type GuardianAccount struct {
+	GuardianId  *string    `json:"guardian_id,omitempty"`
+	Invoices    *[]Invoice `json:"invoices,omitempty"`
+	Name        *string    `json:"name,omitempty"`
+	Outstanding *int64     `json:"outstanding,omitempty"`
+	Overdue     *int64     `json:"overdue,omitempty"`
+}
+
+// GuardianCodeRequest Identifies the guardian by email or phone number. Exactly one is required.
+type GuardianCodeRequest struct {
+	Email       *openapi_types.Email `json:"email,omitempty"`
+	PhoneNumber *string              `json:"phone_number,omitempty"`
+}
+
+// GuardianTokenResponse defines model for GuardianTokenResponse.
+type GuardianTokenResponse struct {
+	Guardian Guardian `json:"guardian"`
+	Token    string   `json:"token"`
+}
+
+// GuardianVerifyRequest defines model for GuardianVerifyRequest.
+type GuardianVerifyRequest struct {
+	// Code The six digit code that was sent to the guardian.
+	Code        string               `json:"code"`
+	Email       *openapi_types.Email `json:"email,omitempty"`
+	PhoneNumber *string              `json:"phone_number,omitempty"`
+}
+
+// IncidentTally How many incidents share a value. Free text values are grouped ignoring case, labelled as first recorded.
+type IncidentTally struct {
+	Count *int    `json:"count,omitempty"`
+	Label *string `json:"label,omitempty"`
+
+	// Percent Share of all incidents summarised.
+	Percent *float32 `json:"percent,omitempty"`
+}
+
+// Invoice A bill to a guardian for a patient's completed sessions. Amounts are in paise.
+type Invoice struct {
+	CreatedAt   *time.Time          `json:"created_at,omitempty"`
+	CreatedById *string             `json:"created_by_id,omitempty"`
+	DueDate     *openapi_types.Date `json:"due_date,omitempty"`
+	GuardianId  *string             `json:"guardian_id,omitempty"`
+	Id          *int                `json:"id,omitempty"`
+	IssueDate   *openapi_types.Date `json:"issue_date,omitempty"`
+	Lines       *[]InvoiceLine      `json:"lines,omitempty"`
+	Paid        *int64              `json:"paid,omitempty"`
+	PatientId   *string             `json:"patient_id,omitempty"`
+	Payments    *[]Payment          `json:"payments,omitempty"`
+	Status      *InvoiceStatus      `json:"status,omitempty"`
+	Total       *int64              `json:"total,omitempty"`
+	UpdatedAt   *time.Time          `json:"updated_at,omitempty"`
+	VoidedAt    *time.Time          `json:"voided_at"`
+}
+
+// InvoiceStatus defines model for Invoice.Status.
+type InvoiceStatus string
+
+// InvoiceGeneration defines model for InvoiceGeneration.
+type InvoiceGeneration struct {
+	From openapi_types.Date `json:"from"`
+
+	// GuardianId Required when the patient has several guardians.
+	GuardianId *string `json:"guardian_id,omitempty"`
+
+	// To The last day to invoice sessions on.
+	To openapi_types.Date `json:"to"`
+}
+
+// InvoiceLine defines model for InvoiceLine.
+type InvoiceLine struct {
+	Amount         *int64  `json:"amount,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Discount       *int64  `json:"discount,omitempty"`
+	DiscountRuleId *int    `json:"discount_rule_id"`
+	Id             *int    `json:"id,omitempty"`
+	InvoiceId      *int    `json:"invoice_id,omitempty"`
+	Minutes        *int    `json:"minutes,omitempty"`
+	RateCardId     *int    `json:"rate_card_id"`
+	SessionId      *string `json:"session_id"`
+
+	// UnitPrice The session's price before the discount.
+	UnitPrice *int64 `json:"unit_price,omitempty"`
+}
+
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// MeasurementDataPoint What was observed of a measured behavior in one session. Frequency measurements record a count, duration measurements a duration, and interval measurements the intervals observed and scored.
+type MeasurementDataPoint struct {
+	Count           *int       `json:"count"`
+	CreatedAt       *time.Time `json:"created_at,omitempty"`
+	DurationSeconds *int       `json:"duration_seconds"`
+	Id              *int       `json:"id,omitempty"`
+	Intervals       *int       `json:"intervals"`
+
+	// IntervalsScored Intervals the behavior happened in at any point, or throughout for whole interval recording.
+	IntervalsScored *int `json:"intervals_scored"`
+	MeasurementId   int  `json:"measurement_id"`
+
+	// ObservationSeconds How long the behavior was watched for. Defaults to the intervals observed for interval recording, and to the length of the session otherwise.
+	ObservationSeconds *int       `json:"observation_seconds,omitempty"`
+	RecordedById       *string    `json:"recorded_by_id,omitempty"`
+	SessionId          *string    `json:"session_id,omitempty"`
+	UpdatedAt          *time.Time `json:"updated_at,omitempty"`
+}
+
+// MeasurementSeries defines model for MeasurementSeries.
+type MeasurementSeries struct {
+	// Measurement How one of a patient's behaviors is measured during sessions. Inactive measurements keep their data points but take no new ones.
+	Measurement *BehaviorMeasurement `json:"measurement,omitempty"`
+
+	// Points In the order their sessions started.
+	Points *[]DataPointScore `json:"points,omitempty"`
+}
+
+// MedicationDose An entry of the medication administration record, a dose given at the clinic or noted as refused.
+type MedicationDose struct {
+	// AdministeredAt When the dose was given or refused. Defaults to now.
+	AdministeredAt *time.Time `json:"administered_at,omitempty"`
+
+	// BranchId Where the dose was given. Defaults to the patient's primary branch.
+	BranchId  *int       `json:"branch_id,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DoseAmount What was given. Defaults to the prescribed amount; refused doses have none.
+	DoseAmount *float64 `json:"dose_amount"`
+	DoseUnit   *string  `json:"dose_unit"`
+
+	// DueAt The dose time it records. Required for scheduled medicines, and only allowed for them.
+	DueAt      *time.Time `json:"due_at"`
+	Id         *int       `json:"id,omitempty"`
+	MedicineId *string    `json:"medicine_id,omitempty"`
+
+	// Notes Required for refused doses, to say why.
+	Notes        *string              `json:"notes"`
+	PatientId    *string              `json:"patient_id,omitempty"`
+	RecordedById *string              `json:"recorded_by_id,omitempty"`
+	Status       MedicationDoseStatus `json:"status"`
+}
+
+// MedicationDoseStatus defines model for MedicationDose.Status.
+type MedicationDoseStatus string
+
+// Medicine defines model for Medicine.
+type Medicine struct {
+	// Active False once the medicine has been discontinued.
+	Active *bool `json:"active,omitempty"`
+
+	// BrandName The brand name of the medicine.
+	BrandName *string `json:"brand_name"`
+
+	// Dosage The prescribed dosage, as free text.
+	Dosage *string `json:"dosage"`
+
+	// DoseAmount The amount per dose, in dose_unit. Structured dosing sets the amount, unit, route, frequency and start date together.
+	DoseAmount *float64 `json:"dose_amount"`
+
+	// DoseTimes The local times doses are due, in order, such as 09:00,14:00. One for each dose a day; none for medicines taken as needed.
+	DoseTimes *string `json:"dose_times"`
+
+	// DoseUnit Such as mg, ml or tablet.
+	DoseUnit *string `json:"dose_unit"`
+
+	// EndDate The last day of the course, included.
+	EndDate   *openapi_types.Date `json:"end_date"`
+	Frequency *MedicineFrequency  `json:"frequency"`
+
+	// Id The unique identifier for the prescribed medicine.
+	Id *string `json:"id,omitempty"`
+
+	// Name The generic name of the medicine.
+	Name string `json:"name"`
+
+	// PatientId The patient the medicine is prescribed to.
+	PatientId *string `json:"patient_id,omitempty"`
+
+	// PrescriberId The doctor who prescribed the medicine.
+	PrescriberId *string `json:"prescriber_id,omitempty"`
+
+	// Reason Why the prescription is started or changed, recorded on its prescription event. Required to change the prescription, but not its refill and renewal dates.
+	Reason *string `json:"reason"`
+
+	// RefillDate When the current supply runs out.
+	RefillDate *openapi_types.Date `json:"refill_date"`
+
+	// RenewalDate When the prescription expires unless it's renewed.
+	RenewalDate *openapi_types.Date `json:"renewal_date"`
+	Route       *MedicineRoute      `json:"route"`
+	StartDate   *openapi_types.Date `json:"start_date"`
+}
+
+// MedicineFrequency defines model for Medicine.Frequency.
+type MedicineFrequency string
+
+// MedicineRoute defines model for Medicine.Route.
+type MedicineRoute string
+
+// MedicineReminder A refill or renewal of an active medicine that's due soon or overdue.
+type MedicineReminder struct {
+	// DaysLeft Negative once overdue.
+	DaysLeft    *int                 `json:"days_left,omitempty"`
+	Due         *MedicineReminderDue `json:"due,omitempty"`
+	DueDate     *openapi_types.Date  `json:"due_date,omitempty"`
+	Medicine    *Medicine            `json:"medicine,omitempty"`
+	PatientName *string              `json:"patient_name,omitempty"`
+}
+
+// MedicineReminderDue defines model for MedicineReminder.Due.
+type MedicineReminderDue string
+
+// MilestoneChange defines model for MilestoneChange.
+type MilestoneChange struct {
+	Baseline *int    `json:"baseline,omitempty"`
+	Current  *int    `json:"current,omitempty"`
+	Domain   *string `json:"domain,omitempty"`
+}
+
+// OccurrenceChanges Fields left out keep their current values.
+type OccurrenceChanges struct {
+	Description *string    `json:"description,omitempty"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+
+	// Recurrence New RRULE for all following occurrences.
+	Recurrence *string `json:"recurrence,omitempty"`
+	Shareable  *bool   `json:"shareable,omitempty"`
+	StaffId    *string `json:"staff_id,omitempty"`
+
+	// StartTime New start of the occurrence. Applied to all following occurrences, it sets their time of day.
+	StartTime *time.Time `json:"start_time,omitempty"`
+}
+
+// OnboardingAnswer Score questions take a score from the assessment's scale, or up to the question's max_score. Yes/no questions take an answer of "yes" or "no", and text questions a free text answer.
+type OnboardingAnswer struct {
+	Answer *string  `json:"answer"`
+	Notes  *string  `json:"notes"`
+	Score  *float64 `json:"score"`
+}
+
+// OnboardingResponse defines model for OnboardingResponse.
+type OnboardingResponse struct {
+	AdministrationId *int       `json:"administration_id,omitempty"`
+	Answer           *string    `json:"answer"`
+	Id               *int       `json:"id,omitempty"`
+	Notes            *string    `json:"notes"`
+	PatientId        *string    `json:"patient_id,omitempty"`
+	QuestionId       *int       `json:"question_id,omitempty"`
+	ResponseDate     *time.Time `json:"response_date,omitempty"`
+	Score            *float32   `json:"score"`
+	SessionId        *string    `json:"session_id"`
+	StaffId          *string    `json:"staff_id,omitempty"`
+}
+
+// OnboardingResponseRequest defines model for OnboardingResponseRequest.
+type OnboardingResponseRequest struct {
+	AdministrationId int      `json:"administration_id"`
+	Answer           *string  `json:"answer"`
+	Notes            *string  `json:"notes"`
+	QuestionId       int      `json:"question_id"`
+	Score            *float64 `json:"score"`
+	SessionId        *string  `json:"session_id"`
+}
+
+// OperatingHours A branch's hours on one day of the week, in the clinic's timezone.
+type OperatingHours struct {
+	CloseTime *string `json:"close_time,omitempty"`
+
+	// DayOfWeek 0 for Sunday to 6 for Saturday.
+	DayOfWeek int     `json:"day_of_week"`
+	IsClosed  *bool   `json:"is_closed,omitempty"`
+	OpenTime  *string `json:"open_time,omitempty"`
+}
+
+// OutsideHours The operating hours a session conflicts with.
+type OutsideHours struct {
+	BranchId  *int    `json:"branch_id,omitempty"`
+	CloseTime *string `json:"close_time,omitempty"`
+	DayOfWeek *int    `json:"day_of_week,omitempty"`
+	Error     *string `json:"error,omitempty"`
+	IsClosed  *bool   `json:"is_closed,omitempty"`
+	OpenTime  *string `json:"open_time,omitempty"`
+}
+
+// PaginatedResponse defines model for PaginatedResponse.
+type PaginatedResponse struct {
+	Data       *[]map[string]interface{} `json:"data,omitempty"`
+	Pagination *struct {
+		Limit *int `json:"limit,omitempty"`
+		Page  *int `json:"page,omitempty"`
+		Pages *int `json:"pages,omitempty"`
+		Total *int `json:"total,omitempty"`
+	} `json:"pagination,omitempty"`
+}
+
+// Patient defines model for Patient.
+type Patient struct {
+	// Active Represents the current patient status.
+	Active *bool `json:"active,omitempty"`
+
+	// CreatedAt Timestamp when the patient record was created.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Dob Patient Date of Birth
+	Dob openapi_types.Date `json:"dob"`
+
+	// DoctorId The primary physician for the patient.
+	DoctorId *string `json:"doctor_id,omitempty"`
+
+	// GuardianId ID of the patient's guardian.
+	GuardianId *string `json:"guardian_id,omitempty"`
+
+	// Id A unique identifier for the patient.
+	Id *string `json:"id,omitempty"`
+
+	// Name Full name of the patient.
+	Name string `json:"name"`
+
+	// PrescribedMedicines List of prescribed medicines
+	PrescribedMedicines *[]string `json:"prescribed_medicines,omitempty"`
+
+	// StaffId The unique identifier of the assigned staff member.
+	StaffId      string               `json:"staff_id"`
+	TherapyTypes *PatientTherapyTypes `json:"therapy_types,omitempty"`
+
+	// UpdatedAt Timestamp when the patient record was last updated.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// PatientTherapyTypes defines model for Patient.TherapyTypes.
+type PatientTherapyTypes string
+
+// PatientDiscountRequest defines model for PatientDiscountRequest.
+type PatientDiscountRequest struct {
+	// DiscountRuleId A hardship discount rule.
+	DiscountRuleId int `json:"discount_rule_id"`
+}
+
+// Payment defines model for Payment.
+type Payment struct {
+	Amount       *int64         `json:"amount,omitempty"`
+	CreatedAt    *time.Time     `json:"created_at,omitempty"`
+	Id           *int           `json:"id,omitempty"`
+	InvoiceId    *int           `json:"invoice_id,omitempty"`
+	Method       *PaymentMethod `json:"method,omitempty"`
+	ReceivedAt   *time.Time     `json:"received_at,omitempty"`
+	ReceivedById *string        `json:"received_by_id,omitempty"`
+
+	// Reference A UPI transaction ID, card slip number or receipt number.
+	Reference *string `json:"reference,omitempty"`
+}
+
+// PaymentMethod defines model for Payment.Method.
+type PaymentMethod string
+
+// PaymentRequest defines model for PaymentRequest.
+type PaymentRequest struct {
+	// Amount In paise, no more than the invoice's balance.
+	Amount int64                `json:"amount"`
+	Method PaymentRequestMethod `json:"method"`
+
+	// ReceivedAt Defaults to now.
+	ReceivedAt *time.Time `json:"received_at,omitempty"`
+	Reference  *string    `json:"reference,omitempty"`
+}
+
+// PaymentRequestMethod defines model for PaymentRequest.Method.
+type PaymentRequestMethod string
+
+// PortalMedicine defines model for PortalMedicine.
+type PortalMedicine struct {
+	BrandName *string `json:"brand_name"`
+	Dosage    *string `json:"dosage"`
+	Id        *string `json:"id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+}
+
+// PortalSession A session as shown to guardians. The description is only included when staff marked it shareable.
+type PortalSession struct {
+	Description *string    `json:"description"`
+	EndTime     *time.Time `json:"end_time,omitempty"`
+	Id          *string    `json:"id,omitempty"`
+	StartTime   *time.Time `json:"start_time,omitempty"`
+}
+
+// PrescriptionEvent A version of a medicine's prescription, as it stood after it was started, adjusted or discontinued.
+type PrescriptionEvent struct {
+	BrandName  *string                `json:"brand_name"`
+	CreatedAt  *time.Time             `json:"created_at,omitempty"`
+	Dosage     *string                `json:"dosage"`
+	DoseAmount *float64               `json:"dose_amount"`
+	DoseTimes  *string                `json:"dose_times"`
+	DoseUnit   *string                `json:"dose_unit"`
+	EndDate    *openapi_types.Date    `json:"end_date"`
+	Frequency  *string                `json:"frequency"`
+	Id         *int                   `json:"id,omitempty"`
+	Kind       *PrescriptionEventKind `json:"kind,omitempty"`
+	MedicineId *string                `json:"medicine_id,omitempty"`
+	Name       *string                `json:"name,omitempty"`
+	PatientId  *string                `json:"patient_id,omitempty"`
+
+	// PrescriberId The doctor who made the change.
+	PrescriberId *string             `json:"prescriber_id,omitempty"`
+	Reason       *string             `json:"reason"`
+	Route        *string             `json:"route"`
+	StartDate    *openapi_types.Date `json:"start_date"`
+
+	// Version 1 for the medicine's first event.
+	Version *int `json:"version,omitempty"`
+}
+
+// PrescriptionEventKind defines model for PrescriptionEvent.Kind.
+type PrescriptionEventKind string
+
+// ProgressSummary defines model for ProgressSummary.
+type ProgressSummary struct {
+	LastSessionAt *time.Time `json:"last_session_at"`
+	NextSessionAt *time.Time `json:"next_session_at"`
+	PatientId     *string    `json:"patient_id,omitempty"`
+
+	// Responses Count of completed sessions in the last 90 days by response level.
+	Responses         *map[string]int `json:"responses,omitempty"`
+	SessionsCompleted *int            `json:"sessions_completed,omitempty"`
+	SessionsUpcoming  *int            `json:"sessions_upcoming,omitempty"`
+}
+
+// QuestionScoreChange defines model for QuestionScoreChange.
+type QuestionScoreChange struct {
+	Baseline       *float32 `json:"baseline"`
+	BaselineAnswer *string  `json:"baseline_answer"`
+
+	// Change Missing unless both administrations have a scored answer.
+	Change        *float32 `json:"change"`
+	Code          *string  `json:"code,omitempty"`
+	Current       *float32 `json:"current"`
+	CurrentAnswer *string  `json:"current_answer"`
+	Group         *int     `json:"group,omitempty"`
+	QuestionId    *int     `json:"question_id,omitempty"`
+	Text          *string  `json:"text,omitempty"`
+}
+
+// RateCard The price of sessions of a therapy type whose length falls in a band, from a date until a card for the same band with a later date takes over. Cards without a branch apply at branches with no card of their own for the band.
+type RateCard struct {
+	BranchId      *int               `json:"branch_id"`
+	CreatedAt     *time.Time         `json:"created_at,omitempty"`
+	EffectiveFrom openapi_types.Date `json:"effective_from"`
+	Id            *int               `json:"id,omitempty"`
+
+	// MaxMinutes Included in the band.
+	MaxMinutes int `json:"max_minutes"`
+	MinMinutes int `json:"min_minutes"`
+
+	// Price Per session, in paise.
+	Price       int64               `json:"price"`
+	TherapyType RateCardTherapyType `json:"therapy_type"`
+}
+
+// RateCardTherapyType defines model for RateCard.TherapyType.
+type RateCardTherapyType string
+
+// ReceivablesAging defines model for ReceivablesAging.
+type ReceivablesAging struct {
+	AsOf *openapi_types.Date `json:"as_of,omitempty"`
+
+	// Rows One row per guardian with an open invoice, largest balance first.
+	Rows *[]struct {
+		// Current Not due yet.
+		Current    *int64  `json:"current,omitempty"`
+		Days130    *int64  `json:"days_1_30,omitempty"`
+		Days3160   *int64  `json:"days_31_60,omitempty"`
+		Days6190   *int64  `json:"days_61_90,omitempty"`
+		GuardianId *string `json:"guardian_id,omitempty"`
+		Name       *string `json:"name,omitempty"`
+		Over90     *int64  `json:"over_90,omitempty"`
+		Total      *int64  `json:"total,omitempty"`
+	} `json:"rows,omitempty"`
+
+	// Totals Outstanding balances in paise by how many days past their due date they are.
+	Totals *AgingBalances `json:"totals,omitempty"`
+}
+
+// RescheduleConflict defines model for RescheduleConflict.
+type RescheduleConflict struct {
+	// Conflicts Sessions that can't be moved or cancelled. None of the sessions were changed.
+	Conflicts *[]struct {
+		Reason    *string `json:"reason,omitempty"`
+		SessionId *string `json:"session_id,omitempty"`
+	} `json:"conflicts,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+// RescheduleRequest New times for sessions flagged by a closure. Sessions without a time of their own are shifted by offset_days at the same local time.
+type RescheduleRequest struct {
+	Moves *[]struct {
+		EndTime   time.Time `json:"end_time"`
+		SessionId string    `json:"session_id"`
+		StartTime time.Time `json:"start_time"`
+	} `json:"moves,omitempty"`
+	OffsetDays *int `json:"offset_days,omitempty"`
+}
+
+// ResponsePeriod defines model for ResponsePeriod.
+type ResponsePeriod struct {
+	// Activities Activities grouped by description, ignoring case and surrounding spaces.
+	Activities *[]ActivityResponses `json:"activities,omitempty"`
+
+	// EndDate Last day included.
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Responses Count of sessions by response level.
+	Responses *map[string]int `json:"responses,omitempty"`
+
+	// Score Average session response, from low 1 to high 3.
+	Score     *float32            `json:"score"`
+	Sessions  *int                `json:"sessions,omitempty"`
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+}
+
+// ResponseProgress How a patient responded in sessions and activities, bucketed by the week or month sessions start in. Only sessions that have started count.
+type ResponseProgress struct {
+	// Buckets Every week or month of the range, earliest first, including ones without sessions.
+	Buckets *[]ResponsePeriod `json:"buckets,omitempty"`
+
+	// EndDate Last day included.
+	EndDate     *openapi_types.Date     `json:"end_date,omitempty"`
+	PatientId   *string                 `json:"patient_id,omitempty"`
+	Period      *ResponseProgressPeriod `json:"period,omitempty"`
+	StartDate   *openapi_types.Date     `json:"start_date,omitempty"`
+	TherapyType *string                 `json:"therapy_type"`
+}
+
+// ResponseProgressPeriod defines model for ResponseProgress.Period.
+type ResponseProgressPeriod string
+
+// ScheduledDose A scheduled dose still to be given today.
+type ScheduledDose struct {
+	DueAt    *time.Time `json:"due_at,omitempty"`
+	Medicine *Medicine  `json:"medicine,omitempty"`
+
+	// Overdue Whether it's more than half an hour past due.
+	Overdue     *bool   `json:"overdue,omitempty"`
+	PatientId   *string `json:"patient_id,omitempty"`
+	PatientName *string `json:"patient_name,omitempty"`
+}
+
+// SeriesConflict defines model for SeriesConflict.
+type SeriesConflict struct {
+	// Conflicts Occurrences that would overlap another session of the staff member or fall outside the branch's hours.
+	Conflicts *[]struct {
+		OccursAt *time.Time `json:"occurs_at,omitempty"`
+		Reason   *string    `json:"reason,omitempty"`
+	} `json:"conflicts,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+// SeriesOccurrence defines model for SeriesOccurrence.
+type SeriesOccurrence struct {
+	Series  *SessionSeries `json:"series,omitempty"`
+	Session *Session       `json:"session,omitempty"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	// ClosureId The branch closure the session falls on, until it's rescheduled.
+	ClosureId *int `json:"closure_id"`
+
+	// Description A summarized description of the overall session.
+	Description *string `json:"description,omitempty"`
+
+	// EndTime The end time of the overall session.
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// Id The unique identifier for the session.
+	Id *string `json:"id,omitempty"`
+
+	// OccursAt The occurrence of the series the session fills. It stays the same when only this session is moved.
+	OccursAt *time.Time `json:"occurs_at"`
+
+	// PatientId The unique patient identifier involved with the session.
+	PatientId *string `json:"patient_id,omitempty"`
+
+	// PaymentReceived Set from invoices; false while the session is on an unpaid invoice and true once it's paid.
+	PaymentReceived *bool `json:"payment_received"`
+
+	// Response A measurement of the patient's response to the treatment of the session.
+	Response *SessionResponse `json:"response,omitempty"`
+
+	// SeriesId The recurring series the session was generated from.
+	SeriesId *string `json:"series_id"`
+
+	// Shareable Whether guardians can read the session description in the portal.
+	Shareable *bool `json:"shareable,omitempty"`
+
+	// StaffId The unique staff identifier administering the session.
+	StaffId *string `json:"staff_id,omitempty"`
+
+	// StartTime The start time of the overall session.
+	StartTime *time.Time `json:"start_time,omitempty"`
+}
+
+// SessionResponse A measurement of the patient's response to the treatment of the session.
+type SessionResponse string
+
+// SessionEstimate The price of a session for a patient, in paise.
+type SessionEstimate struct {
+	Amount         *int64  `json:"amount,omitempty"`
+	BranchId       *int    `json:"branch_id"`
+	Discount       *int64  `json:"discount,omitempty"`
+	DiscountName   *string `json:"discount_name,omitempty"`
+	DiscountRuleId *int    `json:"discount_rule_id"`
+	Minutes        *int    `json:"minutes,omitempty"`
+	Price          *int64  `json:"price,omitempty"`
+	RateCardId     *int    `json:"rate_card_id,omitempty"`
+	TherapyType    *string `json:"therapy_type,omitempty"`
+}
+
+// SessionSeries defines model for SessionSeries.
+type SessionSeries struct {
+	BranchId    *int    `json:"branch_id"`
+	Description *string `json:"description,omitempty"`
+
+	// EndTime End of the first occurrence.
+	EndTime *time.Time `json:"end_time,omitempty"`
+
+	// GeneratedUntil Sessions have been generated for every occurrence before this.
+	GeneratedUntil *time.Time `json:"generated_until,omitempty"`
+	Id             *string    `json:"id,omitempty"`
+	PatientId      *string    `json:"patient_id,omitempty"`
+
+	// Recurrence RRULE the occurrences follow, with UNTIL in UTC.
+	Recurrence *string `json:"recurrence,omitempty"`
+	Shareable  *bool   `json:"shareable,omitempty"`
+	StaffId    *string `json:"staff_id,omitempty"`
+
+	// StartTime Start of the first occurrence. Later occurrences start at the same local time of day.
+	StartTime *time.Time `json:"start_time,omitempty"`
+}
+
+// SessionSeriesRequest defines model for SessionSeriesRequest.
+type SessionSeriesRequest struct {
+	BranchId    *int    `json:"branch_id"`
+	Description *string `json:"description,omitempty"`
+
+	// EndTime End of the first occurrence.
+	EndTime   time.Time `json:"end_time"`
+	PatientId string    `json:"patient_id"`
+
+	// Recurrence An RRULE with FREQ=DAILY or WEEKLY, and optionally INTERVAL, BYDAY and UNTIL.
+	// A date-only UNTIL includes that whole day in the clinic's timezone.
+	Recurrence string `json:"recurrence"`
+	Shareable  *bool  `json:"shareable,omitempty"`
+	StaffId    string `json:"staff_id"`
+
+	// StartTime Start of the first occurrence.
+	StartTime time.Time `json:"start_time"`
+}
+
+// Staff defines model for Staff.
+type Staff struct {
+	// Email The email address the staff member signs in with.
+	Email *openapi_types.Email `json:"email"`
+
+	// ExpectedHours The number of expected hours per week worked.
+	ExpectedHours *float32 `json:"expected_hours,omitempty"`
+
+	// Id A unique identifier for the staff member.
+	Id string `json:"id"`
+
+	// JoinDate Date of which the staff member commenced.
+	JoinDate *openapi_types.Date `json:"join_date,omitempty"`
+
+	// Name The name of the staff member.
+	Name string `json:"name"`
+
+	// Password The staff member's password. Only accepted when creating or updating staff.
+	Password *string `json:"password,omitempty"`
+
+	// Role The role of the staff member in the organization.
+	Role StaffRole `json:"role"`
+}
+
+// StaffRole The role of the staff member in the organization.
+type StaffRole string
+
+// StaffTimesheet A staff member's session hours over a week or month, compared with their expected hours. Completed hours are from sessions that ended with activities recorded; sessions that ended without any are unrecorded, and ones still to come are upcoming.
+type StaffTimesheet struct {
+	CompletedHours *float32 `json:"completed_hours,omitempty"`
+	Days           *[]struct {
+		CompletedHours *float32            `json:"completed_hours,omitempty"`
+		Date           *openapi_types.Date `json:"date,omitempty"`
+		ScheduledHours *float32            `json:"scheduled_hours,omitempty"`
+	} `json:"days,omitempty"`
+
+	// EndDate The last day of the period.
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// ExpectedHours The staff member's weekly expected hours, spread over the days of the period since they joined.
+	ExpectedHours *float32 `json:"expected_hours,omitempty"`
+
+	// Flag Until the period is over, upcoming hours count towards the flag.
+	Flag            *StaffTimesheetFlag   `json:"flag,omitempty"`
+	Period          *StaffTimesheetPeriod `json:"period,omitempty"`
+	ScheduledHours  *float32              `json:"scheduled_hours,omitempty"`
+	Sessions        *int                  `json:"sessions,omitempty"`
+	StaffId         *string               `json:"staff_id,omitempty"`
+	StaffName       *string               `json:"staff_name,omitempty"`
+	StartDate       *openapi_types.Date   `json:"start_date,omitempty"`
+	UnrecordedHours *float32              `json:"unrecorded_hours,omitempty"`
+	UpcomingHours   *float32              `json:"upcoming_hours,omitempty"`
+
+	// Utilization Completed hours as a share of expected hours.
+	Utilization *float32 `json:"utilization"`
+}
+
+// StaffTimesheetFlag Until the period is over, upcoming hours count towards the flag.
+type StaffTimesheetFlag string
+
+// StaffTimesheetPeriod defines model for StaffTimesheet.Period.
+type StaffTimesheetPeriod string
+
+// TargetScores defines model for TargetScores.
+type TargetScores struct {
+	// Sessions Every session the target was practised in, earliest first.
+	Sessions *[]TargetSessionScore `json:"sessions,omitempty"`
+
+	// Target A short-term target working towards a goal, mastered once the patient scores at least mastery_percent in mastery_sessions consecutive sessions.
+	Target *TreatmentTarget `json:"target,omitempty"`
+}
+
+// TargetSessionScore How a target scored in one session, across all of the session's activities that practised it.
+type TargetSessionScore struct {
+	// Independent Correct trials.
+	Independent *int `json:"independent,omitempty"`
+
+	// MeetsCriterion Whether the session scored at or above the target's mastery percent.
+	MeetsCriterion     *bool      `json:"meets_criterion,omitempty"`
+	PercentIndependent *float32   `json:"percent_independent,omitempty"`
+	SessionId          *string    `json:"session_id,omitempty"`
+	StartTime          *time.Time `json:"start_time,omitempty"`
+	Trials             *int       `json:"trials,omitempty"`
+}
+
+// TaxInvoice The GST document issued for a paid invoice, numbered without gaps within its branch and financial year. It's a bill of supply while GST_RATE is 0, as healthcare is exempt. Amounts are in paise and include GST.
+type TaxInvoice struct {
+	BranchId        *int                `json:"branch_id,omitempty"`
+	Cgst            *int64              `json:"cgst,omitempty"`
+	CreatedAt       *time.Time          `json:"created_at,omitempty"`
+	CreatedById     *string             `json:"created_by_id,omitempty"`
+	FinancialYear   *string             `json:"financial_year,omitempty"`
+	Id              *int                `json:"id,omitempty"`
+	InvoiceId       *int                `json:"invoice_id,omitempty"`
+	IssueDate       *openapi_types.Date `json:"issue_date,omitempty"`
+	LastReprintedAt *time.Time          `json:"last_reprinted_at"`
+	Number          *string             `json:"number,omitempty"`
+	Reprints        *int                `json:"reprints,omitempty"`
+	SequenceNumber  *int                `json:"sequence_number,omitempty"`
+	Sgst            *int64              `json:"sgst,omitempty"`
+	Taxable         *int64              `json:"taxable,omitempty"`
+	Total           *int64              `json:"total,omitempty"`
+}
+
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	Token string `json:"token"`
+	User  Staff  `json:"user"`
+}
+
+// TreatmentGoal A long-term goal of a treatment plan.
+type TreatmentGoal struct {
+	CreatedAt   *time.Time           `json:"created_at,omitempty"`
+	Description string               `json:"description"`
+	Id          *int                 `json:"id,omitempty"`
+	PlanId      *int                 `json:"plan_id,omitempty"`
+	Status      *TreatmentGoalStatus `json:"status,omitempty"`
+
+	// Targets Targets to create with the goal. Later targets are added to the goal on their own.
+	Targets   *[]TreatmentTarget `json:"targets,omitempty"`
+	UpdatedAt *time.Time         `json:"updated_at,omitempty"`
+}
+
+// TreatmentGoalStatus defines model for TreatmentGoal.Status.
+type TreatmentGoalStatus string
+
+// TreatmentPlan A patient's individualized treatment plan of long-term goals, each broken down into short-term targets. A patient has at most one active plan.
+type TreatmentPlan struct {
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	CreatedById *string    `json:"created_by_id,omitempty"`
+
+	// Goals Goals to create with the plan. Later goals are added to the plan on their own.
+	Goals          *[]TreatmentGoal    `json:"goals,omitempty"`
+	Id             *int                `json:"id,omitempty"`
+	LastReviewedOn *openapi_types.Date `json:"last_reviewed_on"`
+	Notes          *string             `json:"notes"`
+	PatientId      *string             `json:"patient_id,omitempty"`
+
+	// ReviewDate When the next review of the plan is due.
+	ReviewDate openapi_types.Date `json:"review_date"`
+
+	// StartDate Defaults to today.
+	StartDate *openapi_types.Date  `json:"start_date,omitempty"`
+	Status    *TreatmentPlanStatus `json:"status,omitempty"`
+	Title     string               `json:"title"`
+	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
+}
+
+// TreatmentPlanStatus defines model for TreatmentPlan.Status.
+type TreatmentPlanStatus string
+
+// TreatmentTarget A short-term target working towards a goal, mastered once the patient scores at least mastery_percent in mastery_sessions consecutive sessions.
+type TreatmentTarget struct {
+	BaselineNotes *string `json:"baseline_notes"`
+
+	// BaselinePercent How the patient scored before teaching started.
+	BaselinePercent *float32               `json:"baseline_percent"`
+	CreatedAt       *time.Time             `json:"created_at,omitempty"`
+	Description     string                 `json:"description"`
+	GoalId          *int                   `json:"goal_id,omitempty"`
+	Id              *int                   `json:"id,omitempty"`
+	MasteredAt      *time.Time             `json:"mastered_at"`
+	MasteryPercent  *float32               `json:"mastery_percent,omitempty"`
+	MasterySessions *int                   `json:"mastery_sessions,omitempty"`
+	PlanId          *int                   `json:"plan_id,omitempty"`
+	Status          *TreatmentTargetStatus `json:"status,omitempty"`
+	UpdatedAt       *time.Time             `json:"updated_at,omitempty"`
+}
+
+// TreatmentTargetStatus defines model for TreatmentTarget.Status.
+type TreatmentTargetStatus string
+
+// Trial One discrete trial run during an activity. Correct trials are independent; prompted ones say which prompt was needed; incorrect ones have no prompt level.
+type Trial struct {
+	ActivityId *string    `json:"activity_id,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	Id         *int       `json:"id,omitempty"`
+
+	// Number Order of the trial within the activity, from 1.
+	Number       *int              `json:"number,omitempty"`
+	Outcome      TrialOutcome      `json:"outcome"`
+	PromptLevel  *TrialPromptLevel `json:"prompt_level"`
+	RecordedById *string           `json:"recorded_by_id,omitempty"`
+}
+
+// TrialOutcome defines model for Trial.Outcome.
+type TrialOutcome string
+
+// TrialPromptLevel defines model for Trial.PromptLevel.
+type TrialPromptLevel string
+
+// TrialBatch defines model for TrialBatch.
+type TrialBatch struct {
+	// Trials Trials in the order they were run, numbered after the activity's earlier ones.
+	Trials []Trial `json:"trials"`
+}
+
+// TrialRecording defines model for TrialRecording.
+type TrialRecording struct {
+	// Mastered Whether the trials met the target's mastery criteria, marking it mastered.
+	Mastered *bool `json:"mastered,omitempty"`
+
+	// Session How the activity's target scored in its session. Null when the activity doesn't practise a target.
+	Session *TargetSessionScore `json:"session"`
+
+	// Target The activity's target, after the trials. Null when the activity doesn't practise one.
+	Target *TreatmentTarget `json:"target"`
+	Trials *[]Trial         `json:"trials,omitempty"`
+}
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	Errors []struct {
+		Message string `json:"message"`
+		Path    string `json:"path"`
+	} `json:"errors"`
+	Message string `json:"message"`
+}
+
+// GetAdministrationsIdComparisonParams defines parameters for GetAdministrationsIdComparison.
+type GetAdministrationsIdComparisonParams struct {
+	// BaselineId The administration to compare against.
+	BaselineId int `form:"baseline_id" json:"baseline_id"`
+}
+
+// GetAuditLogsParams defines parameters for GetAuditLogs.
+type GetAuditLogsParams struct {
+	PatientId *string `form:"patient_id,omitempty" json:"patient_id,omitempty"`
+
+	// StaffId The staff member who viewed or changed the data.
+	StaffId *string    `form:"staff_id,omitempty" json:"staff_id,omitempty"`
+	From    *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To      *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Page    *int       `form:"page,omitempty" json:"page,omitempty"`
+	Limit   *int       `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetBehaviorMeasurementsIdDataPointsParams defines parameters for GetBehaviorMeasurementsIdDataPoints.
+type GetBehaviorMeasurementsIdDataPointsParams struct {
+	// From Leave out to start from the first session recorded.
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Included. Leave out to go up to the latest session recorded.
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetBranchesIdClosuresParams defines parameters for GetBranchesIdClosures.
+type GetBranchesIdClosuresParams struct {
+	// From Only closures ending on or after this date.
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only closures starting on or before this date.
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PostBranchesIdClosuresHolidaysParams defines parameters for PostBranchesIdClosuresHolidays.
+type PostBranchesIdClosuresHolidaysParams struct {
+	Year int `form:"year" json:"year"`
+}
+
+// PutBranchesIdHoursJSONBody defines parameters for PutBranchesIdHours.
+type PutBranchesIdHoursJSONBody = []OperatingHours
+
+// GetBranchesIdTaxInvoicesParams defines parameters for GetBranchesIdTaxInvoices.
+type GetBranchesIdTaxInvoicesParams struct {
+	// FinancialYear Defaults to the current one.
+	FinancialYear *string `form:"financial_year,omitempty" json:"financial_year,omitempty"`
+}
+
+// GetCalendarPatientsPatientIdParams defines parameters for GetCalendarPatientsPatientId.
+type GetCalendarPatientsPatientIdParams struct {
+	Token string `form:"token" json:"token"`
+}
+
+// GetCalendarStaffIdParams defines parameters for GetCalendarStaffId.
+type GetCalendarStaffIdParams struct {
+	Token string `form:"token" json:"token"`
+}
+
+// GetGuardianChildrenPatientIdSessionsParams defines parameters for GetGuardianChildrenPatientIdSessions.
+type GetGuardianChildrenPatientIdSessionsParams struct {
+	When *GetGuardianChildrenPatientIdSessionsParamsWhen `form:"when,omitempty" json:"when,omitempty"`
+}
+
+// GetGuardianChildrenPatientIdSessionsParamsWhen defines parameters for GetGuardianChildrenPatientIdSessions.
+type GetGuardianChildrenPatientIdSessionsParamsWhen string
+
+// GetPatientsParams defines parameters for GetPatients.
+type GetPatientsParams struct {
+	Page  *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPatientsPatientIdAdministrationsParams defines parameters for GetPatientsPatientIdAdministrations.
+type GetPatientsPatientIdAdministrationsParams struct {
+	// AssessmentId Only list administrations of this assessment.
+	AssessmentId *int `form:"assessment_id,omitempty" json:"assessment_id,omitempty"`
+}
+
+// GetPatientsPatientIdBehaviorIncidentsParams defines parameters for GetPatientsPatientIdBehaviorIncidents.
+type GetPatientsPatientIdBehaviorIncidentsParams struct {
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Included.
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// BehaviorType Matched ignoring case.
+	BehaviorType *string `form:"behavior_type,omitempty" json:"behavior_type,omitempty"`
+}
+
+// GetPatientsPatientIdBehaviorSummaryParams defines parameters for GetPatientsPatientIdBehaviorSummary.
+type GetPatientsPatientIdBehaviorSummaryParams struct {
+	// From Leave out to count from the first incident.
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Included. Leave out to count up to the latest incident.
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+
+	// BehaviorType Matched ignoring case.
+	BehaviorType *string `form:"behavior_type,omitempty" json:"behavior_type,omitempty"`
+}
+
+// GetPatientsPatientIdMedicationRecordParams defines parameters for GetPatientsPatientIdMedicationRecord.
+type GetPatientsPatientIdMedicationRecordParams struct {
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Included.
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetPatientsPatientIdOnboardingResponsesParams defines parameters for GetPatientsPatientIdOnboardingResponses.
+type GetPatientsPatientIdOnboardingResponsesParams struct {
+	// AssessmentId Only list responses to this assessment.
+	AssessmentId *int `form:"assessment_id,omitempty" json:"assessment_id,omitempty"`
+}
+
+// GetPatientsPatientIdProgressParams defines parameters for GetPatientsPatientIdProgress.
+type GetPatientsPatientIdProgressParams struct {
+	Period *GetPatientsPatientIdProgressParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// From Defaults to twelve weeks or months before to.
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Defaults to today.
+	To *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetPatientsPatientIdProgressParamsPeriod defines parameters for GetPatientsPatientIdProgress.
+type GetPatientsPatientIdProgressParamsPeriod string
+
+// GetPatientsPatientIdSessionEstimateParams defines parameters for GetPatientsPatientIdSessionEstimate.
+type GetPatientsPatientIdSessionEstimateParams struct {
+	Minutes int `form:"minutes" json:"minutes"`
+
+	// BranchId Defaults to the patient's primary branch.
+	BranchId *int `form:"branch_id,omitempty" json:"branch_id,omitempty"`
+
+	// Date Defaults to today.
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// GetPatientsPatientIdSessionsParams defines parameters for GetPatientsPatientIdSessions.
+type GetPatientsPatientIdSessionsParams struct {
+	Page      *int                `form:"page,omitempty" json:"page,omitempty"`
+	Limit     *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// GetRateCardsParams defines parameters for GetRateCards.
+type GetRateCardsParams struct {
+	TherapyType *GetRateCardsParamsTherapyType `form:"therapy_type,omitempty" json:"therapy_type,omitempty"`
+}
+
+// GetRateCardsParamsTherapyType defines parameters for GetRateCards.
+type GetRateCardsParamsTherapyType string
+
+// DeleteSessionSeriesIdSessionsSessionIdParams defines parameters for DeleteSessionSeriesIdSessionsSessionId.
+type DeleteSessionSeriesIdSessionsSessionIdParams struct {
+	// Scope Whether the change applies to this occurrence only or to it and all following ones.
+	Scope *DeleteSessionSeriesIdSessionsSessionIdParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// DeleteSessionSeriesIdSessionsSessionIdParamsScope defines parameters for DeleteSessionSeriesIdSessionsSessionId.
+type DeleteSessionSeriesIdSessionsSessionIdParamsScope string
+
+// PutSessionSeriesIdSessionsSessionIdParams defines parameters for PutSessionSeriesIdSessionsSessionId.
+type PutSessionSeriesIdSessionsSessionIdParams struct {
+	// Scope Whether the change applies to this occurrence only or to it and all following ones.
+	Scope *PutSessionSeriesIdSessionsSessionIdParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// PutSessionSeriesIdSessionsSessionIdParamsScope defines parameters for PutSessionSeriesIdSessionsSessionId.
+type PutSessionSeriesIdSessionsSessionIdParamsScope string
+
+// GetSessionsParams defines parameters for GetSessions.
+type GetSessionsParams struct {
+	Page      *int                `form:"page,omitempty" json:"page,omitempty"`
+	Limit     *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// GetStaffParams defines parameters for GetStaff.
+type GetStaffParams struct {
+	Page  *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetStaffIdPrescriptionRemindersParams defines parameters for GetStaffIdPrescriptionReminders.
+type GetStaffIdPrescriptionRemindersParams struct {
+	// Days How many days ahead to look, up to 90. Defaults to 14.
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// GetStaffIdSessionsParams defines parameters for GetStaffIdSessions.
+type GetStaffIdSessionsParams struct {
+	Page      *int                `form:"page,omitempty" json:"page,omitempty"`
+	Limit     *int                `form:"limit,omitempty" json:"limit,omitempty"`
+	StartDate *openapi_types.Date `form:"start_date,omitempty" json:"start_date,omitempty"`
+	EndDate   *openapi_types.Date `form:"end_date,omitempty" json:"end_date,omitempty"`
+}
+
+// GetStaffIdTimesheetParams defines parameters for GetStaffIdTimesheet.
+type GetStaffIdTimesheetParams struct {
+	Period *GetStaffIdTimesheetParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Date Any day of the period. Defaults to today.
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// Format csv exports one row per staff member for payroll.
+	Format *GetStaffIdTimesheetParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetStaffIdTimesheetParamsPeriod defines parameters for GetStaffIdTimesheet.
+type GetStaffIdTimesheetParamsPeriod string
+
+// GetStaffIdTimesheetParamsFormat defines parameters for GetStaffIdTimesheet.
+type GetStaffIdTimesheetParamsFormat string
+
+// GetStaffStaffIdSessionsSessionIdActivitiesParams defines parameters for GetStaffStaffIdSessionsSessionIdActivities.
+type GetStaffStaffIdSessionsSessionIdActivitiesParams struct {
+	Page  *int `form:"page,omitempty" json:"page,omitempty"`
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetTimesheetsParams defines parameters for GetTimesheets.
+type GetTimesheetsParams struct {
+	Period *GetTimesheetsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// Date Any day of the period. Defaults to today.
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// Format csv exports one row per staff member for payroll.
+	Format *GetTimesheetsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetTimesheetsParamsPeriod defines parameters for GetTimesheets.
+type GetTimesheetsParamsPeriod string
+
+// GetTimesheetsParamsFormat defines parameters for GetTimesheets.
+type GetTimesheetsParamsFormat string
+
+// PostActivitiesIdTrialsJSONRequestBody defines body for PostActivitiesIdTrials for application/json ContentType.
+type PostActivitiesIdTrialsJSONRequestBody = TrialBatch
+
+// PutAdministrationsIdJSONRequestBody defines body for PutAdministrationsId for application/json ContentType.
+type PutAdministrationsIdJSONRequestBody = AssessmentAdministration
+
+// PostAuthGuardianCodeJSONRequestBody defines body for PostAuthGuardianCode for application/json ContentType.
+type PostAuthGuardianCodeJSONRequestBody = GuardianCodeRequest
+
+// PostAuthGuardianVerifyJSONRequestBody defines body for PostAuthGuardianVerify for application/json ContentType.
+type PostAuthGuardianVerifyJSONRequestBody = GuardianVerifyRequest
+
+// PostAuthLoginJSONRequestBody defines body for PostAuthLogin for application/json ContentType.
+type PostAuthLoginJSONRequestBody = LoginRequest
+
+// PutBehaviorIncidentsIdJSONRequestBody defines body for PutBehaviorIncidentsId for application/json ContentType.
+type PutBehaviorIncidentsIdJSONRequestBody = BehaviorIncident
+
+// PutBehaviorMeasurementsIdJSONRequestBody defines body for PutBehaviorMeasurementsId for application/json ContentType.
+type PutBehaviorMeasurementsIdJSONRequestBody = BehaviorMeasurement
+
+// PostBranchesJSONRequestBody defines body for PostBranches for application/json ContentType.
+type PostBranchesJSONRequestBody = Branch
+
+// PutBranchesIdJSONRequestBody defines body for PutBranchesId for application/json ContentType.
+type PutBranchesIdJSONRequestBody = Branch
+
+// PostBranchesIdClosuresJSONRequestBody defines body for PostBranchesIdClosures for application/json ContentType.
+type PostBranchesIdClosuresJSONRequestBody = BranchClosure
+
+// PutBranchesIdHoursJSONRequestBody defines body for PutBranchesIdHours for application/json ContentType.
+type PutBranchesIdHoursJSONRequestBody = PutBranchesIdHoursJSONBody
+
+// PostClosuresIdCancelJSONRequestBody defines body for PostClosuresIdCancel for application/json ContentType.
+type PostClosuresIdCancelJSONRequestBody = CancelRequest
+
+// PostClosuresIdRescheduleJSONRequestBody defines body for PostClosuresIdReschedule for application/json ContentType.
+type PostClosuresIdRescheduleJSONRequestBody = RescheduleRequest
+
+// PutDataPointsIdJSONRequestBody defines body for PutDataPointsId for application/json ContentType.
+type PutDataPointsIdJSONRequestBody = MeasurementDataPoint
+
+// PostDiscountRulesJSONRequestBody defines body for PostDiscountRules for application/json ContentType.
+type PostDiscountRulesJSONRequestBody = DiscountRule
+
+// PutDiscountRulesIdJSONRequestBody defines body for PutDiscountRulesId for application/json ContentType.
+type PutDiscountRulesIdJSONRequestBody = DiscountRule
+
+// PostInvoicesIdPaymentsJSONRequestBody defines body for PostInvoicesIdPayments for application/json ContentType.
+type PostInvoicesIdPaymentsJSONRequestBody = PaymentRequest
+
+// PutMedicinesIdJSONRequestBody defines body for PutMedicinesId for application/json ContentType.
+type PutMedicinesIdJSONRequestBody = Medicine
+
+// PostMedicinesIdDiscontinueJSONRequestBody defines body for PostMedicinesIdDiscontinue for application/json ContentType.
+type PostMedicinesIdDiscontinueJSONRequestBody = DiscontinueRequest
+
+// PostMedicinesIdDosesJSONRequestBody defines body for PostMedicinesIdDoses for application/json ContentType.
+type PostMedicinesIdDosesJSONRequestBody = MedicationDose
+
+// PutOnboardingResponsesIdJSONRequestBody defines body for PutOnboardingResponsesId for application/json ContentType.
+type PutOnboardingResponsesIdJSONRequestBody = OnboardingAnswer
+
+// PostPatientsJSONRequestBody defines body for PostPatients for application/json ContentType.
+type PostPatientsJSONRequestBody = Patient
+
+// PutPatientsIdJSONRequestBody defines body for PutPatientsId for application/json ContentType.
+type PutPatientsIdJSONRequestBody = Patient
+
+// PostPatientsPatientIdAdministrationsJSONRequestBody defines body for PostPatientsPatientIdAdministrations for application/json ContentType.
+type PostPatientsPatientIdAdministrationsJSONRequestBody = AdministrationRequest
+
+// PostPatientsPatientIdBehaviorMeasurementsJSONRequestBody defines body for PostPatientsPatientIdBehaviorMeasurements for application/json ContentType.
+type PostPatientsPatientIdBehaviorMeasurementsJSONRequestBody = BehaviorMeasurement
+
+// PutPatientsPatientIdDiscountJSONRequestBody defines body for PutPatientsPatientIdDiscount for application/json ContentType.
+type PutPatientsPatientIdDiscountJSONRequestBody = PatientDiscountRequest
+
+// PostPatientsPatientIdInvoicesJSONRequestBody defines body for PostPatientsPatientIdInvoices for application/json ContentType.
+type PostPatientsPatientIdInvoicesJSONRequestBody = InvoiceGeneration
+
+// PostPatientsPatientIdMedicinesJSONRequestBody defines body for PostPatientsPatientIdMedicines for application/json ContentType.
+type PostPatientsPatientIdMedicinesJSONRequestBody = Medicine
+
+// PostPatientsPatientIdOnboardingResponsesJSONRequestBody defines body for PostPatientsPatientIdOnboardingResponses for application/json ContentType.
+type PostPatientsPatientIdOnboardingResponsesJSONRequestBody = OnboardingResponseRequest
+
+// PostPatientsPatientIdTreatmentPlansJSONRequestBody defines body for PostPatientsPatientIdTreatmentPlans for application/json ContentType.
+type PostPatientsPatientIdTreatmentPlansJSONRequestBody = TreatmentPlan
+
+// PostRateCardsJSONRequestBody defines body for PostRateCards for application/json ContentType.
+type PostRateCardsJSONRequestBody = RateCard
+
+// PostSessionSeriesJSONRequestBody defines body for PostSessionSeries for application/json ContentType.
+type PostSessionSeriesJSONRequestBody = SessionSeriesRequest
+
+// PutSessionSeriesIdSessionsSessionIdJSONRequestBody defines body for PutSessionSeriesIdSessionsSessionId for application/json ContentType.
+type PutSessionSeriesIdSessionsSessionIdJSONRequestBody = OccurrenceChanges
+
+// PostSessionsJSONRequestBody defines body for PostSessions for application/json ContentType.
+type PostSessionsJSONRequestBody = Session
+
+// PutSessionsIdJSONRequestBody defines body for PutSessionsId for application/json ContentType.
+type PutSessionsIdJSONRequestBody = Session
+
+// PostSessionsIdBehaviorIncidentsJSONRequestBody defines body for PostSessionsIdBehaviorIncidents for application/json ContentType.
+type PostSessionsIdBehaviorIncidentsJSONRequestBody = BehaviorIncident
+
+// PostSessionsIdDataPointsJSONRequestBody defines body for PostSessionsIdDataPoints for application/json ContentType.
+type PostSessionsIdDataPointsJSONRequestBody = MeasurementDataPoint
+
+// PostStaffJSONRequestBody defines body for PostStaff for application/json ContentType.
+type PostStaffJSONRequestBody = Staff
+
+// PutStaffIdJSONRequestBody defines body for PutStaffId for application/json ContentType.
+type PutStaffIdJSONRequestBody = Staff
+
+// PostStaffStaffIdSessionsSessionIdActivitiesJSONRequestBody defines body for PostStaffStaffIdSessionsSessionIdActivities for application/json ContentType.
+type PostStaffStaffIdSessionsSessionIdActivitiesJSONRequestBody = Activity
+
+// PutStaffStaffIdSessionsSessionIdActivitiesIdJSONRequestBody defines body for PutStaffStaffIdSessionsSessionIdActivitiesId for application/json ContentType.
+type PutStaffStaffIdSessionsSessionIdActivitiesIdJSONRequestBody = Activity
+
+// PutTreatmentGoalsIdJSONRequestBody defines body for PutTreatmentGoalsId for application/json ContentType.
+type PutTreatmentGoalsIdJSONRequestBody = TreatmentGoal
+
+// PostTreatmentGoalsIdTargetsJSONRequestBody defines body for PostTreatmentGoalsIdTargets for application/json ContentType.
+type PostTreatmentGoalsIdTargetsJSONRequestBody = TreatmentTarget
+
+// PutTreatmentPlansIdJSONRequestBody defines body for PutTreatmentPlansId for application/json ContentType.
+type PutTreatmentPlansIdJSONRequestBody = TreatmentPlan
+
+// PostTreatmentPlansIdGoalsJSONRequestBody defines body for PostTreatmentPlansIdGoals for application/json ContentType.
+type PostTreatmentPlansIdGoalsJSONRequestBody = TreatmentGoal
+
+// PutTreatmentTargetsIdJSONRequestBody defines body for PutTreatmentTargetsId for application/json ContentType.
+type PutTreatmentTargetsIdJSONRequestBody = TreatmentTarget
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List an activity's trials in the order they were run
+	// (GET /activities/{id}/trials)
+	GetActivitiesIdTrials(c *fiber.Ctx, id string) error
+	// Record discrete trials of an activity
+	// (POST /activities/{id}/trials)
+	PostActivitiesIdTrials(c *fiber.Ctx, id string) error
+	// Get an assessment administration by ID
+	// (GET /administrations/{id})
+	GetAdministrationsId(c *fiber.Ctx, id int) error
+	// Update or complete an assessment administration
+	// (PUT /administrations/{id})
+	PutAdministrationsId(c *fiber.Ctx, id int) error
+	// Compare an administration with an earlier one
+	// (GET /administrations/{id}/comparison)
+	GetAdministrationsIdComparison(c *fiber.Ctx, id int, params GetAdministrationsIdComparisonParams) error
+	// List the responses recorded during an administration
+	// (GET /administrations/{id}/responses)
+	GetAdministrationsIdResponses(c *fiber.Ctx, id int) error
+	// Score an administration
+	// (GET /administrations/{id}/scores)
+	GetAdministrationsIdScores(c *fiber.Ctx, id int) error
+	// List assessments
+	// (GET /assessments)
+	GetAssessments(c *fiber.Ctx) error
+	// List the active questions of an assessment in order
+	// (GET /assessments/{id}/questions)
+	GetAssessmentsIdQuestions(c *fiber.Ctx, id int) error
+	// Query the audit log
+	// (GET /audit-logs)
+	GetAuditLogs(c *fiber.Ctx, params GetAuditLogsParams) error
+	// Send a guardian a one-time sign-in code
+	// (POST /auth/guardian/code)
+	PostAuthGuardianCode(c *fiber.Ctx) error
+	// Sign in as a guardian with a one-time code
+	// (POST /auth/guardian/verify)
+	PostAuthGuardianVerify(c *fiber.Ctx) error
+	// Sign in as a staff member
+	// (POST /auth/login)
+	PostAuthLogin(c *fiber.Ctx) error
+	// Delete a behavior incident recorded by mistake
+	// (DELETE /behavior-incidents/{id})
+	DeleteBehaviorIncidentsId(c *fiber.Ctx, id int) error
+	// Get a behavior incident
+	// (GET /behavior-incidents/{id})
+	GetBehaviorIncidentsId(c *fiber.Ctx, id int) error
+	// Update a behavior incident
+	// (PUT /behavior-incidents/{id})
+	PutBehaviorIncidentsId(c *fiber.Ctx, id int) error
+	// Delete a behavior measurement no session has recorded
+	// (DELETE /behavior-measurements/{id})
+	DeleteBehaviorMeasurementsId(c *fiber.Ctx, id int) error
+	// Get a behavior measurement
+	// (GET /behavior-measurements/{id})
+	GetBehaviorMeasurementsId(c *fiber.Ctx, id int) error
+	// Update a behavior measurement
+	// (PUT /behavior-measurements/{id})
+	PutBehaviorMeasurementsId(c *fiber.Ctx, id int) error
+	// Graph a behavior measurement across sessions
+	// (GET /behavior-measurements/{id}/data-points)
+	GetBehaviorMeasurementsIdDataPoints(c *fiber.Ctx, id int, params GetBehaviorMeasurementsIdDataPointsParams) error
+	// List all branches
+	// (GET /branches)
+	GetBranches(c *fiber.Ctx) error
+	// Create a new branch
+	// (POST /branches)
+	PostBranches(c *fiber.Ctx) error
+	// Delete a branch
+	// (DELETE /branches/{id})
+	DeleteBranchesId(c *fiber.Ctx, id int) error
+	// Get branch by ID
+	// (GET /branches/{id})
+	GetBranchesId(c *fiber.Ctx, id int) error
+	// Update branch information
+	// (PUT /branches/{id})
+	PutBranchesId(c *fiber.Ctx, id int) error
+	// List a branch's closures
+	// (GET /branches/{id}/closures)
+	GetBranchesIdClosures(c *fiber.Ctx, id int, params GetBranchesIdClosuresParams) error
+	// Close a branch for one or more days
+	// (POST /branches/{id}/closures)
+	PostBranchesIdClosures(c *fiber.Ctx, id int) error
+	// Close a branch on the public holidays of a year
+	// (POST /branches/{id}/closures/holidays)
+	PostBranchesIdClosuresHolidays(c *fiber.Ctx, id int, params PostBranchesIdClosuresHolidaysParams) error
+	// List the scheduled doses still to be given at a branch today
+	// (GET /branches/{id}/doses-due)
+	GetBranchesIdDosesDue(c *fiber.Ctx, id int) error
+	// Get a branch's operating hours
+	// (GET /branches/{id}/hours)
+	GetBranchesIdHours(c *fiber.Ctx, id int) error
+	// Replace a branch's weekly operating hours
+	// (PUT /branches/{id}/hours)
+	PutBranchesIdHours(c *fiber.Ctx, id int) error
+	// List a branch's tax invoices for a financial year
+	// (GET /branches/{id}/tax-invoices)
+	GetBranchesIdTaxInvoices(c *fiber.Ctx, id int, params GetBranchesIdTaxInvoicesParams) error
+	// Revoke a calendar feed
+	// (DELETE /calendar-feeds/{id})
+	DeleteCalendarFeedsId(c *fiber.Ctx, id int) error
+	// Subscribe to a patient's sessions
+	// (GET /calendar/patients/{patient_id})
+	GetCalendarPatientsPatientId(c *fiber.Ctx, patientId string, params GetCalendarPatientsPatientIdParams) error
+	// Subscribe to a staff member's sessions
+	// (GET /calendar/staff/{id})
+	GetCalendarStaffId(c *fiber.Ctx, id string, params GetCalendarStaffIdParams) error
+	// Delete a branch closure
+	// (DELETE /closures/{id})
+	DeleteClosuresId(c *fiber.Ctx, id int) error
+	// Cancel sessions flagged by a closure
+	// (POST /closures/{id}/cancel)
+	PostClosuresIdCancel(c *fiber.Ctx, id int) error
+	// Move sessions off a closure
+	// (POST /closures/{id}/reschedule)
+	PostClosuresIdReschedule(c *fiber.Ctx, id int) error
+	// List the sessions a closure flagged
+	// (GET /closures/{id}/sessions)
+	GetClosuresIdSessions(c *fiber.Ctx, id int) error
+	// Delete a behavior measurement data point recorded by mistake
+	// (DELETE /data-points/{id})
+	DeleteDataPointsId(c *fiber.Ctx, id int) error
+	// Update a behavior measurement data point
+	// (PUT /data-points/{id})
+	PutDataPointsId(c *fiber.Ctx, id int) error
+	// List the discount rules
+	// (GET /discount-rules)
+	GetDiscountRules(c *fiber.Ctx) error
+	// Add a discount rule
+	// (POST /discount-rules)
+	PostDiscountRules(c *fiber.Ctx) error
+	// Update a discount rule
+	// (PUT /discount-rules/{id})
+	PutDiscountRulesId(c *fiber.Ctx, id int) error
+	// List the signed-in guardian's children
+	// (GET /guardian/children)
+	GetGuardianChildren(c *fiber.Ctx) error
+	// List a child's prescribed medicines
+	// (GET /guardian/children/{patient_id}/medicines)
+	GetGuardianChildrenPatientIdMedicines(c *fiber.Ctx, patientId string) error
+	// Get a summary of a child's progress
+	// (GET /guardian/children/{patient_id}/progress)
+	GetGuardianChildrenPatientIdProgress(c *fiber.Ctx, patientId string) error
+	// List a child's upcoming or past sessions
+	// (GET /guardian/children/{patient_id}/sessions)
+	GetGuardianChildrenPatientIdSessions(c *fiber.Ctx, patientId string, params GetGuardianChildrenPatientIdSessionsParams) error
+	// Get what a guardian owes
+	// (GET /guardians/{id}/balance)
+	GetGuardiansIdBalance(c *fiber.Ctx, id string) error
+	// Get an invoice with its lines and payments
+	// (GET /invoices/{id})
+	GetInvoicesId(c *fiber.Ctx, id int) error
+	// Record a payment against an invoice
+	// (POST /invoices/{id}/payments)
+	PostInvoicesIdPayments(c *fiber.Ctx, id int) error
+	// Download the tax invoice of an invoice as it was issued
+	// (GET /invoices/{id}/tax-invoice)
+	GetInvoicesIdTaxInvoice(c *fiber.Ctx, id int) error
+	// Issue the tax invoice of a paid invoice
+	// (POST /invoices/{id}/tax-invoice)
+	PostInvoicesIdTaxInvoice(c *fiber.Ctx, id int) error
+	// Reprint the tax invoice of an invoice
+	// (POST /invoices/{id}/tax-invoice/reprint)
+	PostInvoicesIdTaxInvoiceReprint(c *fiber.Ctx, id int) error
+	// Void an invoice
+	// (POST /invoices/{id}/void)
+	PostInvoicesIdVoid(c *fiber.Ctx, id int) error
+	// Remove a prescribed medicine
+	// (DELETE /medicines/{id})
+	DeleteMedicinesId(c *fiber.Ctx, id string) error
+	// Get medicine by ID
+	// (GET /medicines/{id})
+	GetMedicinesId(c *fiber.Ctx, id string) error
+	// Update a prescribed medicine
+	// (PUT /medicines/{id})
+	PutMedicinesId(c *fiber.Ctx, id string) error
+	// Discontinue a medicine
+	// (POST /medicines/{id}/discontinue)
+	PostMedicinesIdDiscontinue(c *fiber.Ctx, id string) error
+	// Record a dose of a medicine as given or refused
+	// (POST /medicines/{id}/doses)
+	PostMedicinesIdDoses(c *fiber.Ctx, id string) error
+	// Answer or correct an onboarding response
+	// (PUT /onboarding-responses/{id})
+	PutOnboardingResponsesId(c *fiber.Ctx, id int) error
 	// List all patients
 	// (GET /patients)
 	GetPatients(c *fiber.Ctx, params GetPatientsParams) error
@@ -245,19 +2128,118 @@ type ServerInterface interface {
 	PostPatients(c *fiber.Ctx) error
 	// Delete a patient
 	// (DELETE /patients/{id})
-	DeletePatientsId(c *fiber.Ctx, id float32) error
+	DeletePatientsId(c *fiber.Ctx, id string) error
 	// Get patient by ID
 	// (GET /patients/{id})
-	GetPatientsId(c *fiber.Ctx, id float32) error
+	GetPatientsId(c *fiber.Ctx, id string) error
 	// Update patient information
 	// (PUT /patients/{id})
-	PutPatientsId(c *fiber.Ctx, id float32) error
+	PutPatientsId(c *fiber.Ctx, id string) error
+	// List a patient's assessment administrations, newest first
+	// (GET /patients/{patient_id}/administrations)
+	GetPatientsPatientIdAdministrations(c *fiber.Ctx, patientId string, params GetPatientsPatientIdAdministrationsParams) error
+	// Start administering an assessment to a patient
+	// (POST /patients/{patient_id}/administrations)
+	PostPatientsPatientIdAdministrations(c *fiber.Ctx, patientId string) error
+	// Score a patient's most recent administration of an assessment
+	// (GET /patients/{patient_id}/assessments/{assessment_id}/scores)
+	GetPatientsPatientIdAssessmentsAssessmentIdScores(c *fiber.Ctx, patientId string, assessmentId int) error
+	// List a patient's behavior incidents, earliest first
+	// (GET /patients/{patient_id}/behavior-incidents)
+	GetPatientsPatientIdBehaviorIncidents(c *fiber.Ctx, patientId string, params GetPatientsPatientIdBehaviorIncidentsParams) error
+	// List a patient's behavior measurements, active ones first
+	// (GET /patients/{patient_id}/behavior-measurements)
+	GetPatientsPatientIdBehaviorMeasurements(c *fiber.Ctx, patientId string) error
+	// Define how one of a patient's behaviors is measured
+	// (POST /patients/{patient_id}/behavior-measurements)
+	PostPatientsPatientIdBehaviorMeasurements(c *fiber.Ctx, patientId string) error
+	// Count a patient's behavior incidents by time of day, antecedent and function
+	// (GET /patients/{patient_id}/behavior-summary)
+	GetPatientsPatientIdBehaviorSummary(c *fiber.Ctx, patientId string, params GetPatientsPatientIdBehaviorSummaryParams) error
+	// List a patient's calendar feeds
+	// (GET /patients/{patient_id}/calendar-feeds)
+	GetPatientsPatientIdCalendarFeeds(c *fiber.Ctx, patientId string) error
+	// Create a calendar feed of a patient's sessions
+	// (POST /patients/{patient_id}/calendar-feeds)
+	PostPatientsPatientIdCalendarFeeds(c *fiber.Ctx, patientId string) error
+	// Take a patient's hardship discount away
+	// (DELETE /patients/{patient_id}/discount)
+	DeletePatientsPatientIdDiscount(c *fiber.Ctx, patientId string) error
+	// Give a patient a hardship discount
+	// (PUT /patients/{patient_id}/discount)
+	PutPatientsPatientIdDiscount(c *fiber.Ctx, patientId string) error
+	// List a patient's invoices
+	// (GET /patients/{patient_id}/invoices)
+	GetPatientsPatientIdInvoices(c *fiber.Ctx, patientId string) error
+	// Invoice a patient's completed sessions
+	// (POST /patients/{patient_id}/invoices)
+	PostPatientsPatientIdInvoices(c *fiber.Ctx, patientId string) error
+	// List the doses given to or refused by a patient, earliest first
+	// (GET /patients/{patient_id}/medication-record)
+	GetPatientsPatientIdMedicationRecord(c *fiber.Ctx, patientId string, params GetPatientsPatientIdMedicationRecordParams) error
+	// List the prescription events of a patient's medicines
+	// (GET /patients/{patient_id}/medication-timeline)
+	GetPatientsPatientIdMedicationTimeline(c *fiber.Ctx, patientId string) error
+	// List the medicines prescribed to a patient
+	// (GET /patients/{patient_id}/medicines)
+	GetPatientsPatientIdMedicines(c *fiber.Ctx, patientId string) error
+	// Prescribe a medicine to a patient
+	// (POST /patients/{patient_id}/medicines)
+	PostPatientsPatientIdMedicines(c *fiber.Ctx, patientId string) error
+	// List a patient's onboarding responses
+	// (GET /patients/{patient_id}/onboarding-responses)
+	GetPatientsPatientIdOnboardingResponses(c *fiber.Ctx, patientId string, params GetPatientsPatientIdOnboardingResponsesParams) error
+	// Record a patient's answer to an assessment question
+	// (POST /patients/{patient_id}/onboarding-responses)
+	PostPatientsPatientIdOnboardingResponses(c *fiber.Ctx, patientId string) error
+	// Get how a patient's session and activity responses changed over time
+	// (GET /patients/{patient_id}/progress)
+	GetPatientsPatientIdProgress(c *fiber.Ctx, patientId string, params GetPatientsPatientIdProgressParams) error
+	// Estimate what a session would cost a patient
+	// (GET /patients/{patient_id}/session-estimate)
+	GetPatientsPatientIdSessionEstimate(c *fiber.Ctx, patientId string, params GetPatientsPatientIdSessionEstimateParams) error
+	// List a patient's recurring session series
+	// (GET /patients/{patient_id}/session-series)
+	GetPatientsPatientIdSessionSeries(c *fiber.Ctx, patientId string) error
 	// Get all sessions for a patient
 	// (GET /patients/{patient_id}/sessions)
-	GetPatientsPatientIdSessions(c *fiber.Ctx, patientId float32, params GetPatientsPatientIdSessionsParams) error
+	GetPatientsPatientIdSessions(c *fiber.Ctx, patientId string, params GetPatientsPatientIdSessionsParams) error
 	// Get specific session for a patient
 	// (GET /patients/{patient_id}/sessions/{session_id})
-	GetPatientsPatientIdSessionsSessionId(c *fiber.Ctx, patientId float32, sessionId float32) error
+	GetPatientsPatientIdSessionsSessionId(c *fiber.Ctx, patientId string, sessionId string) error
+	// List a patient's treatment plans with their goals and targets
+	// (GET /patients/{patient_id}/treatment-plans)
+	GetPatientsPatientIdTreatmentPlans(c *fiber.Ctx, patientId string) error
+	// Create a treatment plan for a patient, with its goals and targets
+	// (POST /patients/{patient_id}/treatment-plans)
+	PostPatientsPatientIdTreatmentPlans(c *fiber.Ctx, patientId string) error
+	// List the rate cards
+	// (GET /rate-cards)
+	GetRateCards(c *fiber.Ctx, params GetRateCardsParams) error
+	// Add a rate card
+	// (POST /rate-cards)
+	PostRateCards(c *fiber.Ctx) error
+	// Delete a rate card
+	// (DELETE /rate-cards/{id})
+	DeleteRateCardsId(c *fiber.Ctx, id int) error
+	// Get outstanding balances by guardian and days overdue
+	// (GET /receivables/aging)
+	GetReceivablesAging(c *fiber.Ctx) error
+	// Create a recurring session series
+	// (POST /session-series)
+	PostSessionSeries(c *fiber.Ctx) error
+	// Get a session series by ID
+	// (GET /session-series/{id})
+	GetSessionSeriesId(c *fiber.Ctx, id string) error
+	// List the sessions generated from a series
+	// (GET /session-series/{id}/sessions)
+	GetSessionSeriesIdSessions(c *fiber.Ctx, id string) error
+	// Cancel an upcoming occurrence of a series, or it and all following ones
+	// (DELETE /session-series/{id}/sessions/{session_id})
+	DeleteSessionSeriesIdSessionsSessionId(c *fiber.Ctx, id string, sessionId string, params DeleteSessionSeriesIdSessionsSessionIdParams) error
+	// Edit an upcoming occurrence of a series, or it and all following ones
+	// (PUT /session-series/{id}/sessions/{session_id})
+	PutSessionSeriesIdSessionsSessionId(c *fiber.Ctx, id string, sessionId string, params PutSessionSeriesIdSessionsSessionIdParams) error
 	// List all sessions
 	// (GET /sessions)
 	GetSessions(c *fiber.Ctx, params GetSessionsParams) error
@@ -266,16 +2248,25 @@ type ServerInterface interface {
 	PostSessions(c *fiber.Ctx) error
 	// Delete a session
 	// (DELETE /sessions/{id})
-	DeleteSessionsId(c *fiber.Ctx, id float32) error
+	DeleteSessionsId(c *fiber.Ctx, id string) error
 	// Get session by ID
 	// (GET /sessions/{id})
-	GetSessionsId(c *fiber.Ctx, id float32) error
+	GetSessionsId(c *fiber.Ctx, id string) error
 	// Update session information
 	// (PUT /sessions/{id})
-	PutSessionsId(c *fiber.Ctx, id float32) error
+	PutSessionsId(c *fiber.Ctx, id string) error
+	// Record a behavior incident during a session
+	// (POST /sessions/{id}/behavior-incidents)
+	PostSessionsIdBehaviorIncidents(c *fiber.Ctx, id string) error
+	// List the behavior measurement data points a session recorded
+	// (GET /sessions/{id}/data-points)
+	GetSessionsIdDataPoints(c *fiber.Ctx, id string) error
+	// Record a session's data point for one of its patient's active behavior measurements
+	// (POST /sessions/{id}/data-points)
+	PostSessionsIdDataPoints(c *fiber.Ctx, id string) error
 	// Get detailed session information
 	// (GET /sessions/{id}/details)
-	GetSessionsIdDetails(c *fiber.Ctx, id float32) error
+	GetSessionsIdDetails(c *fiber.Ctx, id string) error
 	// List all staff members.
 	// (GET /staff)
 	GetStaff(c *fiber.Ctx, params GetStaffParams) error
@@ -284,49 +2275,2480 @@ type ServerInterface interface {
 	PostStaff(c *fiber.Ctx) error
 	// Delete a staff member from application.
 	// (DELETE /staff/{id})
-	DeleteStaffId(c *fiber.Ctx, id float32) error
+	DeleteStaffId(c *fiber.Ctx, id string) error
 	// Get staff by ID
 	// (GET /staff/{id})
-	GetStaffId(c *fiber.Ctx, id float32) error
+	GetStaffId(c *fiber.Ctx, id string) error
 	// Update staff information
 	// (PUT /staff/{id})
-	PutStaffId(c *fiber.Ctx, id float32) error
+	PutStaffId(c *fiber.Ctx, id string) error
+	// List a staff member's calendar feeds
+	// (GET /staff/{id}/calendar-feeds)
+	GetStaffIdCalendarFeeds(c *fiber.Ctx, id string) error
+	// Create a calendar feed of a staff member's sessions
+	// (POST /staff/{id}/calendar-feeds)
+	PostStaffIdCalendarFeeds(c *fiber.Ctx, id string) error
+	// List the refills and renewals due for a doctor's prescriptions
+	// (GET /staff/{id}/prescription-reminders)
+	GetStaffIdPrescriptionReminders(c *fiber.Ctx, id string, params GetStaffIdPrescriptionRemindersParams) error
 	// Get all sessions for a staff member
 	// (GET /staff/{id}/sessions)
-	GetStaffIdSessions(c *fiber.Ctx, id float32, params GetStaffIdSessionsParams) error
+	GetStaffIdSessions(c *fiber.Ctx, id string, params GetStaffIdSessionsParams) error
+	// Get a staff member's timesheet
+	// (GET /staff/{id}/timesheet)
+	GetStaffIdTimesheet(c *fiber.Ctx, id string, params GetStaffIdTimesheetParams) error
 	// List all activities in a session
 	// (GET /staff/{staff_id}/sessions/{session_id}/activities)
-	GetStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId float32, sessionId float32, params GetStaffStaffIdSessionsSessionIdActivitiesParams) error
+	GetStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId string, sessionId string, params GetStaffStaffIdSessionsSessionIdActivitiesParams) error
 	// Create a new activity
 	// (POST /staff/{staff_id}/sessions/{session_id}/activities)
-	PostStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId float32, sessionId float32) error
+	PostStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId string, sessionId string) error
 	// Delete an activity
 	// (DELETE /staff/{staff_id}/sessions/{session_id}/activities/{id})
-	DeleteStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId float32, sessionId float32, id float32) error
+	DeleteStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId string, sessionId string, id string) error
 	// Get specific activity
 	// (GET /staff/{staff_id}/sessions/{session_id}/activities/{id})
-	GetStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId float32, sessionId float32, id float32) error
+	GetStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId string, sessionId string, id string) error
 	// Update activity information
 	// (PUT /staff/{staff_id}/sessions/{session_id}/activities/{id})
-	PutStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId float32, sessionId float32, id float32) error
+	PutStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId string, sessionId string, id string) error
+	// Get every staff member's timesheet
+	// (GET /timesheets)
+	GetTimesheets(c *fiber.Ctx, params GetTimesheetsParams) error
+	// Delete a treatment plan goal with its targets
+	// (DELETE /treatment-goals/{id})
+	DeleteTreatmentGoalsId(c *fiber.Ctx, id int) error
+	// Update a treatment plan goal
+	// (PUT /treatment-goals/{id})
+	PutTreatmentGoalsId(c *fiber.Ctx, id int) error
+	// Add a target to a treatment plan goal
+	// (POST /treatment-goals/{id}/targets)
+	PostTreatmentGoalsIdTargets(c *fiber.Ctx, id int) error
+	// Delete a draft treatment plan
+	// (DELETE /treatment-plans/{id})
+	DeleteTreatmentPlansId(c *fiber.Ctx, id int) error
+	// Get a treatment plan with its goals and targets
+	// (GET /treatment-plans/{id})
+	GetTreatmentPlansId(c *fiber.Ctx, id int) error
+	// Update a treatment plan
+	// (PUT /treatment-plans/{id})
+	PutTreatmentPlansId(c *fiber.Ctx, id int) error
+	// Add a goal to a treatment plan, with its targets
+	// (POST /treatment-plans/{id}/goals)
+	PostTreatmentPlansIdGoals(c *fiber.Ctx, id int) error
+	// Delete a treatment plan target
+	// (DELETE /treatment-targets/{id})
+	DeleteTreatmentTargetsId(c *fiber.Ctx, id int) error
+	// Update a treatment plan target
+	// (PUT /treatment-targets/{id})
+	PutTreatmentTargetsId(c *fiber.Ctx, id int) error
+	// Get how a target scored in each session it was practised
+	// (GET /treatment-targets/{id}/sessions)
+	GetTreatmentTargetsIdSessions(c *fiber.Ctx, id int) error
+	// Delete a trial recorded by mistake
+	// (DELETE /trials/{id})
+	DeleteTrialsId(c *fiber.Ctx, id int) error
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+type MiddlewareFunc fiber.Handler
+
+// GetActivitiesIdTrials operation middleware
+func (siw *ServerInterfaceWrapper) GetActivitiesIdTrials(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetActivitiesIdTrials(c, id)
+}
+
+// PostActivitiesIdTrials operation middleware
+func (siw *ServerInterfaceWrapper) PostActivitiesIdTrials(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostActivitiesIdTrials(c, id)
+}
+
+// GetAdministrationsId operation middleware
+func (siw *ServerInterfaceWrapper) GetAdministrationsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAdministrationsId(c, id)
+}
+
+// PutAdministrationsId operation middleware
+func (siw *ServerInterfaceWrapper) PutAdministrationsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutAdministrationsId(c, id)
+}
+
+// GetAdministrationsIdComparison operation middleware
+func (siw *ServerInterfaceWrapper) GetAdministrationsIdComparison(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdministrationsIdComparisonParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "baseline_id" -------------
+
+	if paramValue := c.Query("baseline_id"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument baseline_id is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "baseline_id", query, &params.BaselineId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter baseline_id: %w", err).Error())
+	}
+
+	return siw.Handler.GetAdministrationsIdComparison(c, id, params)
+}
+
+// GetAdministrationsIdResponses operation middleware
+func (siw *ServerInterfaceWrapper) GetAdministrationsIdResponses(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAdministrationsIdResponses(c, id)
+}
+
+// GetAdministrationsIdScores operation middleware
+func (siw *ServerInterfaceWrapper) GetAdministrationsIdScores(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAdministrationsIdScores(c, id)
+}
+
+// GetAssessments operation middleware
+func (siw *ServerInterfaceWrapper) GetAssessments(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAssessments(c)
+}
+
+// GetAssessmentsIdQuestions operation middleware
+func (siw *ServerInterfaceWrapper) GetAssessmentsIdQuestions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetAssessmentsIdQuestions(c, id)
+}
+
+// GetAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditLogs(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditLogsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "patient_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "patient_id", query, &params.PatientId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "staff_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "staff_id", query, &params.StaffId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetAuditLogs(c, params)
+}
+
+// PostAuthGuardianCode operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthGuardianCode(c *fiber.Ctx) error {
+
+	return siw.Handler.PostAuthGuardianCode(c)
+}
+
+// PostAuthGuardianVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthGuardianVerify(c *fiber.Ctx) error {
+
+	return siw.Handler.PostAuthGuardianVerify(c)
+}
+
+// PostAuthLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthLogin(c *fiber.Ctx) error {
+
+	return siw.Handler.PostAuthLogin(c)
+}
+
+// DeleteBehaviorIncidentsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBehaviorIncidentsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteBehaviorIncidentsId(c, id)
+}
+
+// GetBehaviorIncidentsId operation middleware
+func (siw *ServerInterfaceWrapper) GetBehaviorIncidentsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBehaviorIncidentsId(c, id)
+}
+
+// PutBehaviorIncidentsId operation middleware
+func (siw *ServerInterfaceWrapper) PutBehaviorIncidentsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutBehaviorIncidentsId(c, id)
+}
+
+// DeleteBehaviorMeasurementsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBehaviorMeasurementsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteBehaviorMeasurementsId(c, id)
+}
+
+// GetBehaviorMeasurementsId operation middleware
+func (siw *ServerInterfaceWrapper) GetBehaviorMeasurementsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBehaviorMeasurementsId(c, id)
+}
+
+// PutBehaviorMeasurementsId operation middleware
+func (siw *ServerInterfaceWrapper) PutBehaviorMeasurementsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutBehaviorMeasurementsId(c, id)
+}
+
+// GetBehaviorMeasurementsIdDataPoints operation middleware
+func (siw *ServerInterfaceWrapper) GetBehaviorMeasurementsIdDataPoints(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBehaviorMeasurementsIdDataPointsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	return siw.Handler.GetBehaviorMeasurementsIdDataPoints(c, id, params)
+}
+
+// GetBranches operation middleware
+func (siw *ServerInterfaceWrapper) GetBranches(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBranches(c)
+}
+
+// PostBranches operation middleware
+func (siw *ServerInterfaceWrapper) PostBranches(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostBranches(c)
+}
+
+// DeleteBranchesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBranchesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteBranchesId(c, id)
+}
+
+// GetBranchesId operation middleware
+func (siw *ServerInterfaceWrapper) GetBranchesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBranchesId(c, id)
+}
+
+// PutBranchesId operation middleware
+func (siw *ServerInterfaceWrapper) PutBranchesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutBranchesId(c, id)
+}
+
+// GetBranchesIdClosures operation middleware
+func (siw *ServerInterfaceWrapper) GetBranchesIdClosures(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBranchesIdClosuresParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	return siw.Handler.GetBranchesIdClosures(c, id, params)
+}
+
+// PostBranchesIdClosures operation middleware
+func (siw *ServerInterfaceWrapper) PostBranchesIdClosures(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostBranchesIdClosures(c, id)
+}
+
+// PostBranchesIdClosuresHolidays operation middleware
+func (siw *ServerInterfaceWrapper) PostBranchesIdClosuresHolidays(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostBranchesIdClosuresHolidaysParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "year" -------------
+
+	if paramValue := c.Query("year"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument year is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "year", query, &params.Year)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter year: %w", err).Error())
+	}
+
+	return siw.Handler.PostBranchesIdClosuresHolidays(c, id, params)
+}
+
+// GetBranchesIdDosesDue operation middleware
+func (siw *ServerInterfaceWrapper) GetBranchesIdDosesDue(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBranchesIdDosesDue(c, id)
+}
+
+// GetBranchesIdHours operation middleware
+func (siw *ServerInterfaceWrapper) GetBranchesIdHours(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetBranchesIdHours(c, id)
+}
+
+// PutBranchesIdHours operation middleware
+func (siw *ServerInterfaceWrapper) PutBranchesIdHours(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutBranchesIdHours(c, id)
+}
+
+// GetBranchesIdTaxInvoices operation middleware
+func (siw *ServerInterfaceWrapper) GetBranchesIdTaxInvoices(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBranchesIdTaxInvoicesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "financial_year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "financial_year", query, &params.FinancialYear)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter financial_year: %w", err).Error())
+	}
+
+	return siw.Handler.GetBranchesIdTaxInvoices(c, id, params)
+}
+
+// DeleteCalendarFeedsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCalendarFeedsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteCalendarFeedsId(c, id)
+}
+
+// GetCalendarPatientsPatientId operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarPatientsPatientId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarPatientsPatientIdParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := c.Query("token"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument token is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", query, &params.Token)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter token: %w", err).Error())
+	}
+
+	return siw.Handler.GetCalendarPatientsPatientId(c, patientId, params)
+}
+
+// GetCalendarStaffId operation middleware
+func (siw *ServerInterfaceWrapper) GetCalendarStaffId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCalendarStaffIdParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := c.Query("token"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument token is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", query, &params.Token)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter token: %w", err).Error())
+	}
+
+	return siw.Handler.GetCalendarStaffId(c, id, params)
+}
+
+// DeleteClosuresId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteClosuresId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteClosuresId(c, id)
+}
+
+// PostClosuresIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostClosuresIdCancel(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostClosuresIdCancel(c, id)
+}
+
+// PostClosuresIdReschedule operation middleware
+func (siw *ServerInterfaceWrapper) PostClosuresIdReschedule(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostClosuresIdReschedule(c, id)
+}
+
+// GetClosuresIdSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetClosuresIdSessions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetClosuresIdSessions(c, id)
+}
+
+// DeleteDataPointsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteDataPointsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteDataPointsId(c, id)
+}
+
+// PutDataPointsId operation middleware
+func (siw *ServerInterfaceWrapper) PutDataPointsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutDataPointsId(c, id)
+}
+
+// GetDiscountRules operation middleware
+func (siw *ServerInterfaceWrapper) GetDiscountRules(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetDiscountRules(c)
+}
+
+// PostDiscountRules operation middleware
+func (siw *ServerInterfaceWrapper) PostDiscountRules(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostDiscountRules(c)
+}
+
+// PutDiscountRulesId operation middleware
+func (siw *ServerInterfaceWrapper) PutDiscountRulesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutDiscountRulesId(c, id)
+}
+
+// GetGuardianChildren operation middleware
+func (siw *ServerInterfaceWrapper) GetGuardianChildren(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetGuardianChildren(c)
+}
+
+// GetGuardianChildrenPatientIdMedicines operation middleware
+func (siw *ServerInterfaceWrapper) GetGuardianChildrenPatientIdMedicines(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetGuardianChildrenPatientIdMedicines(c, patientId)
+}
+
+// GetGuardianChildrenPatientIdProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetGuardianChildrenPatientIdProgress(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetGuardianChildrenPatientIdProgress(c, patientId)
+}
+
+// GetGuardianChildrenPatientIdSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetGuardianChildrenPatientIdSessions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetGuardianChildrenPatientIdSessionsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "when" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "when", query, &params.When)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter when: %w", err).Error())
+	}
+
+	return siw.Handler.GetGuardianChildrenPatientIdSessions(c, patientId, params)
+}
+
+// GetGuardiansIdBalance operation middleware
+func (siw *ServerInterfaceWrapper) GetGuardiansIdBalance(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetGuardiansIdBalance(c, id)
+}
+
+// GetInvoicesId operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoicesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetInvoicesId(c, id)
+}
+
+// PostInvoicesIdPayments operation middleware
+func (siw *ServerInterfaceWrapper) PostInvoicesIdPayments(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostInvoicesIdPayments(c, id)
+}
+
+// GetInvoicesIdTaxInvoice operation middleware
+func (siw *ServerInterfaceWrapper) GetInvoicesIdTaxInvoice(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetInvoicesIdTaxInvoice(c, id)
+}
+
+// PostInvoicesIdTaxInvoice operation middleware
+func (siw *ServerInterfaceWrapper) PostInvoicesIdTaxInvoice(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostInvoicesIdTaxInvoice(c, id)
+}
+
+// PostInvoicesIdTaxInvoiceReprint operation middleware
+func (siw *ServerInterfaceWrapper) PostInvoicesIdTaxInvoiceReprint(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostInvoicesIdTaxInvoiceReprint(c, id)
+}
+
+// PostInvoicesIdVoid operation middleware
+func (siw *ServerInterfaceWrapper) PostInvoicesIdVoid(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostInvoicesIdVoid(c, id)
+}
+
+// DeleteMedicinesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMedicinesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteMedicinesId(c, id)
+}
+
+// GetMedicinesId operation middleware
+func (siw *ServerInterfaceWrapper) GetMedicinesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetMedicinesId(c, id)
+}
+
+// PutMedicinesId operation middleware
+func (siw *ServerInterfaceWrapper) PutMedicinesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutMedicinesId(c, id)
+}
+
+// PostMedicinesIdDiscontinue operation middleware
+func (siw *ServerInterfaceWrapper) PostMedicinesIdDiscontinue(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostMedicinesIdDiscontinue(c, id)
+}
+
+// PostMedicinesIdDoses operation middleware
+func (siw *ServerInterfaceWrapper) PostMedicinesIdDoses(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostMedicinesIdDoses(c, id)
+}
+
+// PutOnboardingResponsesId operation middleware
+func (siw *ServerInterfaceWrapper) PutOnboardingResponsesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutOnboardingResponsesId(c, id)
+}
+
+// GetPatients operation middleware
+func (siw *ServerInterfaceWrapper) GetPatients(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatients(c, params)
+}
+
+// PostPatients operation middleware
+func (siw *ServerInterfaceWrapper) PostPatients(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatients(c)
+}
+
+// DeletePatientsId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePatientsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeletePatientsId(c, id)
+}
+
+// GetPatientsId operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsId(c, id)
+}
+
+// PutPatientsId operation middleware
+func (siw *ServerInterfaceWrapper) PutPatientsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutPatientsId(c, id)
+}
+
+// GetPatientsPatientIdAdministrations operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdAdministrations(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdAdministrationsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "assessment_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assessment_id", query, &params.AssessmentId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter assessment_id: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdAdministrations(c, patientId, params)
+}
+
+// PostPatientsPatientIdAdministrations operation middleware
+func (siw *ServerInterfaceWrapper) PostPatientsPatientIdAdministrations(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatientsPatientIdAdministrations(c, patientId)
+}
+
+// GetPatientsPatientIdAssessmentsAssessmentIdScores operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdAssessmentsAssessmentIdScores(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "assessment_id" -------------
+	var assessmentId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "assessment_id", c.Params("assessment_id"), &assessmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter assessment_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdAssessmentsAssessmentIdScores(c, patientId, assessmentId)
+}
+
+// GetPatientsPatientIdBehaviorIncidents operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdBehaviorIncidents(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdBehaviorIncidentsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "behavior_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "behavior_type", query, &params.BehaviorType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter behavior_type: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdBehaviorIncidents(c, patientId, params)
+}
+
+// GetPatientsPatientIdBehaviorMeasurements operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdBehaviorMeasurements(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdBehaviorMeasurements(c, patientId)
+}
+
+// PostPatientsPatientIdBehaviorMeasurements operation middleware
+func (siw *ServerInterfaceWrapper) PostPatientsPatientIdBehaviorMeasurements(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatientsPatientIdBehaviorMeasurements(c, patientId)
+}
+
+// GetPatientsPatientIdBehaviorSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdBehaviorSummary(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdBehaviorSummaryParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "behavior_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "behavior_type", query, &params.BehaviorType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter behavior_type: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdBehaviorSummary(c, patientId, params)
+}
+
+// GetPatientsPatientIdCalendarFeeds operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdCalendarFeeds(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdCalendarFeeds(c, patientId)
+}
+
+// PostPatientsPatientIdCalendarFeeds operation middleware
+func (siw *ServerInterfaceWrapper) PostPatientsPatientIdCalendarFeeds(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatientsPatientIdCalendarFeeds(c, patientId)
+}
+
+// DeletePatientsPatientIdDiscount operation middleware
+func (siw *ServerInterfaceWrapper) DeletePatientsPatientIdDiscount(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeletePatientsPatientIdDiscount(c, patientId)
+}
+
+// PutPatientsPatientIdDiscount operation middleware
+func (siw *ServerInterfaceWrapper) PutPatientsPatientIdDiscount(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutPatientsPatientIdDiscount(c, patientId)
+}
+
+// GetPatientsPatientIdInvoices operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdInvoices(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdInvoices(c, patientId)
+}
+
+// PostPatientsPatientIdInvoices operation middleware
+func (siw *ServerInterfaceWrapper) PostPatientsPatientIdInvoices(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatientsPatientIdInvoices(c, patientId)
+}
+
+// GetPatientsPatientIdMedicationRecord operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdMedicationRecord(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdMedicationRecordParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdMedicationRecord(c, patientId, params)
+}
+
+// GetPatientsPatientIdMedicationTimeline operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdMedicationTimeline(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdMedicationTimeline(c, patientId)
+}
+
+// GetPatientsPatientIdMedicines operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdMedicines(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdMedicines(c, patientId)
+}
+
+// PostPatientsPatientIdMedicines operation middleware
+func (siw *ServerInterfaceWrapper) PostPatientsPatientIdMedicines(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatientsPatientIdMedicines(c, patientId)
+}
+
+// GetPatientsPatientIdOnboardingResponses operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdOnboardingResponses(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdOnboardingResponsesParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "assessment_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assessment_id", query, &params.AssessmentId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter assessment_id: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdOnboardingResponses(c, patientId, params)
+}
+
+// PostPatientsPatientIdOnboardingResponses operation middleware
+func (siw *ServerInterfaceWrapper) PostPatientsPatientIdOnboardingResponses(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatientsPatientIdOnboardingResponses(c, patientId)
+}
+
+// GetPatientsPatientIdProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdProgress(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdProgressParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", query, &params.Period)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", query, &params.From)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter from: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", query, &params.To)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter to: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdProgress(c, patientId, params)
+}
+
+// GetPatientsPatientIdSessionEstimate operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdSessionEstimate(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdSessionEstimateParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Required query parameter "minutes" -------------
+
+	if paramValue := c.Query("minutes"); paramValue != "" {
+
+	} else {
+		err = fmt.Errorf("Query argument minutes is required, but not found")
+		c.Status(fiber.StatusBadRequest).JSON(err)
+		return err
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "minutes", query, &params.Minutes)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter minutes: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "branch_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "branch_id", query, &params.BranchId)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter branch_id: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", query, &params.Date)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter date: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdSessionEstimate(c, patientId, params)
+}
+
+// GetPatientsPatientIdSessionSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdSessionSeries(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdSessionSeries(c, patientId)
+}
+
+// GetPatientsPatientIdSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdSessions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPatientsPatientIdSessionsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", query, &params.StartDate)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start_date: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", query, &params.EndDate)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end_date: %w", err).Error())
+	}
+
+	return siw.Handler.GetPatientsPatientIdSessions(c, patientId, params)
+}
+
+// GetPatientsPatientIdSessionsSessionId operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdSessionsSessionId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdSessionsSessionId(c, patientId, sessionId)
+}
+
+// GetPatientsPatientIdTreatmentPlans operation middleware
+func (siw *ServerInterfaceWrapper) GetPatientsPatientIdTreatmentPlans(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetPatientsPatientIdTreatmentPlans(c, patientId)
+}
+
+// PostPatientsPatientIdTreatmentPlans operation middleware
+func (siw *ServerInterfaceWrapper) PostPatientsPatientIdTreatmentPlans(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "patient_id" -------------
+	var patientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostPatientsPatientIdTreatmentPlans(c, patientId)
+}
+
+// GetRateCards operation middleware
+func (siw *ServerInterfaceWrapper) GetRateCards(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRateCardsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "therapy_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "therapy_type", query, &params.TherapyType)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter therapy_type: %w", err).Error())
+	}
+
+	return siw.Handler.GetRateCards(c, params)
+}
+
+// PostRateCards operation middleware
+func (siw *ServerInterfaceWrapper) PostRateCards(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostRateCards(c)
+}
+
+// DeleteRateCardsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRateCardsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteRateCardsId(c, id)
+}
+
+// GetReceivablesAging operation middleware
+func (siw *ServerInterfaceWrapper) GetReceivablesAging(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetReceivablesAging(c)
+}
+
+// PostSessionSeries operation middleware
+func (siw *ServerInterfaceWrapper) PostSessionSeries(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostSessionSeries(c)
+}
+
+// GetSessionSeriesId operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionSeriesId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetSessionSeriesId(c, id)
+}
+
+// GetSessionSeriesIdSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionSeriesIdSessions(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetSessionSeriesIdSessions(c, id)
+}
+
+// DeleteSessionSeriesIdSessionsSessionId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSessionSeriesIdSessionsSessionId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSessionSeriesIdSessionsSessionIdParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", query, &params.Scope)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter scope: %w", err).Error())
+	}
+
+	return siw.Handler.DeleteSessionSeriesIdSessionsSessionId(c, id, sessionId, params)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler ServerInterface
+// PutSessionSeriesIdSessionsSessionId operation middleware
+func (siw *ServerInterfaceWrapper) PutSessionSeriesIdSessionsSessionId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutSessionSeriesIdSessionsSessionIdParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", query, &params.Scope)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter scope: %w", err).Error())
+	}
+
+	return siw.Handler.PutSessionSeriesIdSessionsSessionId(c, id, sessionId, params)
 }
 
-type MiddlewareFunc fiber.Handler
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(c *fiber.Ctx) error {
 
-// GetPatients operation middleware
-func (siw *ServerInterfaceWrapper) GetPatients(c *fiber.Ctx) error {
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSessionsParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "start_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "start_date", query, &params.StartDate)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start_date: %w", err).Error())
+	}
+
+	// ------------- Optional query parameter "end_date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "end_date", query, &params.EndDate)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end_date: %w", err).Error())
+	}
+
+	return siw.Handler.GetSessions(c, params)
+}
+
+// PostSessions operation middleware
+func (siw *ServerInterfaceWrapper) PostSessions(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostSessions(c)
+}
+
+// DeleteSessionsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSessionsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.DeleteSessionsId(c, id)
+}
+
+// GetSessionsId operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetSessionsId(c, id)
+}
+
+// PutSessionsId operation middleware
+func (siw *ServerInterfaceWrapper) PutSessionsId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PutSessionsId(c, id)
+}
+
+// PostSessionsIdBehaviorIncidents operation middleware
+func (siw *ServerInterfaceWrapper) PostSessionsIdBehaviorIncidents(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostSessionsIdBehaviorIncidents(c, id)
+}
+
+// GetSessionsIdDataPoints operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionsIdDataPoints(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetSessionsIdDataPoints(c, id)
+}
+
+// PostSessionsIdDataPoints operation middleware
+func (siw *ServerInterfaceWrapper) PostSessionsIdDataPoints(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostSessionsIdDataPoints(c, id)
+}
+
+// GetSessionsIdDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetSessionsIdDetails(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+	}
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.GetSessionsIdDetails(c, id)
+}
+
+// GetStaff operation middleware
+func (siw *ServerInterfaceWrapper) GetStaff(c *fiber.Ctx) error {
 
 	var err error
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPatientsParams
+	var params GetStaffParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -345,27 +4767,45 @@ func (siw *ServerInterfaceWrapper) GetPatients(c *fiber.Ctx) error {
 
 	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+	}
+
+	return siw.Handler.GetStaff(c, params)
+}
+
+// PostStaff operation middleware
+func (siw *ServerInterfaceWrapper) PostStaff(c *fiber.Ctx) error {
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostStaff(c)
+}
+
+// DeleteStaffId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStaffId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	return siw.Handler.GetPatients(c, params)
-}
-
-// PostPatients operation middleware
-func (siw *ServerInterfaceWrapper) PostPatients(c *fiber.Ctx) error {
-
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.PostPatients(c)
+	return siw.Handler.DeleteStaffId(c, id)
 }
 
-// DeletePatientsId operation middleware
-func (siw *ServerInterfaceWrapper) DeletePatientsId(c *fiber.Ctx) error {
+// GetStaffId operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffId(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -374,16 +4814,16 @@ func (siw *ServerInterfaceWrapper) DeletePatientsId(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeletePatientsId(c, id)
+	return siw.Handler.GetStaffId(c, id)
 }
 
-// GetPatientsId operation middleware
-func (siw *ServerInterfaceWrapper) GetPatientsId(c *fiber.Ctx) error {
+// PutStaffId operation middleware
+func (siw *ServerInterfaceWrapper) PutStaffId(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -392,16 +4832,16 @@ func (siw *ServerInterfaceWrapper) GetPatientsId(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetPatientsId(c, id)
+	return siw.Handler.PutStaffId(c, id)
 }
 
-// PutPatientsId operation middleware
-func (siw *ServerInterfaceWrapper) PutPatientsId(c *fiber.Ctx) error {
+// GetStaffIdCalendarFeeds operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffIdCalendarFeeds(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -410,99 +4850,78 @@ func (siw *ServerInterfaceWrapper) PutPatientsId(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.PutPatientsId(c, id)
+	return siw.Handler.GetStaffIdCalendarFeeds(c, id)
 }
 
-// GetPatientsPatientIdSessions operation middleware
-func (siw *ServerInterfaceWrapper) GetPatientsPatientIdSessions(c *fiber.Ctx) error {
+// PostStaffIdCalendarFeeds operation middleware
+func (siw *ServerInterfaceWrapper) PostStaffIdCalendarFeeds(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "patient_id" -------------
-	var patientId float32
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPatientsPatientIdSessionsParams
-
-	var query url.Values
-	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
-	}
+	return siw.Handler.PostStaffIdCalendarFeeds(c, id)
+}
 
-	// ------------- Optional query parameter "page" -------------
+// GetStaffIdPrescriptionReminders operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffIdPrescriptionReminders(c *fiber.Ctx) error {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
-	}
+	var err error
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "start_date" -------------
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "start_date", query, &params.StartDate)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStaffIdPrescriptionRemindersParams
+
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start_date: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "end_date" -------------
+	// ------------- Optional query parameter "days" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "end_date", query, &params.EndDate)
+	err = runtime.BindQueryParameter("form", true, false, "days", query, &params.Days)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end_date: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter days: %w", err).Error())
 	}
 
-	return siw.Handler.GetPatientsPatientIdSessions(c, patientId, params)
+	return siw.Handler.GetStaffIdPrescriptionReminders(c, id, params)
 }
 
-// GetPatientsPatientIdSessionsSessionId operation middleware
-func (siw *ServerInterfaceWrapper) GetPatientsPatientIdSessionsSessionId(c *fiber.Ctx) error {
+// GetStaffIdSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffIdSessions(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "patient_id" -------------
-	var patientId float32
-
-	err = runtime.BindStyledParameterWithOptions("simple", "patient_id", c.Params("patient_id"), &patientId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter patient_id: %w", err).Error())
-	}
-
-	// ------------- Path parameter "session_id" -------------
-	var sessionId float32
+	// ------------- Path parameter "id" -------------
+	var id string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetPatientsPatientIdSessionsSessionId(c, patientId, sessionId)
-}
-
-// GetSessions operation middleware
-func (siw *ServerInterfaceWrapper) GetSessions(c *fiber.Ctx) error {
-
-	var err error
-
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSessionsParams
+	var params GetStaffIdSessionsParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -538,24 +4957,16 @@ func (siw *ServerInterfaceWrapper) GetSessions(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end_date: %w", err).Error())
 	}
 
-	return siw.Handler.GetSessions(c, params)
-}
-
-// PostSessions operation middleware
-func (siw *ServerInterfaceWrapper) PostSessions(c *fiber.Ctx) error {
-
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.PostSessions(c)
+	return siw.Handler.GetStaffIdSessions(c, id, params)
 }
 
-// DeleteSessionsId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSessionsId(c *fiber.Ctx) error {
+// GetStaffIdTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffIdTimesheet(c *fiber.Ctx) error {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -564,72 +4975,64 @@ func (siw *ServerInterfaceWrapper) DeleteSessionsId(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeleteSessionsId(c, id)
-}
-
-// GetSessionsId operation middleware
-func (siw *ServerInterfaceWrapper) GetSessionsId(c *fiber.Ctx) error {
-
-	var err error
-
-	// ------------- Path parameter "id" -------------
-	var id float32
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStaffIdTimesheetParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	var query url.Values
+	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "period" -------------
 
-	return siw.Handler.GetSessionsId(c, id)
-}
+	err = runtime.BindQueryParameter("form", true, false, "period", query, &params.Period)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
+	}
 
-// PutSessionsId operation middleware
-func (siw *ServerInterfaceWrapper) PutSessionsId(c *fiber.Ctx) error {
+	// ------------- Optional query parameter "date" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "date", query, &params.Date)
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter date: %w", err).Error())
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id float32
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.PutSessionsId(c, id)
+	return siw.Handler.GetStaffIdTimesheet(c, id, params)
 }
 
-// GetSessionsIdDetails operation middleware
-func (siw *ServerInterfaceWrapper) GetSessionsIdDetails(c *fiber.Ctx) error {
+// GetStaffStaffIdSessionsSessionIdActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id float32
+	// ------------- Path parameter "staff_id" -------------
+	var staffId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
 	}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
-
-	return siw.Handler.GetSessionsIdDetails(c, id)
-}
-
-// GetStaff operation middleware
-func (siw *ServerInterfaceWrapper) GetStaff(c *fiber.Ctx) error {
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
 
-	var err error
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetStaffParams
+	var params GetStaffStaffIdSessionsSessionIdActivitiesParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -651,42 +5054,58 @@ func (siw *ServerInterfaceWrapper) GetStaff(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
 	}
 
-	return siw.Handler.GetStaff(c, params)
+	return siw.Handler.GetStaffStaffIdSessionsSessionIdActivities(c, staffId, sessionId, params)
 }
 
-// PostStaff operation middleware
-func (siw *ServerInterfaceWrapper) PostStaff(c *fiber.Ctx) error {
-
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+// PostStaffStaffIdSessionsSessionIdActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx) error {
 
-	return siw.Handler.PostStaff(c)
-}
+	var err error
 
-// DeleteStaffId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStaffId(c *fiber.Ctx) error {
+	// ------------- Path parameter "staff_id" -------------
+	var staffId string
 
-	var err error
+	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id float32
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeleteStaffId(c, id)
+	return siw.Handler.PostStaffStaffIdSessionsSessionIdActivities(c, staffId, sessionId)
 }
 
-// GetStaffId operation middleware
-func (siw *ServerInterfaceWrapper) GetStaffId(c *fiber.Ctx) error {
+// DeleteStaffStaffIdSessionsSessionIdActivitiesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "staff_id" -------------
+	var staffId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+	}
+
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -695,16 +5114,32 @@ func (siw *ServerInterfaceWrapper) GetStaffId(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetStaffId(c, id)
+	return siw.Handler.DeleteStaffStaffIdSessionsSessionIdActivitiesId(c, staffId, sessionId, id)
 }
 
-// PutStaffId operation middleware
-func (siw *ServerInterfaceWrapper) PutStaffId(c *fiber.Ctx) error {
+// GetStaffStaffIdSessionsSessionIdActivitiesId operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "staff_id" -------------
+	var staffId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+	}
+
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -713,16 +5148,32 @@ func (siw *ServerInterfaceWrapper) PutStaffId(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.PutStaffId(c, id)
+	return siw.Handler.GetStaffStaffIdSessionsSessionIdActivitiesId(c, staffId, sessionId, id)
 }
 
-// GetStaffIdSessions operation middleware
-func (siw *ServerInterfaceWrapper) GetStaffIdSessions(c *fiber.Ctx) error {
+// PutStaffStaffIdSessionsSessionIdActivitiesId operation middleware
+func (siw *ServerInterfaceWrapper) PutStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx) error {
 
 	var err error
 
+	// ------------- Path parameter "staff_id" -------------
+	var staffId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+	}
+
+	// ------------- Path parameter "session_id" -------------
+	var sessionId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+	}
+
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id string
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -731,8 +5182,18 @@ func (siw *ServerInterfaceWrapper) GetStaffIdSessions(c *fiber.Ctx) error {
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
+	return siw.Handler.PutStaffStaffIdSessionsSessionIdActivitiesId(c, staffId, sessionId, id)
+}
+
+// GetTimesheets operation middleware
+func (siw *ServerInterfaceWrapper) GetTimesheets(c *fiber.Ctx) error {
+
+	var err error
+
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetStaffIdSessionsParams
+	var params GetTimesheetsParams
 
 	var query url.Values
 	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
@@ -740,135 +5201,145 @@ func (siw *ServerInterfaceWrapper) GetStaffIdSessions(c *fiber.Ctx) error {
 		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
-	}
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "period" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "period", query, &params.Period)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter period: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "start_date" -------------
+	// ------------- Optional query parameter "date" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "start_date", query, &params.StartDate)
+	err = runtime.BindQueryParameter("form", true, false, "date", query, &params.Date)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter start_date: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter date: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "end_date" -------------
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "end_date", query, &params.EndDate)
+	err = runtime.BindQueryParameter("form", true, false, "format", query, &params.Format)
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter end_date: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter format: %w", err).Error())
 	}
 
-	return siw.Handler.GetStaffIdSessions(c, id, params)
+	return siw.Handler.GetTimesheets(c, params)
 }
 
-// GetStaffStaffIdSessionsSessionIdActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx) error {
+// DeleteTreatmentGoalsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTreatmentGoalsId(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "staff_id" -------------
-	var staffId float32
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Path parameter "session_id" -------------
-	var sessionId float32
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
-	}
+	return siw.Handler.DeleteTreatmentGoalsId(c, id)
+}
 
-	c.Context().SetUserValue(BearerAuthScopes, []string{})
+// PutTreatmentGoalsId operation middleware
+func (siw *ServerInterfaceWrapper) PutTreatmentGoalsId(c *fiber.Ctx) error {
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetStaffStaffIdSessionsSessionIdActivitiesParams
+	var err error
 
-	var query url.Values
-	query, err = url.ParseQuery(string(c.Request().URI().QueryString()))
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for query string: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "page", query, &params.Page)
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter page: %w", err).Error())
-	}
+	return siw.Handler.PutTreatmentGoalsId(c, id)
+}
 
-	// ------------- Optional query parameter "limit" -------------
+// PostTreatmentGoalsIdTargets operation middleware
+func (siw *ServerInterfaceWrapper) PostTreatmentGoalsIdTargets(c *fiber.Ctx) error {
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", query, &params.Limit)
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter limit: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	return siw.Handler.GetStaffStaffIdSessionsSessionIdActivities(c, staffId, sessionId, params)
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
+
+	return siw.Handler.PostTreatmentGoalsIdTargets(c, id)
 }
 
-// PostStaffStaffIdSessionsSessionIdActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx) error {
+// DeleteTreatmentPlansId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTreatmentPlansId(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "staff_id" -------------
-	var staffId float32
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Path parameter "session_id" -------------
-	var sessionId float32
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	return siw.Handler.DeleteTreatmentPlansId(c, id)
+}
+
+// GetTreatmentPlansId operation middleware
+func (siw *ServerInterfaceWrapper) GetTreatmentPlansId(c *fiber.Ctx) error {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.PostStaffStaffIdSessionsSessionIdActivities(c, staffId, sessionId)
+	return siw.Handler.GetTreatmentPlansId(c, id)
 }
 
-// DeleteStaffStaffIdSessionsSessionIdActivitiesId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx) error {
+// PutTreatmentPlansId operation middleware
+func (siw *ServerInterfaceWrapper) PutTreatmentPlansId(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "staff_id" -------------
-	var staffId float32
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Path parameter "session_id" -------------
-	var sessionId float32
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
-	}
+	return siw.Handler.PutTreatmentPlansId(c, id)
+}
+
+// PostTreatmentPlansIdGoals operation middleware
+func (siw *ServerInterfaceWrapper) PostTreatmentPlansIdGoals(c *fiber.Ctx) error {
+
+	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id int
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -877,32 +5348,34 @@ func (siw *ServerInterfaceWrapper) DeleteStaffStaffIdSessionsSessionIdActivities
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.DeleteStaffStaffIdSessionsSessionIdActivitiesId(c, staffId, sessionId, id)
+	return siw.Handler.PostTreatmentPlansIdGoals(c, id)
 }
 
-// GetStaffStaffIdSessionsSessionIdActivitiesId operation middleware
-func (siw *ServerInterfaceWrapper) GetStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx) error {
+// DeleteTreatmentTargetsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTreatmentTargetsId(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "staff_id" -------------
-	var staffId float32
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Path parameter "session_id" -------------
-	var sessionId float32
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
-	}
+	return siw.Handler.DeleteTreatmentTargetsId(c, id)
+}
+
+// PutTreatmentTargetsId operation middleware
+func (siw *ServerInterfaceWrapper) PutTreatmentTargetsId(c *fiber.Ctx) error {
+
+	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id int
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -911,32 +5384,34 @@ func (siw *ServerInterfaceWrapper) GetStaffStaffIdSessionsSessionIdActivitiesId(
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.GetStaffStaffIdSessionsSessionIdActivitiesId(c, staffId, sessionId, id)
+	return siw.Handler.PutTreatmentTargetsId(c, id)
 }
 
-// PutStaffStaffIdSessionsSessionIdActivitiesId operation middleware
-func (siw *ServerInterfaceWrapper) PutStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx) error {
+// GetTreatmentTargetsIdSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetTreatmentTargetsIdSessions(c *fiber.Ctx) error {
 
 	var err error
 
-	// ------------- Path parameter "staff_id" -------------
-	var staffId float32
+	// ------------- Path parameter "id" -------------
+	var id int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "staff_id", c.Params("staff_id"), &staffId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter staff_id: %w", err).Error())
+		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter id: %w", err).Error())
 	}
 
-	// ------------- Path parameter "session_id" -------------
-	var sessionId float32
+	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "session_id", c.Params("session_id"), &sessionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		return fiber.NewError(fiber.StatusBadRequest, fmt.Errorf("Invalid format for parameter session_id: %w", err).Error())
-	}
+	return siw.Handler.GetTreatmentTargetsIdSessions(c, id)
+}
+
+// DeleteTrialsId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTrialsId(c *fiber.Ctx) error {
+
+	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id float32
+	var id int
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Params("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
@@ -945,7 +5420,7 @@ func (siw *ServerInterfaceWrapper) PutStaffStaffIdSessionsSessionIdActivitiesId(
 
 	c.Context().SetUserValue(BearerAuthScopes, []string{})
 
-	return siw.Handler.PutStaffStaffIdSessionsSessionIdActivitiesId(c, staffId, sessionId, id)
+	return siw.Handler.DeleteTrialsId(c, id)
 }
 
 // FiberServerOptions provides options for the Fiber server.
@@ -969,6 +5444,128 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 		router.Use(fiber.Handler(m))
 	}
 
+	router.Get(options.BaseURL+"/activities/:id/trials", wrapper.GetActivitiesIdTrials)
+
+	router.Post(options.BaseURL+"/activities/:id/trials", wrapper.PostActivitiesIdTrials)
+
+	router.Get(options.BaseURL+"/administrations/:id", wrapper.GetAdministrationsId)
+
+	router.Put(options.BaseURL+"/administrations/:id", wrapper.PutAdministrationsId)
+
+	router.Get(options.BaseURL+"/administrations/:id/comparison", wrapper.GetAdministrationsIdComparison)
+
+	router.Get(options.BaseURL+"/administrations/:id/responses", wrapper.GetAdministrationsIdResponses)
+
+	router.Get(options.BaseURL+"/administrations/:id/scores", wrapper.GetAdministrationsIdScores)
+
+	router.Get(options.BaseURL+"/assessments", wrapper.GetAssessments)
+
+	router.Get(options.BaseURL+"/assessments/:id/questions", wrapper.GetAssessmentsIdQuestions)
+
+	router.Get(options.BaseURL+"/audit-logs", wrapper.GetAuditLogs)
+
+	router.Post(options.BaseURL+"/auth/guardian/code", wrapper.PostAuthGuardianCode)
+
+	router.Post(options.BaseURL+"/auth/guardian/verify", wrapper.PostAuthGuardianVerify)
+
+	router.Post(options.BaseURL+"/auth/login", wrapper.PostAuthLogin)
+
+	router.Delete(options.BaseURL+"/behavior-incidents/:id", wrapper.DeleteBehaviorIncidentsId)
+
+	router.Get(options.BaseURL+"/behavior-incidents/:id", wrapper.GetBehaviorIncidentsId)
+
+	router.Put(options.BaseURL+"/behavior-incidents/:id", wrapper.PutBehaviorIncidentsId)
+
+	router.Delete(options.BaseURL+"/behavior-measurements/:id", wrapper.DeleteBehaviorMeasurementsId)
+
+	router.Get(options.BaseURL+"/behavior-measurements/:id", wrapper.GetBehaviorMeasurementsId)
+
+	router.Put(options.BaseURL+"/behavior-measurements/:id", wrapper.PutBehaviorMeasurementsId)
+
+	router.Get(options.BaseURL+"/behavior-measurements/:id/data-points", wrapper.GetBehaviorMeasurementsIdDataPoints)
+
+	router.Get(options.BaseURL+"/branches", wrapper.GetBranches)
+
+	router.Post(options.BaseURL+"/branches", wrapper.PostBranches)
+
+	router.Delete(options.BaseURL+"/branches/:id", wrapper.DeleteBranchesId)
+
+	router.Get(options.BaseURL+"/branches/:id", wrapper.GetBranchesId)
+
+	router.Put(options.BaseURL+"/branches/:id", wrapper.PutBranchesId)
+
+	router.Get(options.BaseURL+"/branches/:id/closures", wrapper.GetBranchesIdClosures)
+
+	router.Post(options.BaseURL+"/branches/:id/closures", wrapper.PostBranchesIdClosures)
+
+	router.Post(options.BaseURL+"/branches/:id/closures/holidays", wrapper.PostBranchesIdClosuresHolidays)
+
+	router.Get(options.BaseURL+"/branches/:id/doses-due", wrapper.GetBranchesIdDosesDue)
+
+	router.Get(options.BaseURL+"/branches/:id/hours", wrapper.GetBranchesIdHours)
+
+	router.Put(options.BaseURL+"/branches/:id/hours", wrapper.PutBranchesIdHours)
+
+	router.Get(options.BaseURL+"/branches/:id/tax-invoices", wrapper.GetBranchesIdTaxInvoices)
+
+	router.Delete(options.BaseURL+"/calendar-feeds/:id", wrapper.DeleteCalendarFeedsId)
+
+	router.Get(options.BaseURL+"/calendar/patients/:patient_id", wrapper.GetCalendarPatientsPatientId)
+
+	router.Get(options.BaseURL+"/calendar/staff/:id", wrapper.GetCalendarStaffId)
+
+	router.Delete(options.BaseURL+"/closures/:id", wrapper.DeleteClosuresId)
+
+	router.Post(options.BaseURL+"/closures/:id/cancel", wrapper.PostClosuresIdCancel)
+
+	router.Post(options.BaseURL+"/closures/:id/reschedule", wrapper.PostClosuresIdReschedule)
+
+	router.Get(options.BaseURL+"/closures/:id/sessions", wrapper.GetClosuresIdSessions)
+
+	router.Delete(options.BaseURL+"/data-points/:id", wrapper.DeleteDataPointsId)
+
+	router.Put(options.BaseURL+"/data-points/:id", wrapper.PutDataPointsId)
+
+	router.Get(options.BaseURL+"/discount-rules", wrapper.GetDiscountRules)
+
+	router.Post(options.BaseURL+"/discount-rules", wrapper.PostDiscountRules)
+
+	router.Put(options.BaseURL+"/discount-rules/:id", wrapper.PutDiscountRulesId)
+
+	router.Get(options.BaseURL+"/guardian/children", wrapper.GetGuardianChildren)
+
+	router.Get(options.BaseURL+"/guardian/children/:patient_id/medicines", wrapper.GetGuardianChildrenPatientIdMedicines)
+
+	router.Get(options.BaseURL+"/guardian/children/:patient_id/progress", wrapper.GetGuardianChildrenPatientIdProgress)
+
+	router.Get(options.BaseURL+"/guardian/children/:patient_id/sessions", wrapper.GetGuardianChildrenPatientIdSessions)
+
+	router.Get(options.BaseURL+"/guardians/:id/balance", wrapper.GetGuardiansIdBalance)
+
+	router.Get(options.BaseURL+"/invoices/:id", wrapper.GetInvoicesId)
+
+	router.Post(options.BaseURL+"/invoices/:id/payments", wrapper.PostInvoicesIdPayments)
+
+	router.Get(options.BaseURL+"/invoices/:id/tax-invoice", wrapper.GetInvoicesIdTaxInvoice)
+
+	router.Post(options.BaseURL+"/invoices/:id/tax-invoice", wrapper.PostInvoicesIdTaxInvoice)
+
+	router.Post(options.BaseURL+"/invoices/:id/tax-invoice/reprint", wrapper.PostInvoicesIdTaxInvoiceReprint)
+
+	router.Post(options.BaseURL+"/invoices/:id/void", wrapper.PostInvoicesIdVoid)
+
+	router.Delete(options.BaseURL+"/medicines/:id", wrapper.DeleteMedicinesId)
+
+	router.Get(options.BaseURL+"/medicines/:id", wrapper.GetMedicinesId)
+
+	router.Put(options.BaseURL+"/medicines/:id", wrapper.PutMedicinesId)
+
+	router.Post(options.BaseURL+"/medicines/:id/discontinue", wrapper.PostMedicinesIdDiscontinue)
+
+	router.Post(options.BaseURL+"/medicines/:id/doses", wrapper.PostMedicinesIdDoses)
+
+	router.Put(options.BaseURL+"/onboarding-responses/:id", wrapper.PutOnboardingResponsesId)
+
 	router.Get(options.BaseURL+"/patients", wrapper.GetPatients)
 
 	router.Post(options.BaseURL+"/patients", wrapper.PostPatients)
@@ -979,10 +5576,76 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Put(options.BaseURL+"/patients/:id", wrapper.PutPatientsId)
 
+	router.Get(options.BaseURL+"/patients/:patient_id/administrations", wrapper.GetPatientsPatientIdAdministrations)
+
+	router.Post(options.BaseURL+"/patients/:patient_id/administrations", wrapper.PostPatientsPatientIdAdministrations)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/assessments/:assessment_id/scores", wrapper.GetPatientsPatientIdAssessmentsAssessmentIdScores)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/behavior-incidents", wrapper.GetPatientsPatientIdBehaviorIncidents)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/behavior-measurements", wrapper.GetPatientsPatientIdBehaviorMeasurements)
+
+	router.Post(options.BaseURL+"/patients/:patient_id/behavior-measurements", wrapper.PostPatientsPatientIdBehaviorMeasurements)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/behavior-summary", wrapper.GetPatientsPatientIdBehaviorSummary)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/calendar-feeds", wrapper.GetPatientsPatientIdCalendarFeeds)
+
+	router.Post(options.BaseURL+"/patients/:patient_id/calendar-feeds", wrapper.PostPatientsPatientIdCalendarFeeds)
+
+	router.Delete(options.BaseURL+"/patients/:patient_id/discount", wrapper.DeletePatientsPatientIdDiscount)
+
+	router.Put(options.BaseURL+"/patients/:patient_id/discount", wrapper.PutPatientsPatientIdDiscount)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/invoices", wrapper.GetPatientsPatientIdInvoices)
+
+	router.Post(options.BaseURL+"/patients/:patient_id/invoices", wrapper.PostPatientsPatientIdInvoices)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/medication-record", wrapper.GetPatientsPatientIdMedicationRecord)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/medication-timeline", wrapper.GetPatientsPatientIdMedicationTimeline)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/medicines", wrapper.GetPatientsPatientIdMedicines)
+
+	router.Post(options.BaseURL+"/patients/:patient_id/medicines", wrapper.PostPatientsPatientIdMedicines)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/onboarding-responses", wrapper.GetPatientsPatientIdOnboardingResponses)
+
+	router.Post(options.BaseURL+"/patients/:patient_id/onboarding-responses", wrapper.PostPatientsPatientIdOnboardingResponses)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/progress", wrapper.GetPatientsPatientIdProgress)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/session-estimate", wrapper.GetPatientsPatientIdSessionEstimate)
+
+	router.Get(options.BaseURL+"/patients/:patient_id/session-series", wrapper.GetPatientsPatientIdSessionSeries)
+
 	router.Get(options.BaseURL+"/patients/:patient_id/sessions", wrapper.GetPatientsPatientIdSessions)
 
 	router.Get(options.BaseURL+"/patients/:patient_id/sessions/:session_id", wrapper.GetPatientsPatientIdSessionsSessionId)
 
+	router.Get(options.BaseURL+"/patients/:patient_id/treatment-plans", wrapper.GetPatientsPatientIdTreatmentPlans)
+
+	router.Post(options.BaseURL+"/patients/:patient_id/treatment-plans", wrapper.PostPatientsPatientIdTreatmentPlans)
+
+	router.Get(options.BaseURL+"/rate-cards", wrapper.GetRateCards)
+
+	router.Post(options.BaseURL+"/rate-cards", wrapper.PostRateCards)
+
+	router.Delete(options.BaseURL+"/rate-cards/:id", wrapper.DeleteRateCardsId)
+
+	router.Get(options.BaseURL+"/receivables/aging", wrapper.GetReceivablesAging)
+
+	router.Post(options.BaseURL+"/session-series", wrapper.PostSessionSeries)
+
+	router.Get(options.BaseURL+"/session-series/:id", wrapper.GetSessionSeriesId)
+
+	router.Get(options.BaseURL+"/session-series/:id/sessions", wrapper.GetSessionSeriesIdSessions)
+
+	router.Delete(options.BaseURL+"/session-series/:id/sessions/:session_id", wrapper.DeleteSessionSeriesIdSessionsSessionId)
+
+	router.Put(options.BaseURL+"/session-series/:id/sessions/:session_id", wrapper.PutSessionSeriesIdSessionsSessionId)
+
 	router.Get(options.BaseURL+"/sessions", wrapper.GetSessions)
 
 	router.Post(options.BaseURL+"/sessions", wrapper.PostSessions)
@@ -993,6 +5656,12 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Put(options.BaseURL+"/sessions/:id", wrapper.PutSessionsId)
 
+	router.Post(options.BaseURL+"/sessions/:id/behavior-incidents", wrapper.PostSessionsIdBehaviorIncidents)
+
+	router.Get(options.BaseURL+"/sessions/:id/data-points", wrapper.GetSessionsIdDataPoints)
+
+	router.Post(options.BaseURL+"/sessions/:id/data-points", wrapper.PostSessionsIdDataPoints)
+
 	router.Get(options.BaseURL+"/sessions/:id/details", wrapper.GetSessionsIdDetails)
 
 	router.Get(options.BaseURL+"/staff", wrapper.GetStaff)
@@ -1005,8 +5674,16 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Put(options.BaseURL+"/staff/:id", wrapper.PutStaffId)
 
+	router.Get(options.BaseURL+"/staff/:id/calendar-feeds", wrapper.GetStaffIdCalendarFeeds)
+
+	router.Post(options.BaseURL+"/staff/:id/calendar-feeds", wrapper.PostStaffIdCalendarFeeds)
+
+	router.Get(options.BaseURL+"/staff/:id/prescription-reminders", wrapper.GetStaffIdPrescriptionReminders)
+
 	router.Get(options.BaseURL+"/staff/:id/sessions", wrapper.GetStaffIdSessions)
 
+	router.Get(options.BaseURL+"/staff/:id/timesheet", wrapper.GetStaffIdTimesheet)
+
 	router.Get(options.BaseURL+"/staff/:staff_id/sessions/:session_id/activities", wrapper.GetStaffStaffIdSessionsSessionIdActivities)
 
 	router.Post(options.BaseURL+"/staff/:staff_id/sessions/:session_id/activities", wrapper.PostStaffStaffIdSessionsSessionIdActivities)
@@ -1017,4 +5694,28 @@ func RegisterHandlersWithOptions(router fiber.Router, si ServerInterface, option
 
 	router.Put(options.BaseURL+"/staff/:staff_id/sessions/:session_id/activities/:id", wrapper.PutStaffStaffIdSessionsSessionIdActivitiesId)
 
+	router.Get(options.BaseURL+"/timesheets", wrapper.GetTimesheets)
+
+	router.Delete(options.BaseURL+"/treatment-goals/:id", wrapper.DeleteTreatmentGoalsId)
+
+	router.Put(options.BaseURL+"/treatment-goals/:id", wrapper.PutTreatmentGoalsId)
+
+	router.Post(options.BaseURL+"/treatment-goals/:id/targets", wrapper.PostTreatmentGoalsIdTargets)
+
+	router.Delete(options.BaseURL+"/treatment-plans/:id", wrapper.DeleteTreatmentPlansId)
+
+	router.Get(options.BaseURL+"/treatment-plans/:id", wrapper.GetTreatmentPlansId)
+
+	router.Put(options.BaseURL+"/treatment-plans/:id", wrapper.PutTreatmentPlansId)
+
+	router.Post(options.BaseURL+"/treatment-plans/:id/goals", wrapper.PostTreatmentPlansIdGoals)
+
+	router.Delete(options.BaseURL+"/treatment-targets/:id", wrapper.DeleteTreatmentTargetsId)
+
+	router.Put(options.BaseURL+"/treatment-targets/:id", wrapper.PutTreatmentTargetsId)
+
+	router.Get(options.BaseURL+"/treatment-targets/:id/sessions", wrapper.GetTreatmentTargetsIdSessions)
+
+	router.Delete(options.BaseURL+"/trials/:id", wrapper.DeleteTrialsId)
+
 }
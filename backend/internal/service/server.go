@@ -1,520 +1,2553 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"palaam/internal/audit"
+	"palaam/internal/auth"
+	"palaam/internal/config"
+	"palaam/internal/ical"
 	"palaam/internal/models"
+	"palaam/internal/pdf"
 	"palaam/internal/repository"
 	"palaam/pkg/utils"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"gorm.io/gorm"
 )
 
 // Server implements the generated ServerInterface
 type Server struct {
 	services *Services
+	repo     *repository.Repository
+	tokens   *auth.TokenManager
+	cfg      config.Config
 }
 
 // NewServer creates a new server with the service dependencies
-func NewServer(services *Services) ServerInterface {
+func NewServer(repo *repository.Repository, tokens *auth.TokenManager, cfg config.Config) *Server {
 	return &Server{
-		services: services,
+		services: newServices(repo, tokens, cfg),
+		repo:     repo,
+		tokens:   tokens,
+		cfg:      cfg,
 	}
 }
 
-func InitApp(router fiber.Router, db *gorm.DB) {
+func InitApp(router fiber.Router, db *gorm.DB, cfg config.Config) error {
+	// Record who views and changes patient data
+	if err := audit.RegisterCallbacks(db); err != nil {
+		return err
+	}
+
+	if _, err := time.LoadLocation(cfg.Scheduling.Timezone); err != nil {
+		return fmt.Errorf("unknown TIMEZONE %q: %w", cfg.Scheduling.Timezone, err)
+	}
+	if cfg.Timesheets.UnderUtilization >= cfg.Timesheets.OverUtilization {
+		return errors.New("TIMESHEET_UNDER must be below TIMESHEET_OVER")
+	}
+	if cfg.Billing.GSTRate < 0 || cfg.Billing.GSTRate > 28 {
+		return fmt.Errorf("GST_RATE %v must be from 0 to 28", cfg.Billing.GSTRate)
+	}
+	if cfg.Billing.GSTIN != "" && !validGSTIN(cfg.Billing.GSTIN) {
+		return fmt.Errorf("CLINIC_GSTIN %q isn't a valid GSTIN", cfg.Billing.GSTIN)
+	}
+	if cfg.Billing.GSTRate > 0 && cfg.Billing.GSTIN == "" {
+		return errors.New("CLINIC_GSTIN is required to charge GST")
+	}
+
 	// Initialize repository with DB connection
 	repo := repository.NewRepository(db)
+	tokens := auth.NewTokenManager(cfg.Auth, cfg.Application.Name)
 
-	server := NewServer(&Services{
-		PatientService:  NewPatientService(repo),
-		SessionService:  NewSessionService(repo),
-		StaffService:    NewStaffService(repo),
-		ActivityService: NewActivityService(repo),
+	server := NewServer(repo, tokens, cfg)
+	if err := server.services.AuthService.EnsureAdmin(cfg.Auth.AdminEmail, cfg.Auth.AdminPassword); err != nil {
+		return err
+	}
+
+	// Every route requires a bearer token except signing in, and a role allowed by the policy table
+	RegisterHandlersWithOptions(newPolicyRouter(router, Policy), server, FiberServerOptions{
+		Middlewares: []MiddlewareFunc{
+			MiddlewareFunc(requestid.New()),
+			MiddlewareFunc(auth.Middleware(tokens, publicRoutes...)),
+			MiddlewareFunc(audit.Middleware()),
+		},
 	})
-	RegisterHandlers(router, server)
+
+	// Keep the sessions of recurring series generated ahead of time
+	go server.extendSeries(time.Hour)
+	return nil
+}
+
+// extendSeries generates upcoming sessions of every recurring series now and then every interval
+func (s *Server) extendSeries(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.services.SessionSeriesService.Extend(time.Now()); err != nil {
+			slog.Error("failed to generate sessions of recurring series", "error", err)
+		}
+		<-ticker.C
+	}
 }
 
 // Services holds all service layer implementations
 type Services struct {
-	PatientService  PatientServiceInterface
-	SessionService  SessionServiceInterface
-	StaffService    StaffServiceInterface
-	ActivityService ActivityServiceInterface
+	AuditService         AuditServiceInterface
+	AuthService          AuthServiceInterface
+	AuthorizationService AuthorizationServiceInterface
+	BranchService        BranchServiceInterface
+	CalendarService      CalendarServiceInterface
+	ClosureService       ClosureServiceInterface
+	MedicineService      MedicineServiceInterface
+	OnboardingService    OnboardingServiceInterface
+	PatientService       PatientServiceInterface
+	ProgressService      ProgressServiceInterface
+	SessionService       SessionServiceInterface
+	SessionSeriesService SessionSeriesServiceInterface
+	StaffService         StaffServiceInterface
+	TimesheetService     TimesheetServiceInterface
+	ActivityService      ActivityServiceInterface
+	GuardianPortal       GuardianPortalServiceInterface
+	InvoiceService       InvoiceServiceInterface
+	PricingService       PricingServiceInterface
+	TaxInvoiceService    TaxInvoiceServiceInterface
+	TreatmentPlanService TreatmentPlanServiceInterface
+	TrialService         TrialServiceInterface
+	IncidentService      BehaviorIncidentServiceInterface
+	MeasurementService   BehaviorMeasurementServiceInterface
+	MedicineRecord       MedicationRecordServiceInterface
 }
 
-/** SESSION HANDLERS **/
-func (s *Server) GetSessions(c *fiber.Ctx, params GetSessionsParams) error {
-	limit, offset := utils.ParseQueryParams(c)
-
-	sessions, total, err := s.services.SessionService.List(limit, offset)
-	if err != nil {
-		return s.handleError(c, err, "Failed to fetch sessions")
+// newServices wires every service to the given repository
+func newServices(repo *repository.Repository, tokens *auth.TokenManager, cfg config.Config) *Services {
+	return &Services{
+		AuditService:         NewAuditService(repo),
+		AuthService:          NewAuthService(repo, tokens),
+		AuthorizationService: NewAuthorizationService(repo),
+		BranchService:        NewBranchService(repo, cfg.Scheduling),
+		CalendarService:      NewCalendarService(repo),
+		ClosureService:       NewClosureService(repo, cfg.Scheduling),
+		MedicineService:      NewMedicineService(repo, cfg.Scheduling),
+		OnboardingService:    NewOnboardingService(repo),
+		PatientService:       NewPatientService(repo),
+		ProgressService:      NewProgressService(repo, cfg.Scheduling),
+		SessionService:       NewSessionService(repo, cfg.Scheduling),
+		SessionSeriesService: NewSessionSeriesService(repo, cfg.Scheduling),
+		StaffService:         NewStaffService(repo),
+		TimesheetService:     NewTimesheetService(repo, cfg.Scheduling, cfg.Timesheets),
+		ActivityService:      NewActivityService(repo),
+		GuardianPortal:       NewGuardianPortalService(repo, tokens, auth.LogSender{}, cfg.Auth.OTPTTL),
+		InvoiceService:       NewInvoiceService(repo, cfg.Scheduling, cfg.Billing),
+		PricingService:       NewPricingService(repo, cfg.Scheduling),
+		TaxInvoiceService:    NewTaxInvoiceService(repo, cfg.Scheduling, cfg.Billing),
+		TreatmentPlanService: NewTreatmentPlanService(repo, cfg.Scheduling),
+		TrialService:         NewTrialService(repo),
+		IncidentService:      NewBehaviorIncidentService(repo, cfg.Scheduling),
+		MeasurementService:   NewBehaviorMeasurementService(repo, cfg.Scheduling),
+		MedicineRecord:       NewMedicationRecordService(repo, cfg.Scheduling),
 	}
+}
 
-	return c.JSON(fiber.Map{
-		"data":   sessions,
-		"total":  total,
-		"limit":  limit,
-		"offset": offset,
+// servicesFor returns services whose patient, session and activity data is limited
+// to the caller's caseload and whose queries are audited as the caller. Handlers
+// serving clinical data must use these.
+func (s *Server) servicesFor(c *fiber.Ctx) *Services {
+	return newServices(s.repo.WithContext(c.UserContext()).ForViewer(viewerFrom(c)), s.tokens, s.cfg)
+}
+
+// auditedServices returns services whose queries are audited as the caller but see every
+// patient, for staff-wide work such as branch closures, timesheets and calendar feeds
+func (s *Server) auditedServices(c *fiber.Ctx) *Services {
+	return newServices(s.repo.WithContext(c.UserContext()), s.tokens, s.cfg)
+}
+
+// servicesAs returns services that read on behalf of a staff member who didn't sign in to
+// the request, such as the creator of a calendar feed, audited as them
+func (s *Server) servicesAs(c *fiber.Ctx, staff *models.Staff) *Services {
+	requestID, _ := c.Locals(requestid.ConfigDefault.ContextKey).(string)
+	ctx := audit.WithActor(c.UserContext(), &audit.Actor{
+		ID:        staff.ID,
+		Role:      staff.Role,
+		RequestID: requestID,
+		IP:        c.IP(),
 	})
+	viewer := &models.Viewer{StaffID: staff.ID, Role: staff.Role}
+	return newServices(s.repo.WithContext(ctx).ForViewer(viewer), s.tokens, s.cfg)
 }
 
-func (s *Server) PostSessions(c *fiber.Ctx) error {
-	var session models.Session
+// viewerFrom returns the signed-in caller
+func viewerFrom(c *fiber.Ctx) *models.Viewer {
+	viewer := &models.Viewer{}
+	if claims, ok := auth.ClaimsFrom(c); ok {
+		viewer.StaffID = claims.StaffID()
+		viewer.Role = claims.Role
+	}
+	return viewer
+}
 
-	if err := c.BodyParser(&session); err != nil {
+/** AUTH HANDLERS **/
+func (s *Server) PostAuthLogin(c *fiber.Ctx) error {
+	var credentials LoginRequest
+
+	if err := c.BodyParser(&credentials); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "Invalid request body",
 		})
 	}
 
-	createdSession, err := s.services.SessionService.Create(&session)
+	token, staff, err := s.services.AuthService.Login(string(credentials.Email), credentials.Password)
 	if err != nil {
-		return s.handleError(c, err, "Failed to create session")
+		return s.handleError(c, err, "Failed to sign in")
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(createdSession)
+	return c.JSON(fiber.Map{
+		"token": token,
+		"user":  staff,
+	})
 }
 
-func (s *Server) GetSessionsId(c *fiber.Ctx, id float32) error {
-	sessionID := utils.Float32ToUint(id)
-	if sessionID == 0 {
+/** GUARDIAN PORTAL HANDLERS **/
+func (s *Server) PostAuthGuardianCode(c *fiber.Ctx) error {
+	var request GuardianCodeRequest
+
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid session ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	session, err := s.services.SessionService.GetByID(sessionID)
-	if err != nil {
-		return s.handleError(c, err, "Session not found")
+	if err := s.services.GuardianPortal.RequestCode(emailOrEmpty(request.Email), stringOrEmpty(request.PhoneNumber)); err != nil {
+		return s.handleError(c, err, "Failed to send sign-in code")
 	}
 
-	return c.JSON(session)
+	return c.SendStatus(fiber.StatusAccepted)
 }
 
-func (s *Server) PutSessionsId(c *fiber.Ctx, id float32) error {
-	sessionID := utils.Float32ToUint(id)
-	if sessionID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid session ID",
-		})
-	}
+func (s *Server) PostAuthGuardianVerify(c *fiber.Ctx) error {
+	var request GuardianVerifyRequest
 
-	// Parse the request body into a map for partial updates
-	var updates map[string]interface{}
-	if err := c.BodyParser(&updates); err != nil {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "Invalid request body",
 		})
 	}
 
-	updatedSession, err := s.services.SessionService.Update(sessionID, updates)
+	token, guardian, err := s.services.GuardianPortal.VerifyCode(emailOrEmpty(request.Email), stringOrEmpty(request.PhoneNumber), request.Code)
 	if err != nil {
-		return s.handleError(c, err, "Failed to update session")
+		return s.handleError(c, err, "Failed to sign in")
 	}
 
-	return c.JSON(updatedSession)
+	return c.JSON(fiber.Map{
+		"token":    token,
+		"guardian": guardian,
+	})
 }
 
-func (s *Server) DeleteSessionsId(c *fiber.Ctx, id float32) error {
-	sessionID := utils.Float32ToUint(id)
-	if sessionID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid session ID",
-		})
+func (s *Server) GetGuardianChildren(c *fiber.Ctx) error {
+	children, err := s.servicesFor(c).GuardianPortal.Children(guardianID(c))
+	if err != nil {
+		return s.handleError(c, err, "Failed to get children")
 	}
 
-	if err := s.services.SessionService.Delete(sessionID); err != nil {
-		return s.handleError(c, err, "Failed to delete session")
+	return c.JSON(children)
+}
+
+func (s *Server) GetGuardianChildrenPatientIdSessions(c *fiber.Ctx, patientId string, params GetGuardianChildrenPatientIdSessionsParams) error {
+	upcoming := params.When == nil || *params.When == Upcoming
+
+	sessions, err := s.servicesFor(c).GuardianPortal.Sessions(guardianID(c), patientId, upcoming)
+	if err != nil {
+		return s.handleError(c, err, "Failed to get sessions")
 	}
 
-	return c.Status(fiber.StatusNoContent).Send(nil)
+	return c.JSON(sessions)
 }
 
-func (s *Server) GetSessionsIdDetails(c *fiber.Ctx, id float32) error {
-	sessionID := utils.Float32ToUint(id)
-	if sessionID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid session ID",
-		})
+func (s *Server) GetGuardianChildrenPatientIdMedicines(c *fiber.Ctx, patientId string) error {
+	medicines, err := s.servicesFor(c).GuardianPortal.Medicines(guardianID(c), patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to get medicines")
 	}
 
-	details, err := s.services.SessionService.GetDetails(sessionID)
+	return c.JSON(medicines)
+}
+
+func (s *Server) GetGuardianChildrenPatientIdProgress(c *fiber.Ctx, patientId string) error {
+	progress, err := s.servicesFor(c).GuardianPortal.Progress(guardianID(c), patientId)
 	if err != nil {
-		return s.handleError(c, err, "Failed to fetch session details")
+		return s.handleError(c, err, "Failed to get progress")
 	}
 
-	return c.JSON(details)
+	return c.JSON(progress)
 }
 
-/** PATIENT HANDLERS **/
-func (s *Server) GetPatients(c *fiber.Ctx, params GetPatientsParams) error {
+/** AUDIT HANDLERS **/
+func (s *Server) GetAuditLogs(c *fiber.Ctx, params GetAuditLogsParams) error {
 	limit, offset := utils.ParseQueryParams(c)
 
-	patients, total, err := s.services.PatientService.List(limit, offset)
+	filter := models.AuditLogFilter{
+		PatientID: stringOrEmpty(params.PatientId),
+		ActorID:   stringOrEmpty(params.StaffId),
+		From:      params.From,
+		To:        params.To,
+	}
+
+	entries, total, err := s.services.AuditService.List(filter, limit, offset)
 	if err != nil {
-		return s.handleError(c, err, "Failed to fetch patients")
+		return s.handleError(c, err, "Failed to fetch audit log")
 	}
 
 	return c.JSON(fiber.Map{
-		"data":   patients,
+		"data":   entries,
 		"total":  total,
 		"limit":  limit,
 		"offset": offset,
 	})
 }
 
-func (s *Server) PostPatients(c *fiber.Ctx) error {
-	var patient models.Patient
+/** BRANCH HANDLERS **/
+func (s *Server) GetBranches(c *fiber.Ctx) error {
+	branches, err := s.services.BranchService.List()
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch branches")
+	}
 
-	if err := c.BodyParser(&patient); err != nil {
+	return c.JSON(branches)
+}
+
+func (s *Server) PostBranches(c *fiber.Ctx) error {
+	var branch models.Branch
+
+	if err := c.BodyParser(&branch); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "Invalid request body",
 		})
 	}
 
-	createdPatient, err := s.services.PatientService.Create(&patient)
+	createdBranch, err := s.services.BranchService.Create(&branch)
 	if err != nil {
-		return s.handleError(c, err, "Failed to create patient")
+		return s.handleError(c, err, "Failed to create branch")
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(createdPatient)
+	return c.Status(fiber.StatusCreated).JSON(createdBranch)
 }
 
-func (s *Server) GetPatientsId(c *fiber.Ctx, id float32) error {
-	patientID := utils.Float32ToUint(id)
-	if patientID == 0 {
+func (s *Server) GetBranchesId(c *fiber.Ctx, id int) error {
+	branch, err := s.services.BranchService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Branch not found")
+	}
+
+	return c.JSON(branch)
+}
+
+func (s *Server) PutBranchesId(c *fiber.Ctx, id int) error {
+	var updates map[string]interface{}
+	if err := c.BodyParser(&updates); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid patient ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	patient, err := s.services.PatientService.GetByID(patientID)
+	updatedBranch, err := s.services.BranchService.Update(id, updates)
 	if err != nil {
-		return s.handleError(c, err, "Patient not found")
+		return s.handleError(c, err, "Failed to update branch")
 	}
 
-	return c.JSON(patient)
+	return c.JSON(updatedBranch)
 }
 
-func (s *Server) PutPatientsId(c *fiber.Ctx, id float32) error {
-	patientID := utils.Float32ToUint(id)
-	if patientID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid patient ID",
-		})
+func (s *Server) DeleteBranchesId(c *fiber.Ctx, id int) error {
+	if err := s.services.BranchService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete branch")
 	}
 
-	var patient models.Patient
-	if err := c.BodyParser(&patient); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid request body",
-		})
-	}
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
 
-	updatedPatient, err := s.services.PatientService.Update(patientID, &patient)
+func (s *Server) GetBranchesIdHours(c *fiber.Ctx, id int) error {
+	hours, err := s.services.BranchService.Hours(id)
 	if err != nil {
-		return s.handleError(c, err, "Failed to update patient")
+		return s.handleError(c, err, "Failed to fetch operating hours")
 	}
 
-	return c.JSON(updatedPatient)
+	return c.JSON(hours)
 }
 
-func (s *Server) DeletePatientsId(c *fiber.Ctx, id float32) error {
-	patientID := utils.Float32ToUint(id)
-	if patientID == 0 {
+func (s *Server) PutBranchesIdHours(c *fiber.Ctx, id int) error {
+	var request []OperatingHours
+
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid patient ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	if err := s.services.PatientService.DeletePatientsId(patientID); err != nil {
-		return s.handleError(c, err, "Failed to delete patient")
+	hours := make([]*models.OperatingHours, 0, len(request))
+	for _, day := range request {
+		hours = append(hours, &models.OperatingHours{
+			DayOfWeek: int16(day.DayOfWeek),
+			OpenTime:  stringOrEmpty(day.OpenTime),
+			CloseTime: stringOrEmpty(day.CloseTime),
+			IsClosed:  day.IsClosed != nil && *day.IsClosed,
+		})
 	}
 
-	return c.Status(fiber.StatusNoContent).Send(nil)
-}
+	updatedHours, err := s.services.BranchService.SetHours(id, hours)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update operating hours")
+	}
 
-func (s *Server) GetPatientsPatientIdSessions(c *fiber.Ctx, patientId float32, params GetPatientsPatientIdSessionsParams) error {
-	limit, offset := utils.ParseQueryParams(c)
-	patientID := utils.Float32ToUint(patientId)
+	return c.JSON(updatedHours)
+}
 
-	if patientID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid patient ID",
-		})
+/** BRANCH CLOSURE HANDLERS **/
+func (s *Server) GetBranchesIdClosures(c *fiber.Ctx, id int, params GetBranchesIdClosuresParams) error {
+	var from, to string
+	if params.From != nil {
+		from = params.From.String()
+	}
+	if params.To != nil {
+		to = params.To.String()
 	}
 
-	sessions, total, err := s.services.PatientService.GetSessions(patientID, limit, offset)
+	closures, err := s.services.ClosureService.List(id, from, to)
 	if err != nil {
-		return s.handleError(c, err, "Failed to fetch patient sessions")
+		return s.handleError(c, err, "Failed to fetch closures")
 	}
 
-	return c.JSON(fiber.Map{
-		"data":   sessions,
-		"total":  total,
-		"limit":  limit,
-		"offset": offset,
-	})
+	return c.JSON(closures)
 }
 
-func (s *Server) GetPatientsPatientIdSessionsSessionId(c *fiber.Ctx, patientId float32, sessionId float32) error {
-	patientID := utils.Float32ToUint(patientId)
-	sessionID := utils.Float32ToUint(sessionId)
+func (s *Server) PostBranchesIdClosures(c *fiber.Ctx, id int) error {
+	var request BranchClosure
 
-	if patientID == 0 || sessionID == 0 {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid patient or session ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	session, err := s.services.SessionService.GetByPatientID(patientID, sessionID)
+	closure := &models.BranchClosure{
+		BranchID:  id,
+		StartDate: request.StartDate.String(),
+		Name:      request.Name,
+	}
+	if request.EndDate != nil {
+		closure.EndDate = request.EndDate.String()
+	}
+
+	impact, err := s.auditedServices(c).ClosureService.Create(closure)
 	if err != nil {
-		return s.handleError(c, err, "Session not found")
+		return s.handleError(c, err, "Failed to create closure")
 	}
 
-	return c.JSON(session)
+	return c.Status(fiber.StatusCreated).JSON(impact)
 }
 
-/** STAFF HANDLERS **/
-func (s *Server) GetStaff(c *fiber.Ctx, params GetStaffParams) error {
-	limit, offset := utils.ParseQueryParams(c)
+func (s *Server) PostBranchesIdClosuresHolidays(c *fiber.Ctx, id int, params PostBranchesIdClosuresHolidaysParams) error {
+	impacts, err := s.auditedServices(c).ClosureService.ImportHolidays(id, params.Year)
+	if err != nil {
+		return s.handleError(c, err, "Failed to import holidays")
+	}
 
-	staff, total, err := s.services.StaffService.List(limit, offset)
+	return c.Status(fiber.StatusCreated).JSON(impacts)
+}
+
+func (s *Server) DeleteClosuresId(c *fiber.Ctx, id int) error {
+	if err := s.auditedServices(c).ClosureService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete closure")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s *Server) GetClosuresIdSessions(c *fiber.Ctx, id int) error {
+	sessions, err := s.servicesFor(c).ClosureService.Sessions(id)
 	if err != nil {
-		return s.handleError(c, err, "Failed to fetch staff")
+		return s.handleError(c, err, "Failed to fetch sessions")
 	}
 
-	return c.JSON(fiber.Map{
-		"data":   staff,
-		"total":  total,
-		"limit":  limit,
-		"offset": offset,
-	})
+	return c.JSON(sessions)
 }
 
-func (s *Server) PostStaff(c *fiber.Ctx) error {
-	var staff models.Staff
+func (s *Server) PostClosuresIdReschedule(c *fiber.Ctx, id int) error {
+	var request RescheduleRequest
 
-	if err := c.BodyParser(&staff); err != nil {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "Invalid request body",
 		})
 	}
 
-	createdStaff, err := s.services.StaffService.Create(&staff)
+	var offsetDays int
+	if request.OffsetDays != nil {
+		offsetDays = *request.OffsetDays
+	}
+	var moves []SessionMove
+	if request.Moves != nil {
+		for _, move := range *request.Moves {
+			moves = append(moves, SessionMove{
+				SessionID: move.SessionId,
+				StartTime: move.StartTime,
+				EndTime:   move.EndTime,
+			})
+		}
+	}
+
+	sessions, warnings, err := s.servicesFor(c).ClosureService.Reschedule(id, offsetDays, moves)
 	if err != nil {
-		return s.handleError(c, err, "Failed to create staff")
+		return s.handleError(c, err, "Failed to reschedule sessions")
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(createdStaff)
+	warn(c, warnings)
+	return c.JSON(sessions)
 }
 
-func (s *Server) GetStaffId(c *fiber.Ctx, id float32) error {
-	staffID := utils.Float32ToUint(id)
-	if staffID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid staff ID",
-		})
+func (s *Server) PostClosuresIdCancel(c *fiber.Ctx, id int) error {
+	var request CancelRequest
+
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&request); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
 	}
 
-	staff, err := s.services.StaffService.GetByID(staffID)
-	if err != nil {
-		return s.handleError(c, err, "Staff not found")
+	var sessionIDs []string
+	if request.SessionIds != nil {
+		sessionIDs = *request.SessionIds
 	}
 
-	return c.JSON(staff)
+	if err := s.servicesFor(c).ClosureService.Cancel(id, sessionIDs); err != nil {
+		return s.handleError(c, err, "Failed to cancel sessions")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
 }
 
-func (s *Server) PutStaffId(c *fiber.Ctx, id float32) error {
-	staffID := utils.Float32ToUint(id)
-	if staffID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid staff ID",
-		})
+/** TREATMENT PLAN HANDLERS **/
+func (s *Server) GetPatientsPatientIdTreatmentPlans(c *fiber.Ctx, patientId string) error {
+	plans, err := s.servicesFor(c).TreatmentPlanService.ListByPatient(patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch treatment plans")
 	}
 
-	var staff models.Staff
-	if err := c.BodyParser(&staff); err != nil {
+	return c.JSON(plans)
+}
+
+func (s *Server) PostPatientsPatientIdTreatmentPlans(c *fiber.Ctx, patientId string) error {
+	var request TreatmentPlan
+
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "Invalid request body",
 		})
 	}
 
-	updatedStaff, err := s.services.StaffService.Update(staffID, &staff)
+	plan := treatmentPlanFrom(request)
+	plan.PatientID = patientId
+
+	created, err := s.servicesFor(c).TreatmentPlanService.Create(viewerFrom(c), plan)
 	if err != nil {
-		return s.handleError(c, err, "Failed to update staff")
+		return s.handleError(c, err, "Failed to create treatment plan")
 	}
 
-	return c.JSON(updatedStaff)
+	return c.Status(fiber.StatusCreated).JSON(created)
 }
 
-func (s *Server) DeleteStaffId(c *fiber.Ctx, id float32) error {
-	staffID := utils.Float32ToUint(id)
-	if staffID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid staff ID",
-		})
-	}
-
-	if err := s.services.StaffService.Delete(staffID); err != nil {
-		return s.handleError(c, err, "Failed to delete staff")
+func (s *Server) GetTreatmentPlansId(c *fiber.Ctx, id int) error {
+	plan, err := s.servicesFor(c).TreatmentPlanService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch treatment plan")
 	}
 
-	return c.Status(fiber.StatusNoContent).Send(nil)
+	return c.JSON(plan)
 }
 
-func (s *Server) GetStaffIdSessions(c *fiber.Ctx, id float32, params GetStaffIdSessionsParams) error {
-	limit, offset := utils.ParseQueryParams(c)
-	staffID := utils.Float32ToUint(id)
+func (s *Server) PutTreatmentPlansId(c *fiber.Ctx, id int) error {
+	var request TreatmentPlan
 
-	if staffID == 0 {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid staff ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	sessions, total, err := s.services.StaffService.GetSessions(staffID, limit, offset)
+	plan, err := s.servicesFor(c).TreatmentPlanService.Update(id, treatmentPlanFrom(request))
 	if err != nil {
-		return s.handleError(c, err, "Failed to fetch staff sessions")
+		return s.handleError(c, err, "Failed to update treatment plan")
 	}
 
-	return c.JSON(fiber.Map{
-		"data":   sessions,
-		"total":  total,
-		"limit":  limit,
-		"offset": offset,
-	})
+	return c.JSON(plan)
 }
 
-/** ACTIVITY HANDLERS **/
-func (s *Server) GetStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId float32, sessionId float32, params GetStaffStaffIdSessionsSessionIdActivitiesParams) error {
-	limit, offset := utils.ParseQueryParams(c)
-	staffID := utils.Float32ToUint(staffId)
-	sessionID := utils.Float32ToUint(sessionId)
+func (s *Server) DeleteTreatmentPlansId(c *fiber.Ctx, id int) error {
+	if err := s.servicesFor(c).TreatmentPlanService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete treatment plan")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s *Server) PostTreatmentPlansIdGoals(c *fiber.Ctx, id int) error {
+	var request TreatmentGoal
 
-	if staffID == 0 || sessionID == 0 {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid staff or session ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	activities, total, err := s.services.ActivityService.GetBySessionAndStaff(staffID, sessionID, limit, offset)
+	goal, err := s.servicesFor(c).TreatmentPlanService.AddGoal(id, treatmentGoalFrom(request))
 	if err != nil {
-		return s.handleError(c, err, "Failed to fetch activities")
+		return s.handleError(c, err, "Failed to add goal")
 	}
 
-	return c.JSON(fiber.Map{
-		"data":   activities,
-		"total":  total,
-		"limit":  limit,
-		"offset": offset,
-	})
+	return c.Status(fiber.StatusCreated).JSON(goal)
 }
 
-func (s *Server) PostStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId float32, sessionId float32) error {
-	staffID := utils.Float32ToUint(staffId)
-	sessionID := utils.Float32ToUint(sessionId)
+func (s *Server) PutTreatmentGoalsId(c *fiber.Ctx, id int) error {
+	var request TreatmentGoal
 
-	if staffID == 0 || sessionID == 0 {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid staff or session ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	var activity models.Activity
-	if err := c.BodyParser(&activity); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid request body",
-		})
+	goal, err := s.servicesFor(c).TreatmentPlanService.UpdateGoal(id, treatmentGoalFrom(request))
+	if err != nil {
+		return s.handleError(c, err, "Failed to update goal")
 	}
 
-	// Set the staff and session IDs from the URL
-	activity.StaffID = staffID
-	activity.SessionID = sessionID
+	return c.JSON(goal)
+}
 
-	createdActivity, err := s.services.ActivityService.Create(&activity)
-	if err != nil {
-		return s.handleError(c, err, "Failed to create activity")
+func (s *Server) DeleteTreatmentGoalsId(c *fiber.Ctx, id int) error {
+	if err := s.servicesFor(c).TreatmentPlanService.DeleteGoal(id); err != nil {
+		return s.handleError(c, err, "Failed to delete goal")
 	}
 
-	return c.Status(fiber.StatusCreated).JSON(createdActivity)
+	return c.SendStatus(fiber.StatusNoContent)
 }
 
-func (s *Server) GetStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId float32, sessionId float32, id float32) error {
-	staffID := utils.Float32ToUint(staffId)
-	sessionID := utils.Float32ToUint(sessionId)
-	activityID := utils.Float32ToUint(id)
+func (s *Server) PostTreatmentGoalsIdTargets(c *fiber.Ctx, id int) error {
+	var request TreatmentTarget
 
-	if staffID == 0 || sessionID == 0 || activityID == 0 {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid staff, session, or activity ID",
+			"error": "Invalid request body",
 		})
 	}
 
-	activity, err := s.services.ActivityService.GetSpecific(staffID, sessionID, activityID)
+	target, err := s.servicesFor(c).TreatmentPlanService.AddTarget(id, treatmentTargetFrom(request))
 	if err != nil {
-		return s.handleError(c, err, "Activity not found")
+		return s.handleError(c, err, "Failed to add target")
 	}
 
-	return c.JSON(activity)
+	return c.Status(fiber.StatusCreated).JSON(target)
 }
 
-func (s *Server) PutStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId float32, sessionId float32, id float32) error {
-	activityID := utils.Float32ToUint(id)
-	if activityID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid activity ID",
-		})
-	}
+func (s *Server) PutTreatmentTargetsId(c *fiber.Ctx, id int) error {
+	var request TreatmentTarget
 
-	var activity models.Activity
-	if err := c.BodyParser(&activity); err != nil {
+	if err := c.BodyParser(&request); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": "Invalid request body",
 		})
 	}
 
-	updatedActivity, err := s.services.ActivityService.Update(activityID, &activity)
+	target, err := s.servicesFor(c).TreatmentPlanService.UpdateTarget(id, treatmentTargetFrom(request))
 	if err != nil {
-		return s.handleError(c, err, "Failed to update activity")
+		return s.handleError(c, err, "Failed to update target")
 	}
 
-	return c.JSON(updatedActivity)
+	return c.JSON(target)
 }
 
-func (s *Server) DeleteStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId float32, sessionId float32, id float32) error {
-	activityID := utils.Float32ToUint(id)
-	if activityID == 0 {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error": "Invalid activity ID",
-		})
+func (s *Server) DeleteTreatmentTargetsId(c *fiber.Ctx, id int) error {
+	if err := s.servicesFor(c).TreatmentPlanService.DeleteTarget(id); err != nil {
+		return s.handleError(c, err, "Failed to delete target")
 	}
 
-	if err := s.services.ActivityService.Delete(activityID); err != nil {
-		return s.handleError(c, err, "Failed to delete activity")
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s *Server) GetTreatmentTargetsIdSessions(c *fiber.Ctx, id int) error {
+	progress, err := s.servicesFor(c).TrialService.TargetProgress(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch target scores")
 	}
 
-	return c.Status(fiber.StatusNoContent).Send(nil)
+	return c.JSON(progress)
 }
 
-// Helper method for consistent error handling
-func (s *Server) handleError(c *fiber.Ctx, err error, message string) error {
-	// Map common business logic errors to appropriate HTTP status codes
-	switch err.Error() {
-	case "patient not found", "staff member not found", "session not found", "activity not found":
+// treatmentPlanFrom converts a treatment plan request with the goals it comes with
+func treatmentPlanFrom(request TreatmentPlan) *models.TreatmentPlan {
+	plan := &models.TreatmentPlan{
+		Title:      request.Title,
+		ReviewDate: request.ReviewDate.String(),
+		Notes:      request.Notes,
+	}
+	if request.Status != nil {
+		plan.Status = models.TreatmentPlanStatus(*request.Status)
+	}
+	if request.StartDate != nil {
+		plan.StartDate = request.StartDate.String()
+	}
+	if request.LastReviewedOn != nil {
+		reviewed := request.LastReviewedOn.String()
+		plan.LastReviewedOn = &reviewed
+	}
+	if request.Goals != nil {
+		for _, goal := range *request.Goals {
+			plan.Goals = append(plan.Goals, *treatmentGoalFrom(goal))
+		}
+	}
+	return plan
+}
+
+// treatmentGoalFrom converts a goal request with the targets it comes with
+func treatmentGoalFrom(request TreatmentGoal) *models.TreatmentGoal {
+	goal := &models.TreatmentGoal{Description: request.Description}
+	if request.Status != nil {
+		goal.Status = models.GoalStatus(*request.Status)
+	}
+	if request.Targets != nil {
+		for _, target := range *request.Targets {
+			goal.Targets = append(goal.Targets, *treatmentTargetFrom(target))
+		}
+	}
+	return goal
+}
+
+// treatmentTargetFrom converts a target request. Missing mastery criteria are left for the service to default.
+func treatmentTargetFrom(request TreatmentTarget) *models.TreatmentTarget {
+	target := &models.TreatmentTarget{
+		Description:   request.Description,
+		BaselineNotes: request.BaselineNotes,
+	}
+	if request.BaselinePercent != nil {
+		baseline := float64(*request.BaselinePercent)
+		target.BaselinePercent = &baseline
+	}
+	if request.MasteryPercent != nil {
+		target.MasteryPercent = float64(*request.MasteryPercent)
+	}
+	if request.MasterySessions != nil {
+		target.MasterySessions = *request.MasterySessions
+	}
+	if request.Status != nil {
+		target.Status = models.TargetStatus(*request.Status)
+	}
+	return target
+}
+
+/** MEDICINE HANDLERS **/
+func (s *Server) GetPatientsPatientIdMedicines(c *fiber.Ctx, patientId string) error {
+	medicines, err := s.servicesFor(c).MedicineService.ListByPatient(patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch medicines")
+	}
+
+	return c.JSON(medicines)
+}
+
+func (s *Server) PostPatientsPatientIdMedicines(c *fiber.Ctx, patientId string) error {
+	var request Medicine
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	// The prescriber is always the doctor making the request
+	claims, _ := auth.ClaimsFrom(c)
+	medicine := medicineFrom(request)
+	medicine.PatientID = patientId
+	medicine.PrescriberID = claims.StaffID()
+
+	var reason string
+	if request.Reason != nil {
+		reason = *request.Reason
+	}
+
+	createdMedicine, err := s.servicesFor(c).MedicineService.Create(medicine, reason)
+	if err != nil {
+		return s.handleError(c, err, "Failed to prescribe medicine")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(createdMedicine)
+}
+
+func (s *Server) GetMedicinesId(c *fiber.Ctx, id string) error {
+	medicine, err := s.servicesFor(c).MedicineService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Medicine not found")
+	}
+
+	return c.JSON(medicine)
+}
+
+func (s *Server) PutMedicinesId(c *fiber.Ctx, id string) error {
+	var updates map[string]interface{}
+	if err := c.BodyParser(&updates); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	// The reason is recorded on the prescription event, not the medicine
+	reason, _ := updates["reason"].(string)
+	delete(updates, "reason")
+
+	updatedMedicine, err := s.servicesFor(c).MedicineService.Update(viewerFrom(c), id, updates, reason)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update medicine")
+	}
+
+	return c.JSON(updatedMedicine)
+}
+
+func (s *Server) PostMedicinesIdDiscontinue(c *fiber.Ctx, id string) error {
+	var request DiscontinueRequest
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	medicine, err := s.servicesFor(c).MedicineService.Discontinue(viewerFrom(c), id, request.Reason)
+	if err != nil {
+		return s.handleError(c, err, "Failed to discontinue medicine")
+	}
+
+	return c.JSON(medicine)
+}
+
+func (s *Server) GetPatientsPatientIdMedicationTimeline(c *fiber.Ctx, patientId string) error {
+	events, err := s.servicesFor(c).MedicineService.Timeline(patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch medication timeline")
+	}
+
+	return c.JSON(events)
+}
+
+func (s *Server) GetStaffIdPrescriptionReminders(c *fiber.Ctx, id string, params GetStaffIdPrescriptionRemindersParams) error {
+	days := 14
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	reminders, err := s.servicesFor(c).MedicineService.Reminders(viewerFrom(c), id, days, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch prescription reminders")
+	}
+
+	return c.JSON(reminders)
+}
+
+func (s *Server) DeleteMedicinesId(c *fiber.Ctx, id string) error {
+	if err := s.servicesFor(c).MedicineService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete medicine")
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+func (s *Server) PostMedicinesIdDoses(c *fiber.Ctx, id string) error {
+	var request MedicationDose
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	dose := &models.MedicationAdministration{
+		DueAt:      request.DueAt,
+		Status:     models.DoseStatus(request.Status),
+		DoseAmount: request.DoseAmount,
+		DoseUnit:   request.DoseUnit,
+		Notes:      request.Notes,
+	}
+	if request.BranchId != nil {
+		dose.BranchID = *request.BranchId
+	}
+	if request.AdministeredAt != nil {
+		dose.AdministeredAt = *request.AdministeredAt
+	}
+
+	recorded, err := s.servicesFor(c).MedicineRecord.Record(viewerFrom(c), id, dose, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to record dose")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(recorded)
+}
+
+func (s *Server) GetPatientsPatientIdMedicationRecord(c *fiber.Ctx, patientId string, params GetPatientsPatientIdMedicationRecordParams) error {
+	var from, to string
+	if params.From != nil {
+		from = params.From.String()
+	}
+	if params.To != nil {
+		to = params.To.String()
+	}
+
+	doses, err := s.servicesFor(c).MedicineRecord.ListByPatient(patientId, from, to)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch medication record")
+	}
+
+	return c.JSON(doses)
+}
+
+func (s *Server) GetBranchesIdDosesDue(c *fiber.Ctx, id int) error {
+	doses, err := s.servicesFor(c).MedicineRecord.DueNow(id, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch due doses")
+	}
+
+	return c.JSON(doses)
+}
+
+// medicineFrom converts a prescription request
+func medicineFrom(request Medicine) *models.Medicine {
+	medicine := &models.Medicine{
+		Name:       request.Name,
+		BrandName:  request.BrandName,
+		Dosage:     request.Dosage,
+		DoseAmount: request.DoseAmount,
+		DoseUnit:   request.DoseUnit,
+		DoseTimes:  request.DoseTimes,
+	}
+	if request.Route != nil {
+		route := models.MedicationRoute(*request.Route)
+		medicine.Route = &route
+	}
+	if request.Frequency != nil {
+		frequency := models.DoseFrequency(*request.Frequency)
+		medicine.Frequency = &frequency
+	}
+	if request.StartDate != nil {
+		start := request.StartDate.String()
+		medicine.StartDate = &start
+	}
+	if request.EndDate != nil {
+		end := request.EndDate.String()
+		medicine.EndDate = &end
+	}
+	if request.RefillDate != nil {
+		refill := request.RefillDate.String()
+		medicine.RefillDate = &refill
+	}
+	if request.RenewalDate != nil {
+		renewal := request.RenewalDate.String()
+		medicine.RenewalDate = &renewal
+	}
+	return medicine
+}
+
+/** ONBOARDING HANDLERS **/
+func (s *Server) GetAssessments(c *fiber.Ctx) error {
+	assessments, err := s.services.OnboardingService.ListAssessments()
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch assessments")
+	}
+
+	return c.JSON(assessments)
+}
+
+func (s *Server) GetAssessmentsIdQuestions(c *fiber.Ctx, id int) error {
+	questions, err := s.services.OnboardingService.Questions(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch questions")
+	}
+
+	return c.JSON(questions)
+}
+
+func (s *Server) GetPatientsPatientIdOnboardingResponses(c *fiber.Ctx, patientId string, params GetPatientsPatientIdOnboardingResponsesParams) error {
+	assessmentID := 0
+	if params.AssessmentId != nil {
+		assessmentID = *params.AssessmentId
+	}
+
+	responses, err := s.servicesFor(c).OnboardingService.ListResponses(patientId, assessmentID)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch onboarding responses")
+	}
+
+	return c.JSON(responses)
+}
+
+func (s *Server) PostPatientsPatientIdOnboardingResponses(c *fiber.Ctx, patientId string) error {
+	var request OnboardingResponseRequest
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	// Answers are recorded by the staff member making the request
+	claims, _ := auth.ClaimsFrom(c)
+	response := &models.OnboardingResponse{
+		AdministrationID: &request.AdministrationId,
+		QuestionID:       request.QuestionId,
+		PatientID:        patientId,
+		StaffID:          claims.StaffID(),
+		SessionID:        request.SessionId,
+	}
+	answer := ResponseAnswer{Score: request.Score, Answer: request.Answer, Notes: request.Notes}
+
+	createdResponse, err := s.servicesFor(c).OnboardingService.Record(response, answer)
+	if err != nil {
+		return s.handleError(c, err, "Failed to record answer")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(createdResponse)
+}
+
+func (s *Server) PutOnboardingResponsesId(c *fiber.Ctx, id int) error {
+	var request OnboardingAnswer
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	claims, _ := auth.ClaimsFrom(c)
+	answer := ResponseAnswer{Score: request.Score, Answer: request.Answer, Notes: request.Notes}
+
+	updatedResponse, err := s.servicesFor(c).OnboardingService.Answer(id, claims.StaffID(), answer)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update onboarding response")
+	}
+
+	return c.JSON(updatedResponse)
+}
+
+func (s *Server) GetPatientsPatientIdAssessmentsAssessmentIdScores(c *fiber.Ctx, patientId string, assessmentId int) error {
+	scores, err := s.servicesFor(c).OnboardingService.Scores(patientId, assessmentId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to score assessment")
+	}
+
+	return c.JSON(scores)
+}
+
+func (s *Server) GetPatientsPatientIdAdministrations(c *fiber.Ctx, patientId string, params GetPatientsPatientIdAdministrationsParams) error {
+	assessmentID := 0
+	if params.AssessmentId != nil {
+		assessmentID = *params.AssessmentId
+	}
+
+	administrations, err := s.servicesFor(c).OnboardingService.ListAdministrations(patientId, assessmentID)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch administrations")
+	}
+
+	return c.JSON(administrations)
+}
+
+func (s *Server) PostPatientsPatientIdAdministrations(c *fiber.Ctx, patientId string) error {
+	var request AdministrationRequest
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	// The administration is credited to the staff member starting it
+	claims, _ := auth.ClaimsFrom(c)
+	administration := &models.AssessmentAdministration{
+		PatientID:      patientId,
+		AssessmentID:   request.AssessmentId,
+		AdministeredBy: claims.StaffID(),
+		Notes:          request.Notes,
+	}
+	if request.AdministeredAt != nil {
+		administration.AdministeredAt = *request.AdministeredAt
+	}
+
+	createdAdministration, err := s.servicesFor(c).OnboardingService.StartAdministration(administration)
+	if err != nil {
+		return s.handleError(c, err, "Failed to start administration")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(createdAdministration)
+}
+
+func (s *Server) GetAdministrationsId(c *fiber.Ctx, id int) error {
+	administration, err := s.servicesFor(c).OnboardingService.GetAdministration(id)
+	if err != nil {
+		return s.handleError(c, err, "Administration not found")
+	}
+
+	return c.JSON(administration)
+}
+
+func (s *Server) PutAdministrationsId(c *fiber.Ctx, id int) error {
+	// Parse the request body into a map for partial updates
+	var updates map[string]interface{}
+	if err := c.BodyParser(&updates); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	updatedAdministration, err := s.servicesFor(c).OnboardingService.UpdateAdministration(id, updates)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update administration")
+	}
+
+	return c.JSON(updatedAdministration)
+}
+
+func (s *Server) GetAdministrationsIdResponses(c *fiber.Ctx, id int) error {
+	responses, err := s.servicesFor(c).OnboardingService.AdministrationResponses(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch onboarding responses")
+	}
+
+	return c.JSON(responses)
+}
+
+func (s *Server) GetAdministrationsIdScores(c *fiber.Ctx, id int) error {
+	scores, err := s.servicesFor(c).OnboardingService.AdministrationScores(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to score administration")
+	}
+
+	return c.JSON(scores)
+}
+
+func (s *Server) GetAdministrationsIdComparison(c *fiber.Ctx, id int, params GetAdministrationsIdComparisonParams) error {
+	comparison, err := s.servicesFor(c).OnboardingService.Compare(id, params.BaselineId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to compare administrations")
+	}
+
+	return c.JSON(comparison)
+}
+
+/** SESSION HANDLERS **/
+func (s *Server) GetSessions(c *fiber.Ctx, params GetSessionsParams) error {
+	limit, offset := utils.ParseQueryParams(c)
+
+	sessions, total, err := s.servicesFor(c).SessionService.List(limit, offset)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch sessions")
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   sessions,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+func (s *Server) PostSessions(c *fiber.Ctx) error {
+	var session models.Session
+
+	if err := c.BodyParser(&session); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+	// Whether a session is paid comes from its invoice
+	session.PaymentReceived = nil
+
+	createdSession, warnings, err := s.servicesFor(c).SessionService.Create(&session)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create session")
+	}
+
+	warn(c, warnings)
+	return c.Status(fiber.StatusCreated).JSON(createdSession)
+}
+
+func (s *Server) GetSessionsId(c *fiber.Ctx, id string) error {
+	session, err := s.servicesFor(c).SessionService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Session not found")
+	}
+
+	return c.JSON(session)
+}
+
+func (s *Server) PutSessionsId(c *fiber.Ctx, id string) error {
+	// Parse the request body into a map for partial updates
+	var updates map[string]interface{}
+	if err := c.BodyParser(&updates); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+	delete(updates, "payment_received")
+
+	updatedSession, warnings, err := s.servicesFor(c).SessionService.Update(id, updates)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update session")
+	}
+
+	warn(c, warnings)
+	return c.JSON(updatedSession)
+}
+
+func (s *Server) DeleteSessionsId(c *fiber.Ctx, id string) error {
+	if err := s.servicesFor(c).SessionService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete session")
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+func (s *Server) GetSessionsIdDetails(c *fiber.Ctx, id string) error {
+	details, err := s.servicesFor(c).SessionService.GetDetails(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch session details")
+	}
+
+	return c.JSON(details)
+}
+
+/** PATIENT HANDLERS **/
+func (s *Server) GetPatients(c *fiber.Ctx, params GetPatientsParams) error {
+	limit, offset := utils.ParseQueryParams(c)
+
+	patients, total, err := s.servicesFor(c).PatientService.List(limit, offset)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch patients")
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   patients,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+func (s *Server) PostPatients(c *fiber.Ctx) error {
+	var patient models.Patient
+
+	if err := c.BodyParser(&patient); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	createdPatient, err := s.servicesFor(c).PatientService.Create(&patient)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create patient")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(createdPatient)
+}
+
+func (s *Server) GetPatientsId(c *fiber.Ctx, id string) error {
+	patient, err := s.servicesFor(c).PatientService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Patient not found")
+	}
+
+	return c.JSON(patient)
+}
+
+func (s *Server) PutPatientsId(c *fiber.Ctx, id string) error {
+	// Parse the request body into a map for partial updates
+	var updates map[string]interface{}
+	if err := c.BodyParser(&updates); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	updatedPatient, err := s.servicesFor(c).PatientService.Update(id, updates)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update patient")
+	}
+
+	return c.JSON(updatedPatient)
+}
+
+func (s *Server) DeletePatientsId(c *fiber.Ctx, id string) error {
+	if err := s.servicesFor(c).PatientService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete patient")
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+func (s *Server) GetPatientsPatientIdSessions(c *fiber.Ctx, patientId string, params GetPatientsPatientIdSessionsParams) error {
+	limit, offset := utils.ParseQueryParams(c)
+
+	sessions, total, err := s.servicesFor(c).PatientService.GetSessions(patientId, limit, offset)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch patient sessions")
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   sessions,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+func (s *Server) GetPatientsPatientIdSessionsSessionId(c *fiber.Ctx, patientId string, sessionId string) error {
+	session, err := s.servicesFor(c).SessionService.GetByPatientID(patientId, sessionId)
+	if err != nil {
+		return s.handleError(c, err, "Session not found")
+	}
+
+	return c.JSON(session)
+}
+
+func (s *Server) GetPatientsPatientIdProgress(c *fiber.Ctx, patientId string, params GetPatientsPatientIdProgressParams) error {
+	period := PeriodWeek
+	if params.Period != nil {
+		period = TimesheetPeriod(*params.Period)
+	}
+	var from, to string
+	if params.From != nil {
+		from = params.From.String()
+	}
+	if params.To != nil {
+		to = params.To.String()
+	}
+
+	progress, err := s.servicesFor(c).ProgressService.Progress(patientId, period, from, to, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch progress")
+	}
+
+	return c.JSON(progress)
+}
+
+/** SESSION SERIES HANDLERS **/
+func (s *Server) PostSessionSeries(c *fiber.Ctx) error {
+	var request SessionSeriesRequest
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	series := &models.SessionSeries{
+		PatientID:  request.PatientId,
+		StaffID:    request.StaffId,
+		BranchID:   request.BranchId,
+		Recurrence: request.Recurrence,
+		StartTime:  request.StartTime,
+		EndTime:    request.EndTime,
+	}
+	if request.Description != nil {
+		series.Description = *request.Description
+	}
+	if request.Shareable != nil {
+		series.Shareable = *request.Shareable
+	}
+
+	createdSeries, warnings, err := s.servicesFor(c).SessionSeriesService.Create(series)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create session series")
+	}
+
+	warn(c, warnings)
+	return c.Status(fiber.StatusCreated).JSON(createdSeries)
+}
+
+func (s *Server) GetSessionSeriesId(c *fiber.Ctx, id string) error {
+	series, err := s.servicesFor(c).SessionSeriesService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Session series not found")
+	}
+
+	return c.JSON(series)
+}
+
+func (s *Server) GetSessionSeriesIdSessions(c *fiber.Ctx, id string) error {
+	sessions, err := s.servicesFor(c).SessionSeriesService.Sessions(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch sessions")
+	}
+
+	return c.JSON(sessions)
+}
+
+func (s *Server) GetPatientsPatientIdSessionSeries(c *fiber.Ctx, patientId string) error {
+	series, err := s.servicesFor(c).SessionSeriesService.ListByPatient(patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch session series")
+	}
+
+	return c.JSON(series)
+}
+
+func (s *Server) PutSessionSeriesIdSessionsSessionId(c *fiber.Ctx, id string, sessionId string, params PutSessionSeriesIdSessionsSessionIdParams) error {
+	var request OccurrenceChanges
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	scope := ScopeThis
+	if params.Scope != nil {
+		scope = SeriesScope(*params.Scope)
+	}
+	changes := SeriesChanges{
+		StartTime:   request.StartTime,
+		EndTime:     request.EndTime,
+		StaffID:     request.StaffId,
+		Description: request.Description,
+		Shareable:   request.Shareable,
+		Recurrence:  request.Recurrence,
+	}
+
+	update, err := s.servicesFor(c).SessionSeriesService.UpdateOccurrence(id, sessionId, scope, changes)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update session series")
+	}
+
+	warn(c, update.Warnings)
+	return c.JSON(update)
+}
+
+func (s *Server) DeleteSessionSeriesIdSessionsSessionId(c *fiber.Ctx, id string, sessionId string, params DeleteSessionSeriesIdSessionsSessionIdParams) error {
+	scope := ScopeThis
+	if params.Scope != nil {
+		scope = SeriesScope(*params.Scope)
+	}
+
+	if err := s.servicesFor(c).SessionSeriesService.CancelOccurrence(id, sessionId, scope); err != nil {
+		return s.handleError(c, err, "Failed to cancel sessions")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+/** CALENDAR FEED HANDLERS **/
+func (s *Server) GetStaffIdCalendarFeeds(c *fiber.Ctx, id string) error {
+	feeds, err := s.auditedServices(c).CalendarService.StaffFeeds(viewerFrom(c), id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch calendar feeds")
+	}
+
+	return c.JSON(feeds)
+}
+
+func (s *Server) PostStaffIdCalendarFeeds(c *fiber.Ctx, id string) error {
+	feed, token, err := s.auditedServices(c).CalendarService.CreateStaffFeed(viewerFrom(c), id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create calendar feed")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"feed":  feed,
+		"token": token,
+		"url":   c.BaseURL() + "/calendar/staff/" + id + "?token=" + token,
+	})
+}
+
+func (s *Server) GetPatientsPatientIdCalendarFeeds(c *fiber.Ctx, patientId string) error {
+	feeds, err := s.servicesFor(c).CalendarService.PatientFeeds(patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch calendar feeds")
+	}
+
+	return c.JSON(feeds)
+}
+
+func (s *Server) PostPatientsPatientIdCalendarFeeds(c *fiber.Ctx, patientId string) error {
+	feed, token, err := s.servicesFor(c).CalendarService.CreatePatientFeed(viewerFrom(c), patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create calendar feed")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"feed":  feed,
+		"token": token,
+		"url":   c.BaseURL() + "/calendar/patients/" + patientId + "?token=" + token,
+	})
+}
+
+func (s *Server) DeleteCalendarFeedsId(c *fiber.Ctx, id int) error {
+	if err := s.auditedServices(c).CalendarService.Revoke(viewerFrom(c), id); err != nil {
+		return s.handleError(c, err, "Failed to revoke calendar feed")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s *Server) GetCalendarStaffId(c *fiber.Ctx, id string, params GetCalendarStaffIdParams) error {
+	feed, err := s.services.CalendarService.Open(params.Token)
+	if err == nil && (feed.StaffID == nil || *feed.StaffID != id) {
+		err = ErrInvalidFeedToken
+	}
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch calendar")
+	}
+
+	calendar, err := s.servicesAs(c, &feed.CreatedBy).CalendarService.StaffCalendar(id, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch calendar")
+	}
+
+	c.Set(fiber.HeaderContentType, ical.ContentType)
+	return c.Send(calendar.Bytes(time.Now()))
+}
+
+func (s *Server) GetCalendarPatientsPatientId(c *fiber.Ctx, patientId string, params GetCalendarPatientsPatientIdParams) error {
+	feed, err := s.services.CalendarService.Open(params.Token)
+	if err == nil && (feed.PatientID == nil || *feed.PatientID != patientId) {
+		err = ErrInvalidFeedToken
+	}
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch calendar")
+	}
+
+	calendar, err := s.servicesAs(c, &feed.CreatedBy).CalendarService.PatientCalendar(patientId, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch calendar")
+	}
+
+	c.Set(fiber.HeaderContentType, ical.ContentType)
+	return c.Send(calendar.Bytes(time.Now()))
+}
+
+/** TIMESHEET HANDLERS **/
+func (s *Server) GetStaffIdTimesheet(c *fiber.Ctx, id string, params GetStaffIdTimesheetParams) error {
+	period, date := PeriodWeek, ""
+	if params.Period != nil {
+		period = TimesheetPeriod(*params.Period)
+	}
+	if params.Date != nil {
+		date = params.Date.String()
+	}
+
+	timesheet, err := s.auditedServices(c).TimesheetService.ForStaff(viewerFrom(c), id, period, date, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch timesheet")
+	}
+
+	if params.Format != nil && *params.Format == GetStaffIdTimesheetParamsFormatCsv {
+		return sendTimesheets(c, []*Timesheet{timesheet}, "timesheet-"+timesheet.StaffID+"-"+timesheet.StartDate+".csv")
+	}
+	return c.JSON(timesheet)
+}
+
+func (s *Server) GetTimesheets(c *fiber.Ctx, params GetTimesheetsParams) error {
+	period, date := PeriodWeek, ""
+	if params.Period != nil {
+		period = TimesheetPeriod(*params.Period)
+	}
+	if params.Date != nil {
+		date = params.Date.String()
+	}
+
+	timesheets, err := s.auditedServices(c).TimesheetService.ForAll(period, date, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch timesheets")
+	}
+
+	if params.Format != nil && *params.Format == GetTimesheetsParamsFormatCsv {
+		name := "timesheets.csv"
+		if len(timesheets) > 0 {
+			name = "timesheets-" + timesheets[0].StartDate + ".csv"
+		}
+		return sendTimesheets(c, timesheets, name)
+	}
+	return c.JSON(timesheets)
+}
+
+// sendTimesheets sends timesheets as a CSV attachment
+func sendTimesheets(c *fiber.Ctx, timesheets []*Timesheet, filename string) error {
+	body, err := TimesheetsCSV(timesheets)
+	if err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+	c.Attachment(filename)
+	return c.Send(body)
+}
+
+/** BILLING HANDLERS **/
+func (s *Server) GetPatientsPatientIdInvoices(c *fiber.Ctx, patientId string) error {
+	invoices, err := s.servicesFor(c).InvoiceService.ListByPatient(patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch invoices")
+	}
+
+	return c.JSON(invoices)
+}
+
+func (s *Server) PostPatientsPatientIdInvoices(c *fiber.Ctx, patientId string) error {
+	var request InvoiceGeneration
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	invoiceRequest := InvoiceRequest{
+		From: request.From.String(),
+		To:   request.To.String(),
+	}
+	if request.GuardianId != nil {
+		invoiceRequest.GuardianID = *request.GuardianId
+	}
+
+	invoice, err := s.servicesFor(c).InvoiceService.Generate(viewerFrom(c), patientId, invoiceRequest)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create invoice")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(invoice)
+}
+
+func (s *Server) GetInvoicesId(c *fiber.Ctx, id int) error {
+	invoice, err := s.servicesFor(c).InvoiceService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch invoice")
+	}
+
+	return c.JSON(invoice)
+}
+
+func (s *Server) PostInvoicesIdVoid(c *fiber.Ctx, id int) error {
+	invoice, err := s.servicesFor(c).InvoiceService.Void(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to void invoice")
+	}
+
+	return c.JSON(invoice)
+}
+
+func (s *Server) PostInvoicesIdPayments(c *fiber.Ctx, id int) error {
+	var request PaymentRequest
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	payment := models.Payment{
+		Amount: request.Amount,
+		Method: models.PaymentMethod(request.Method),
+	}
+	if request.Reference != nil {
+		payment.Reference = *request.Reference
+	}
+	if request.ReceivedAt != nil {
+		payment.ReceivedAt = *request.ReceivedAt
+	}
+
+	invoice, err := s.servicesFor(c).InvoiceService.RecordPayment(viewerFrom(c), id, &payment)
+	if err != nil {
+		return s.handleError(c, err, "Failed to record payment")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(invoice)
+}
+
+func (s *Server) PostInvoicesIdTaxInvoice(c *fiber.Ctx, id int) error {
+	taxInvoice, err := s.servicesFor(c).TaxInvoiceService.Issue(viewerFrom(c), id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to issue tax invoice")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(taxInvoice)
+}
+
+func (s *Server) GetInvoicesIdTaxInvoice(c *fiber.Ctx, id int) error {
+	taxInvoice, err := s.servicesFor(c).TaxInvoiceService.Original(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch tax invoice")
+	}
+
+	return sendTaxInvoice(c, taxInvoice, taxInvoice.PDF)
+}
+
+func (s *Server) PostInvoicesIdTaxInvoiceReprint(c *fiber.Ctx, id int) error {
+	taxInvoice, document, err := s.servicesFor(c).TaxInvoiceService.Reprint(id, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to reprint tax invoice")
+	}
+
+	return sendTaxInvoice(c, taxInvoice, document)
+}
+
+// sendTaxInvoice sends a tax invoice's PDF as an attachment named after its number
+func sendTaxInvoice(c *fiber.Ctx, taxInvoice *models.TaxInvoice, document []byte) error {
+	c.Set(fiber.HeaderContentType, pdf.ContentType)
+	c.Attachment(strings.ReplaceAll(taxInvoice.Number, "/", "-") + ".pdf")
+	return c.Send(document)
+}
+
+func (s *Server) GetBranchesIdTaxInvoices(c *fiber.Ctx, id int, params GetBranchesIdTaxInvoicesParams) error {
+	var financialYear string
+	if params.FinancialYear != nil {
+		financialYear = *params.FinancialYear
+	}
+
+	taxInvoices, err := s.servicesFor(c).TaxInvoiceService.Register(id, financialYear)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch tax invoices")
+	}
+
+	return c.JSON(taxInvoices)
+}
+
+func (s *Server) GetGuardiansIdBalance(c *fiber.Ctx, id string) error {
+	balance, err := s.servicesFor(c).InvoiceService.GuardianBalance(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch balance")
+	}
+
+	return c.JSON(balance)
+}
+
+func (s *Server) GetRateCards(c *fiber.Ctx, params GetRateCardsParams) error {
+	var therapyType string
+	if params.TherapyType != nil {
+		therapyType = string(*params.TherapyType)
+	}
+
+	cards, err := s.services.PricingService.RateCards(therapyType)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch rate cards")
+	}
+
+	return c.JSON(cards)
+}
+
+func (s *Server) PostRateCards(c *fiber.Ctx) error {
+	var request RateCard
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	card, err := s.services.PricingService.CreateRateCard(&models.RateCard{
+		TherapyType:   string(request.TherapyType),
+		BranchID:      request.BranchId,
+		MinMinutes:    request.MinMinutes,
+		MaxMinutes:    request.MaxMinutes,
+		Price:         request.Price,
+		EffectiveFrom: request.EffectiveFrom.String(),
+	})
+	if err != nil {
+		return s.handleError(c, err, "Failed to create rate card")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(card)
+}
+
+func (s *Server) DeleteRateCardsId(c *fiber.Ctx, id int) error {
+	if err := s.services.PricingService.DeleteRateCard(id); err != nil {
+		return s.handleError(c, err, "Failed to delete rate card")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s *Server) GetDiscountRules(c *fiber.Ctx) error {
+	rules, err := s.services.PricingService.DiscountRules()
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch discount rules")
+	}
+
+	return c.JSON(rules)
+}
+
+func (s *Server) PostDiscountRules(c *fiber.Ctx) error {
+	var request DiscountRule
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	rule, err := s.services.PricingService.CreateDiscountRule(discountRuleFrom(request))
+	if err != nil {
+		return s.handleError(c, err, "Failed to create discount rule")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(rule)
+}
+
+func (s *Server) PutDiscountRulesId(c *fiber.Ctx, id int) error {
+	var request DiscountRule
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	rule, err := s.services.PricingService.UpdateDiscountRule(id, discountRuleFrom(request))
+	if err != nil {
+		return s.handleError(c, err, "Failed to update discount rule")
+	}
+
+	return c.JSON(rule)
+}
+
+// discountRuleFrom converts a discount rule request, which is active unless it says otherwise
+func discountRuleFrom(request DiscountRule) *models.DiscountRule {
+	rule := &models.DiscountRule{
+		Name:    request.Name,
+		Percent: request.Percent,
+		Active:  request.Active == nil || *request.Active,
+	}
+	if request.Kind != nil {
+		rule.Kind = models.DiscountKind(*request.Kind)
+	}
+	return rule
+}
+
+func (s *Server) PutPatientsPatientIdDiscount(c *fiber.Ctx, patientId string) error {
+	var request PatientDiscountRequest
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	discount, err := s.servicesFor(c).PricingService.SetPatientDiscount(patientId, request.DiscountRuleId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to give discount")
+	}
+
+	return c.JSON(fiber.Map{
+		"patient_id":    discount.PatientID,
+		"discount_rule": discount.DiscountRule,
+	})
+}
+
+func (s *Server) DeletePatientsPatientIdDiscount(c *fiber.Ctx, patientId string) error {
+	if err := s.servicesFor(c).PricingService.RemovePatientDiscount(patientId); err != nil {
+		return s.handleError(c, err, "Failed to remove discount")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s *Server) GetPatientsPatientIdSessionEstimate(c *fiber.Ctx, patientId string, params GetPatientsPatientIdSessionEstimateParams) error {
+	var date string
+	if params.Date != nil {
+		date = params.Date.String()
+	}
+
+	quote, err := s.servicesFor(c).PricingService.Estimate(patientId, params.BranchId, params.Minutes, date)
+	if err != nil {
+		return s.handleError(c, err, "Failed to estimate session cost")
+	}
+
+	return c.JSON(quote)
+}
+
+func (s *Server) GetReceivablesAging(c *fiber.Ctx) error {
+	report, err := s.servicesFor(c).InvoiceService.Aging(time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch receivables aging")
+	}
+
+	return c.JSON(report)
+}
+
+/** STAFF HANDLERS **/
+func (s *Server) GetStaff(c *fiber.Ctx, params GetStaffParams) error {
+	limit, offset := utils.ParseQueryParams(c)
+
+	staff, total, err := s.services.StaffService.List(limit, offset)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch staff")
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   staff,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+func (s *Server) PostStaff(c *fiber.Ctx) error {
+	var staff models.Staff
+
+	if err := c.BodyParser(&staff); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := s.setStaffPassword(c, &staff); err != nil {
+		return s.handleError(c, err, "Failed to set password")
+	}
+
+	createdStaff, err := s.services.StaffService.Create(&staff)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create staff")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(createdStaff)
+}
+
+func (s *Server) GetStaffId(c *fiber.Ctx, id string) error {
+	staff, err := s.services.StaffService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Staff not found")
+	}
+
+	return c.JSON(staff)
+}
+
+func (s *Server) PutStaffId(c *fiber.Ctx, id string) error {
+	// Parse the request body into a map for partial updates
+	var updates map[string]interface{}
+	if err := c.BodyParser(&updates); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	// The password is stored only as a hash
+	var staff models.Staff
+	if err := s.setStaffPassword(c, &staff); err != nil {
+		return s.handleError(c, err, "Failed to set password")
+	}
+	delete(updates, "password")
+	delete(updates, "password_hash")
+	if staff.PasswordHash != "" {
+		updates["password_hash"] = staff.PasswordHash
+	}
+
+	updatedStaff, err := s.services.StaffService.Update(id, updates)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update staff")
+	}
+
+	return c.JSON(updatedStaff)
+}
+
+func (s *Server) DeleteStaffId(c *fiber.Ctx, id string) error {
+	if err := s.services.StaffService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete staff")
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+func (s *Server) GetStaffIdSessions(c *fiber.Ctx, id string, params GetStaffIdSessionsParams) error {
+	limit, offset := utils.ParseQueryParams(c)
+
+	sessions, total, err := s.servicesFor(c).StaffService.GetSessions(id, limit, offset)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch staff sessions")
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   sessions,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+/** ACTIVITY HANDLERS **/
+func (s *Server) GetStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId string, sessionId string, params GetStaffStaffIdSessionsSessionIdActivitiesParams) error {
+	limit, offset := utils.ParseQueryParams(c)
+
+	activities, total, err := s.servicesFor(c).ActivityService.GetBySessionAndStaff(staffId, sessionId, limit, offset)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch activities")
+	}
+
+	return c.JSON(fiber.Map{
+		"data":   activities,
+		"total":  total,
+		"limit":  limit,
+		"offset": offset,
+	})
+}
+
+func (s *Server) PostStaffStaffIdSessionsSessionIdActivities(c *fiber.Ctx, staffId string, sessionId string) error {
+	if err := s.services.AuthorizationService.CanWriteSession(c, sessionId); err != nil {
+		return s.handleError(c, err, "Failed to create activity")
+	}
+
+	var activity models.Activity
+	if err := c.BodyParser(&activity); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := s.servicesFor(c).TreatmentPlanService.CheckActivityTarget(sessionId, activity.TargetID); err != nil {
+		return s.handleError(c, err, "Failed to create activity")
+	}
+
+	// The session comes from the URL
+	createdActivity, err := s.servicesFor(c).ActivityService.Create(staffId, sessionId, &activity)
+	if err != nil {
+		return s.handleError(c, err, "Failed to create activity")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(createdActivity)
+}
+
+func (s *Server) GetStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId string, sessionId string, id string) error {
+	activity, err := s.servicesFor(c).ActivityService.GetSpecific(staffId, sessionId, id)
+	if err != nil {
+		return s.handleError(c, err, "Activity not found")
+	}
+
+	return c.JSON(activity)
+}
+
+func (s *Server) PutStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId string, sessionId string, id string) error {
+	if err := s.services.AuthorizationService.CanWriteSession(c, sessionId); err != nil {
+		return s.handleError(c, err, "Failed to update activity")
+	}
+
+	var activity models.Activity
+	if err := c.BodyParser(&activity); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := s.servicesFor(c).TreatmentPlanService.CheckActivityTarget(sessionId, activity.TargetID); err != nil {
+		return s.handleError(c, err, "Failed to update activity")
+	}
+
+	updatedActivity, err := s.servicesFor(c).ActivityService.Update(staffId, sessionId, id, &activity)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update activity")
+	}
+
+	return c.JSON(updatedActivity)
+}
+
+func (s *Server) DeleteStaffStaffIdSessionsSessionIdActivitiesId(c *fiber.Ctx, staffId string, sessionId string, id string) error {
+	if err := s.services.AuthorizationService.CanWriteSession(c, sessionId); err != nil {
+		return s.handleError(c, err, "Failed to delete activity")
+	}
+
+	if err := s.servicesFor(c).ActivityService.Delete(staffId, sessionId, id); err != nil {
+		return s.handleError(c, err, "Failed to delete activity")
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+/** TRIAL HANDLERS **/
+func (s *Server) GetActivitiesIdTrials(c *fiber.Ctx, id string) error {
+	trials, err := s.servicesFor(c).TrialService.List(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch trials")
+	}
+
+	return c.JSON(trials)
+}
+
+func (s *Server) PostActivitiesIdTrials(c *fiber.Ctx, id string) error {
+	var request TrialBatch
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	services := s.servicesFor(c)
+	activity, err := services.TrialService.FindActivity(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to record trials")
+	}
+	if activity.SessionID != nil {
+		if err := s.services.AuthorizationService.CanWriteSession(c, *activity.SessionID); err != nil {
+			return s.handleError(c, err, "Failed to record trials")
+		}
+	}
+
+	trials := make([]*models.Trial, 0, len(request.Trials))
+	for _, trial := range request.Trials {
+		record := &models.Trial{Outcome: models.TrialOutcome(trial.Outcome)}
+		if trial.PromptLevel != nil {
+			level := models.PromptLevel(*trial.PromptLevel)
+			record.PromptLevel = &level
+		}
+		trials = append(trials, record)
+	}
+
+	result, err := services.TrialService.Record(viewerFrom(c), id, trials, time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to record trials")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(result)
+}
+
+func (s *Server) DeleteTrialsId(c *fiber.Ctx, id int) error {
+	services := s.servicesFor(c)
+	trial, err := services.TrialService.Get(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to delete trial")
+	}
+	if trial.Activity.SessionID != nil {
+		if err := s.services.AuthorizationService.CanWriteSession(c, *trial.Activity.SessionID); err != nil {
+			return s.handleError(c, err, "Failed to delete trial")
+		}
+	}
+
+	if err := services.TrialService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete trial")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+/** BEHAVIOR INCIDENT HANDLERS **/
+func (s *Server) PostSessionsIdBehaviorIncidents(c *fiber.Ctx, id string) error {
+	var request BehaviorIncident
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := s.services.AuthorizationService.CanWriteSession(c, id); err != nil {
+		return s.handleError(c, err, "Failed to record behavior incident")
+	}
+
+	incident, err := s.servicesFor(c).IncidentService.Create(viewerFrom(c), id, behaviorIncidentFrom(request), time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to record behavior incident")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(incident)
+}
+
+func (s *Server) GetPatientsPatientIdBehaviorIncidents(c *fiber.Ctx, patientId string, params GetPatientsPatientIdBehaviorIncidentsParams) error {
+	var from, to, behaviorType string
+	if params.From != nil {
+		from = params.From.String()
+	}
+	if params.To != nil {
+		to = params.To.String()
+	}
+	if params.BehaviorType != nil {
+		behaviorType = *params.BehaviorType
+	}
+
+	incidents, err := s.servicesFor(c).IncidentService.ListByPatient(patientId, from, to, behaviorType)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch behavior incidents")
+	}
+
+	return c.JSON(incidents)
+}
+
+func (s *Server) GetPatientsPatientIdBehaviorSummary(c *fiber.Ctx, patientId string, params GetPatientsPatientIdBehaviorSummaryParams) error {
+	var from, to, behaviorType string
+	if params.From != nil {
+		from = params.From.String()
+	}
+	if params.To != nil {
+		to = params.To.String()
+	}
+	if params.BehaviorType != nil {
+		behaviorType = *params.BehaviorType
+	}
+
+	summary, err := s.servicesFor(c).IncidentService.Summary(patientId, from, to, behaviorType)
+	if err != nil {
+		return s.handleError(c, err, "Failed to summarise behavior incidents")
+	}
+
+	return c.JSON(summary)
+}
+
+func (s *Server) GetBehaviorIncidentsId(c *fiber.Ctx, id int) error {
+	incident, err := s.servicesFor(c).IncidentService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch behavior incident")
+	}
+
+	return c.JSON(incident)
+}
+
+func (s *Server) PutBehaviorIncidentsId(c *fiber.Ctx, id int) error {
+	var request BehaviorIncident
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	services := s.servicesFor(c)
+	existing, err := services.IncidentService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update behavior incident")
+	}
+	if err := s.services.AuthorizationService.CanWriteSession(c, existing.SessionID); err != nil {
+		return s.handleError(c, err, "Failed to update behavior incident")
+	}
+
+	incident, err := services.IncidentService.Update(id, behaviorIncidentFrom(request), time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to update behavior incident")
+	}
+
+	return c.JSON(incident)
+}
+
+func (s *Server) DeleteBehaviorIncidentsId(c *fiber.Ctx, id int) error {
+	services := s.servicesFor(c)
+	incident, err := services.IncidentService.GetByID(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to delete behavior incident")
+	}
+	if err := s.services.AuthorizationService.CanWriteSession(c, incident.SessionID); err != nil {
+		return s.handleError(c, err, "Failed to delete behavior incident")
+	}
+
+	if err := services.IncidentService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete behavior incident")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// behaviorIncidentFrom converts an incident request. A missing occurrence time is left for the service to default.
+func behaviorIncidentFrom(request BehaviorIncident) *models.BehaviorIncident {
+	incident := &models.BehaviorIncident{
+		BehaviorType:    request.BehaviorType,
+		Intensity:       models.BehaviorIntensity(request.Intensity),
+		DurationSeconds: request.DurationSeconds,
+		Antecedent:      request.Antecedent,
+		Behavior:        request.Behavior,
+		Consequence:     request.Consequence,
+	}
+	if request.OccurredAt != nil {
+		incident.OccurredAt = *request.OccurredAt
+	}
+	if request.Setting != nil {
+		incident.Setting = *request.Setting
+	}
+	if request.StaffResponse != nil {
+		incident.StaffResponse = *request.StaffResponse
+	}
+	if request.FunctionHypothesis != nil {
+		incident.FunctionHypothesis = models.BehaviorFunction(*request.FunctionHypothesis)
+	}
+	return incident
+}
+
+/** BEHAVIOR MEASUREMENT HANDLERS **/
+func (s *Server) GetPatientsPatientIdBehaviorMeasurements(c *fiber.Ctx, patientId string) error {
+	measurements, err := s.servicesFor(c).MeasurementService.ListByPatient(patientId)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch behavior measurements")
+	}
+
+	return c.JSON(measurements)
+}
+
+func (s *Server) PostPatientsPatientIdBehaviorMeasurements(c *fiber.Ctx, patientId string) error {
+	var request BehaviorMeasurement
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	measurement, err := s.servicesFor(c).MeasurementService.Create(viewerFrom(c), patientId, behaviorMeasurementFrom(request))
+	if err != nil {
+		return s.handleError(c, err, "Failed to create behavior measurement")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(measurement)
+}
+
+func (s *Server) GetBehaviorMeasurementsId(c *fiber.Ctx, id int) error {
+	measurement, err := s.servicesFor(c).MeasurementService.Get(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch behavior measurement")
+	}
+
+	return c.JSON(measurement)
+}
+
+func (s *Server) PutBehaviorMeasurementsId(c *fiber.Ctx, id int) error {
+	var request BehaviorMeasurement
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	measurement, err := s.servicesFor(c).MeasurementService.Update(id, behaviorMeasurementFrom(request))
+	if err != nil {
+		return s.handleError(c, err, "Failed to update behavior measurement")
+	}
+
+	return c.JSON(measurement)
+}
+
+func (s *Server) DeleteBehaviorMeasurementsId(c *fiber.Ctx, id int) error {
+	if err := s.servicesFor(c).MeasurementService.Delete(id); err != nil {
+		return s.handleError(c, err, "Failed to delete behavior measurement")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+func (s *Server) GetBehaviorMeasurementsIdDataPoints(c *fiber.Ctx, id int, params GetBehaviorMeasurementsIdDataPointsParams) error {
+	var from, to string
+	if params.From != nil {
+		from = params.From.String()
+	}
+	if params.To != nil {
+		to = params.To.String()
+	}
+
+	graph, err := s.servicesFor(c).MeasurementService.Graph(id, from, to)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch data points")
+	}
+
+	return c.JSON(graph)
+}
+
+func (s *Server) GetSessionsIdDataPoints(c *fiber.Ctx, id string) error {
+	points, err := s.servicesFor(c).MeasurementService.ListBySession(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to fetch data points")
+	}
+
+	return c.JSON(points)
+}
+
+func (s *Server) PostSessionsIdDataPoints(c *fiber.Ctx, id string) error {
+	var request MeasurementDataPoint
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	if err := s.services.AuthorizationService.CanWriteSession(c, id); err != nil {
+		return s.handleError(c, err, "Failed to record data point")
+	}
+
+	point, err := s.servicesFor(c).MeasurementService.Record(viewerFrom(c), id, dataPointFrom(request), time.Now())
+	if err != nil {
+		return s.handleError(c, err, "Failed to record data point")
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(point)
+}
+
+func (s *Server) PutDataPointsId(c *fiber.Ctx, id int) error {
+	var request MeasurementDataPoint
+
+	if err := c.BodyParser(&request); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	services := s.servicesFor(c)
+	existing, err := services.MeasurementService.GetDataPoint(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to update data point")
+	}
+	if err := s.services.AuthorizationService.CanWriteSession(c, existing.SessionID); err != nil {
+		return s.handleError(c, err, "Failed to update data point")
+	}
+
+	point, err := services.MeasurementService.UpdateDataPoint(id, dataPointFrom(request))
+	if err != nil {
+		return s.handleError(c, err, "Failed to update data point")
+	}
+
+	return c.JSON(point)
+}
+
+func (s *Server) DeleteDataPointsId(c *fiber.Ctx, id int) error {
+	services := s.servicesFor(c)
+	point, err := services.MeasurementService.GetDataPoint(id)
+	if err != nil {
+		return s.handleError(c, err, "Failed to delete data point")
+	}
+	if err := s.services.AuthorizationService.CanWriteSession(c, point.SessionID); err != nil {
+		return s.handleError(c, err, "Failed to delete data point")
+	}
+
+	if err := services.MeasurementService.DeleteDataPoint(id); err != nil {
+		return s.handleError(c, err, "Failed to delete data point")
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// behaviorMeasurementFrom converts a measurement request. Measurements are active unless it says otherwise.
+func behaviorMeasurementFrom(request BehaviorMeasurement) *models.BehaviorMeasurement {
+	measurement := &models.BehaviorMeasurement{
+		Behavior:        request.Behavior,
+		Definition:      request.Definition,
+		Method:          models.MeasurementMethod(request.Method),
+		IntervalSeconds: request.IntervalSeconds,
+		Active:          true,
+	}
+	if request.Active != nil {
+		measurement.Active = *request.Active
+	}
+	return measurement
+}
+
+// dataPointFrom converts a data point request. A missing observation time is left for the service to default.
+func dataPointFrom(request MeasurementDataPoint) *models.MeasurementDataPoint {
+	point := &models.MeasurementDataPoint{
+		MeasurementID:   request.MeasurementId,
+		Count:           request.Count,
+		DurationSeconds: request.DurationSeconds,
+		Intervals:       request.Intervals,
+		IntervalsScored: request.IntervalsScored,
+	}
+	if request.ObservationSeconds != nil {
+		point.ObservationSeconds = *request.ObservationSeconds
+	}
+	return point
+}
+
+// setStaffPassword hashes the write-only password field of a staff request body, if one was sent
+func (s *Server) setStaffPassword(c *fiber.Ctx, staff *models.Staff) error {
+	var body struct {
+		Password string `json:"password"`
+	}
+	if err := c.BodyParser(&body); err != nil || body.Password == "" {
+		return nil
+	}
+
+	hash, err := auth.HashPassword(body.Password)
+	if err != nil {
+		return err
+	}
+	staff.PasswordHash = hash
+	return nil
+}
+
+// guardianID returns the signed-in guardian, or an empty ID for any other caller
+func guardianID(c *fiber.Ctx) string {
+	claims, ok := auth.ClaimsFrom(c)
+	if !ok {
+		return ""
+	}
+	return claims.GuardianID()
+}
+
+func emailOrEmpty(email *openapi_types.Email) string {
+	if email == nil {
+		return ""
+	}
+	return string(*email)
+}
+
+// warn adds a Warning header for each problem a change was saved despite
+func warn(c *fiber.Ctx, warnings []string) {
+	for _, warning := range warnings {
+		c.Append(fiber.HeaderWarning, "299 - "+strconv.Quote(warning))
+	}
+}
+
+func stringOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// Helper method for consistent error handling
+func (s *Server) handleError(c *fiber.Ctx, err error, message string) error {
+	var forbidden *ForbiddenError
+	if errors.As(err, &forbidden) {
+		return forbidden.respond(c)
+	}
+	var conflict *SeriesConflictError
+	if errors.As(err, &conflict) {
+		return conflict.respond(c)
+	}
+	var outside *OutsideHoursError
+	if errors.As(err, &outside) {
+		return outside.respond(c)
+	}
+	var closed *BranchClosedError
+	if errors.As(err, &closed) {
+		return closed.respond(c)
+	}
+	var rescheduleConflict *RescheduleConflictError
+	if errors.As(err, &rescheduleConflict) {
+		return rescheduleConflict.respond(c)
+	}
+
+	// Map common business logic errors to appropriate HTTP status codes
+	switch err.Error() {
+	case "invalid credentials", "invalid or expired code", "invalid or revoked calendar feed token":
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	case "patient not found", "staff member not found", "session not found", "activity not found", "branch not found", "medicine not found", "assessment not found", "question not found", "onboarding response not found", "assessment administration not found", "session series not found", "session is not part of the series", "branch closure not found", "calendar feed not found", "invoice not found", "guardian not found", "rate card not found", "discount rule not found", "tax invoice not found", "treatment plan not found", "treatment goal not found", "treatment target not found", "trial not found", "behavior incident not found", "behavior measurement not found", "data point not found":
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"error": err.Error(),
 		})
-	case "patient ID is required", "staff ID is required", "invalid session ID", "invalid patient ID", "invalid staff ID":
+	case "patient ID is required", "staff ID is required", "invalid session ID", "invalid patient ID", "invalid staff ID", "medicine name is required", "email or phone number is required":
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": err.Error(),
 		})
-	case "staff member has overlapping session at this time", "cannot delete session with existing activities", "cannot delete sessions older than 24 hours":
+	case "question has been retired", "assessment administration is completed", "only upcoming sessions can be changed through their series", "staff member has overlapping session at this time", "cannot delete session with existing activities", "cannot delete sessions older than 24 hours", "only open invoices can take payments", "invoices with payments can't be voided", "rate card overlaps another for the same therapy type, branch and date", "rate card has been used on invoices", "only paid invoices get a tax invoice", "the patient already has an active treatment plan", "only draft treatment plans can be deleted", "completed and discontinued treatment plans can't be changed", "targets practised in activities can't be deleted", "activities can only practise targets of an active treatment plan that haven't been discontinued", "behavior measurements with data points can't be deleted; deactivate them instead", "the method and interval of a behavior measurement with data points can't be changed", "behavior measurement is inactive", "the session already has a data point for this measurement", "the dose has already been recorded", "the medicine has been discontinued", "medicines with doses or prescription changes recorded can't be deleted; discontinue them instead":
 		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
 			"error": err.Error(),
 		})
-	case "session does not belong to the specified patient":
+	case "session does not belong to the specified patient", "only admins can manage another staff member's calendar feeds", "only admins can view another staff member's timesheet", "only admins can view another doctor's prescription reminders":
 		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 			"error": err.Error(),
 		})
@@ -0,0 +1,624 @@
+package service
+
+// backend/internal/service/session_series_service.go
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/recurrence"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrSeriesNotFound     = errors.New("session series not found")
+	ErrOccurrenceNotFound = errors.New("session is not part of the series")
+	ErrOccurrenceStarted  = errors.New("only upcoming sessions can be changed through their series")
+)
+
+// SeriesScope says which occurrences an edit or cancellation applies to
+type SeriesScope string
+
+const (
+	ScopeThis      SeriesScope = "this"
+	ScopeFollowing SeriesScope = "following"
+)
+
+// SeriesChanges are edits to an occurrence of a series. Applied to all following occurrences,
+// the new start and end times set the time of day of the rest of the series.
+type SeriesChanges struct {
+	StartTime   *time.Time
+	EndTime     *time.Time
+	StaffID     *string
+	Description *string
+	Shareable   *bool
+	Recurrence  *string // Only for all following occurrences
+}
+
+// OccurrenceUpdate is an edited occurrence and the series it now belongs to. Editing all
+// following occurrences splits them into a new series, whose first session may be a
+// different day if the new recurrence skips the edited one.
+type OccurrenceUpdate struct {
+	Series   *models.SessionSeries `json:"series"`
+	Session  *models.Session       `json:"session"`
+	Warnings []string              `json:"-"`
+}
+
+// OccurrenceConflict is an occurrence that can't be scheduled, and why
+type OccurrenceConflict struct {
+	OccursAt time.Time `json:"occurs_at"`
+	Reason   string    `json:"reason"`
+}
+
+// SeriesConflictError lists the occurrences that would overlap another session of the staff
+// member or fall outside the hours of a branch that enforces them
+type SeriesConflictError struct {
+	Conflicts []OccurrenceConflict
+}
+
+func (e *SeriesConflictError) Error() string {
+	return "some occurrences can't be scheduled"
+}
+
+// respond writes the error as a 409 listing the conflicting occurrences
+func (e *SeriesConflictError) respond(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+		"error":     e.Error(),
+		"conflicts": e.Conflicts,
+	})
+}
+
+type SessionSeriesServiceInterface interface {
+	Create(series *models.SessionSeries) (*models.SessionSeries, []string, error)
+	GetByID(id string) (*models.SessionSeries, error)
+	ListByPatient(patientID string) ([]*models.SessionSeries, error)
+	Sessions(id string) ([]*models.Session, error)
+	UpdateOccurrence(id, sessionID string, scope SeriesScope, changes SeriesChanges) (*OccurrenceUpdate, error)
+	CancelOccurrence(id, sessionID string, scope SeriesScope) error
+	Extend(now time.Time) error
+}
+
+type SessionSeriesService struct {
+	repo     *repository.Repository
+	location *time.Location
+	window   time.Duration
+}
+
+func NewSessionSeriesService(repo *repository.Repository, scheduling config.Scheduling) SessionSeriesServiceInterface {
+	return &SessionSeriesService{repo: repo, location: scheduling.Location(), window: scheduling.SeriesHorizon}
+}
+
+// Create a series and generate its sessions up to the horizon. Nothing is saved if any
+// occurrence can't be scheduled. The warnings are about occurrences outside the hours of
+// a branch that only warns about them.
+func (s *SessionSeriesService) Create(series *models.SessionSeries) (*models.SessionSeries, []string, error) {
+	if staffID := s.repo.Viewer().OwnStaffID(); staffID != "" {
+		series.StaffID = staffID
+	}
+	if series.PatientID == "" {
+		return nil, nil, errors.New("patient ID is required")
+	}
+	if series.StaffID == "" {
+		return nil, nil, errors.New("staff ID is required")
+	}
+	if err := s.checkPatient(series.PatientID); err != nil {
+		return nil, nil, err
+	}
+	if err := s.checkStaff(series.StaffID); err != nil {
+		return nil, nil, err
+	}
+	if err := checkTimes(series.StartTime, series.EndTime); err != nil {
+		return nil, nil, err
+	}
+	if err := s.normalize(series); err != nil {
+		return nil, nil, err
+	}
+	series.ID = uuid.NewString()
+
+	var warnings []string
+	err := s.repo.Transaction(func(repo *repository.Repository) error {
+		if err := repo.SessionSeries.Create(series); err != nil {
+			return err
+		}
+		var err error
+		warnings, err = s.generate(repo, series, s.horizon(time.Now()))
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return series, warnings, nil
+}
+
+// Get a series by ID. Series of patients outside the caller's caseload aren't found.
+func (s *SessionSeriesService) GetByID(id string) (*models.SessionSeries, error) {
+	series, err := s.repo.SessionSeries.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrSeriesNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkPatient(series.PatientID); err != nil {
+		if err.Error() == "patient not found" {
+			return nil, ErrSeriesNotFound
+		}
+		return nil, err
+	}
+	return series, nil
+}
+
+// List a patient's series, including ones that have ended
+func (s *SessionSeriesService) ListByPatient(patientID string) ([]*models.SessionSeries, error) {
+	if err := s.checkPatient(patientID); err != nil {
+		return nil, err
+	}
+	return s.repo.SessionSeries.FindByPatientID(patientID)
+}
+
+// List the sessions generated from a series
+func (s *SessionSeriesService) Sessions(id string) ([]*models.Session, error) {
+	if _, err := s.GetByID(id); err != nil {
+		return nil, err
+	}
+	return s.repo.Session.FindBySeriesID(id)
+}
+
+// UpdateOccurrence edits one upcoming occurrence, or it and every later one. Edits to a single
+// occurrence change only its session. Edits to all following occurrences end the series before
+// the occurrence and continue it as a new series with the changes, regenerating its sessions.
+func (s *SessionSeriesService) UpdateOccurrence(id, sessionID string, scope SeriesScope, changes SeriesChanges) (*OccurrenceUpdate, error) {
+	series, session, err := s.occurrence(id, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if staffID := s.repo.Viewer().OwnStaffID(); staffID != "" && changes.StaffID != nil {
+		changes.StaffID = &staffID
+	}
+	if changes.StaffID != nil {
+		if err := s.checkStaff(*changes.StaffID); err != nil {
+			return nil, err
+		}
+	}
+
+	switch scope {
+	case ScopeThis:
+		if changes.Recurrence != nil {
+			return nil, errors.New("recurrence can only change for all following sessions")
+		}
+		return s.updateThis(series, session, changes)
+	case ScopeFollowing:
+		return s.updateFollowing(series, session, changes)
+	default:
+		return nil, errors.New("scope must be this or following")
+	}
+}
+
+func (s *SessionSeriesService) updateThis(series *models.SessionSeries, session *models.Session, changes SeriesChanges) (*OccurrenceUpdate, error) {
+	start, end, staffID := session.StartTime, session.EndTime, session.StaffID
+	if changes.StartTime != nil {
+		start = changes.StartTime.UTC()
+	}
+	if changes.EndTime != nil {
+		end = changes.EndTime.UTC()
+	} else if changes.StartTime != nil {
+		end = start.Add(session.EndTime.Sub(session.StartTime))
+	}
+	if changes.StaffID != nil {
+		staffID = *changes.StaffID
+	}
+	if err := checkTimes(start, end); err != nil {
+		return nil, err
+	}
+
+	overlapping, err := s.repo.Session.CheckOverlappingSessions(staffID, start, end, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	if overlapping {
+		return nil, errors.New("staff member has overlapping session at this time")
+	}
+	warnings, err := checkSchedule(s.repo, s.location, session.BranchID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{
+		"start_time": start,
+		"end_time":   end,
+		"staff_id":   staffID,
+		"closure_id": nil,
+	}
+	if changes.Description != nil {
+		updates["description"] = *changes.Description
+	}
+	if changes.Shareable != nil {
+		updates["shareable"] = *changes.Shareable
+	}
+	if _, err := s.repo.Session.Update(session.ID, updates); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repo.Session.FindByID(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &OccurrenceUpdate{Series: series, Session: updated, Warnings: warnings}, nil
+}
+
+func (s *SessionSeriesService) updateFollowing(series *models.SessionSeries, session *models.Session, changes SeriesChanges) (*OccurrenceUpdate, error) {
+	from := *session.OccursAt
+	duration := series.EndTime.Sub(series.StartTime)
+
+	next := &models.SessionSeries{
+		ID:          uuid.NewString(),
+		PatientID:   series.PatientID,
+		StaffID:     series.StaffID,
+		BranchID:    series.BranchID,
+		Recurrence:  series.Recurrence,
+		StartTime:   from,
+		EndTime:     from.Add(duration),
+		Description: series.Description,
+		Shareable:   series.Shareable,
+	}
+	if changes.StartTime != nil {
+		next.StartTime = changes.StartTime.UTC()
+		next.EndTime = next.StartTime.Add(duration)
+	}
+	if changes.EndTime != nil {
+		next.EndTime = changes.EndTime.UTC()
+	}
+	if changes.StaffID != nil {
+		next.StaffID = *changes.StaffID
+	}
+	if changes.Description != nil {
+		next.Description = *changes.Description
+	}
+	if changes.Shareable != nil {
+		next.Shareable = *changes.Shareable
+	}
+	if changes.Recurrence != nil {
+		next.Recurrence = *changes.Recurrence
+	}
+	if err := checkTimes(next.StartTime, next.EndTime); err != nil {
+		return nil, err
+	}
+	if err := s.normalize(next); err != nil {
+		return nil, err
+	}
+
+	// The new series replaces sessions as far ahead as the old one had them
+	until := s.horizon(time.Now())
+	if series.GeneratedUntil.After(until) {
+		until = series.GeneratedUntil
+	}
+
+	var warnings []string
+	err := s.repo.Transaction(func(repo *repository.Repository) error {
+		if err := s.truncate(repo, series, from); err != nil {
+			return err
+		}
+		if !from.After(series.StartTime) {
+			// Nothing is left of the old series, so it's replaced outright
+			if err := repo.SessionSeries.Delete(series.ID); err != nil {
+				return err
+			}
+		}
+		if err := repo.SessionSeries.Create(next); err != nil {
+			return err
+		}
+		var err error
+		warnings, err = s.generate(repo, next, until)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	update := &OccurrenceUpdate{Series: next, Warnings: warnings}
+	sessions, err := s.repo.Session.FindBySeriesID(next.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) > 0 {
+		update.Session = sessions[0]
+	}
+	return update, nil
+}
+
+// CancelOccurrence cancels one upcoming occurrence, or it and every later one, deleting their
+// sessions. A series cancelled from its first occurrence is deleted.
+func (s *SessionSeriesService) CancelOccurrence(id, sessionID string, scope SeriesScope) error {
+	series, session, err := s.occurrence(id, sessionID)
+	if err != nil {
+		return err
+	}
+
+	switch scope {
+	case ScopeThis:
+		return s.repo.Transaction(func(repo *repository.Repository) error {
+			if err := deleteSession(repo, session); err != nil {
+				return err
+			}
+			return repo.SessionSeries.AddException(&models.SessionSeriesException{
+				SeriesID: series.ID,
+				OccursAt: *session.OccursAt,
+			})
+		})
+	case ScopeFollowing:
+		from := *session.OccursAt
+		return s.repo.Transaction(func(repo *repository.Repository) error {
+			if err := s.truncate(repo, series, from); err != nil {
+				return err
+			}
+			if !from.After(series.StartTime) {
+				return repo.SessionSeries.Delete(series.ID)
+			}
+			return nil
+		})
+	default:
+		return errors.New("scope must be this or following")
+	}
+}
+
+// Extend generates the sessions of every series up to the horizon. Occurrences that can't be
+// scheduled are skipped and logged, since no one is there to ask, and tried again on later
+// runs until they're due in case whatever was in the way moves.
+func (s *SessionSeriesService) Extend(now time.Time) error {
+	horizon := s.horizon(now)
+	pending, err := s.repo.SessionSeries.FindGeneratedBefore(horizon)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, found := range pending {
+		// The conflict is handled inside the transaction so the occurrences that were saved commit
+		err := s.repo.Transaction(func(repo *repository.Repository) error {
+			// Another server may have extended the series since it was found
+			series, err := repo.SessionSeries.FindForUpdate(found.ID)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			_, err = s.generate(repo, series, horizon)
+			var conflict *SeriesConflictError
+			if !errors.As(err, &conflict) {
+				return err
+			}
+			slog.Warn("skipped occurrences of a session series that can't be scheduled",
+				"series_id", series.ID, "staff_id", series.StaffID, "occurrences", conflict.Conflicts)
+			for _, skipped := range conflict.Conflicts {
+				if skipped.OccursAt.After(now) {
+					return repo.SessionSeries.Update(series.ID, map[string]interface{}{"generated_until": skipped.OccursAt})
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// generate creates a session for every occurrence of the series from where generation last
+// stopped up to until, skipping cancelled occurrences and ones that already have a session.
+// Occurrences that overlap another session of the staff member or fall outside the hours of
+// a branch that enforces them are left out and returned as a SeriesConflictError after the
+// rest are saved, for the caller to roll back or report. Occurrences on a closure of the
+// branch are saved flagged by it. The warnings are about occurrences saved outside the hours
+// of a branch that only warns about them.
+func (s *SessionSeriesService) generate(repo *repository.Repository, series *models.SessionSeries, until time.Time) ([]string, error) {
+	if !until.After(series.GeneratedUntil) {
+		return nil, nil
+	}
+	rule, err := recurrence.Parse(series.Recurrence, s.location)
+	if err != nil {
+		return nil, err
+	}
+
+	skip := map[int64]bool{}
+	exceptions, err := repo.SessionSeries.FindExceptions(series.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, exception := range exceptions {
+		skip[exception.OccursAt.Unix()] = true
+	}
+	existing, err := repo.Session.FindBySeriesID(series.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range existing {
+		if session.OccursAt != nil {
+			skip[session.OccursAt.Unix()] = true
+		}
+	}
+
+	duration := series.EndTime.Sub(series.StartTime)
+	var conflicts []OccurrenceConflict
+	var warnings []string
+	warned := map[string]bool{}
+	for _, occurrence := range rule.Occurrences(series.StartTime.In(s.location), series.GeneratedUntil, until) {
+		start := occurrence.UTC()
+		if skip[start.Unix()] {
+			continue
+		}
+		end := start.Add(duration)
+
+		overlapping, err := repo.Session.CheckOverlappingSessions(series.StaffID, start, end, "")
+		if err != nil {
+			return nil, err
+		}
+		if overlapping {
+			conflicts = append(conflicts, OccurrenceConflict{OccursAt: start, Reason: "staff member has overlapping session at this time"})
+			continue
+		}
+
+		// Occurrences on a closure are saved flagged, to be rescheduled with the closure's other sessions
+		closure, err := findClosure(repo, s.location, series.BranchID, start, end)
+		if err != nil {
+			return nil, err
+		}
+		var closureID *int
+		if closure != nil {
+			closureID = &closure.ID
+		} else {
+			outsideHours, err := checkOperatingHours(repo, s.location, series.BranchID, start, end)
+			var outside *OutsideHoursError
+			if errors.As(err, &outside) {
+				conflicts = append(conflicts, OccurrenceConflict{OccursAt: start, Reason: outside.Error()})
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			for _, warning := range outsideHours {
+				if !warned[warning] {
+					warned[warning] = true
+					warnings = append(warnings, warning)
+				}
+			}
+		}
+
+		seriesID := series.ID
+		if err := repo.Session.Create(&models.Session{
+			ID:          uuid.NewString(),
+			PatientID:   series.PatientID,
+			StaffID:     series.StaffID,
+			BranchID:    series.BranchID,
+			StartTime:   start,
+			EndTime:     end,
+			Description: series.Description,
+			Shareable:   series.Shareable,
+			SeriesID:    &seriesID,
+			OccursAt:    &start,
+			ClosureID:   closureID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := repo.SessionSeries.Update(series.ID, map[string]interface{}{"generated_until": until}); err != nil {
+		return nil, err
+	}
+	series.GeneratedUntil = until
+
+	if len(conflicts) > 0 {
+		return warnings, &SeriesConflictError{Conflicts: conflicts}
+	}
+	return warnings, nil
+}
+
+// truncate ends a series before the occurrence at from, deleting the sessions and
+// exceptions of that occurrence and every later one
+func (s *SessionSeriesService) truncate(repo *repository.Repository, series *models.SessionSeries, from time.Time) error {
+	sessions, err := repo.Session.FindBySeriesID(series.ID)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if session.OccursAt == nil || session.OccursAt.Before(from) {
+			continue
+		}
+		if err := deleteSession(repo, session); err != nil {
+			return err
+		}
+	}
+	if err := repo.SessionSeries.DeleteExceptionsFrom(series.ID, from); err != nil {
+		return err
+	}
+
+	rule, err := recurrence.Parse(series.Recurrence, s.location)
+	if err != nil {
+		return err
+	}
+	until := from.Add(-time.Second)
+	if rule.Until != nil && rule.Until.Before(until) {
+		return nil
+	}
+	rule.Until = &until
+	series.Recurrence = rule.String()
+	return repo.SessionSeries.Update(series.ID, map[string]interface{}{"recurrence": series.Recurrence})
+}
+
+// normalize checks the recurrence and moves the series to its first occurrence, so the
+// series starts with a session even when its start time doesn't match the rule
+func (s *SessionSeriesService) normalize(series *models.SessionSeries) error {
+	rule, err := recurrence.Parse(series.Recurrence, s.location)
+	if err != nil {
+		return err
+	}
+	first, ok := rule.First(series.StartTime.In(s.location))
+	if !ok {
+		return errors.New("recurrence ends before the first session")
+	}
+
+	duration := series.EndTime.Sub(series.StartTime)
+	series.Recurrence = rule.String()
+	series.StartTime = first.UTC()
+	series.EndTime = series.StartTime.Add(duration)
+	series.GeneratedUntil = series.StartTime
+	return nil
+}
+
+// occurrence finds a series and one of its upcoming sessions
+func (s *SessionSeriesService) occurrence(id, sessionID string) (*models.SessionSeries, *models.Session, error) {
+	series, err := s.GetByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session, err := s.repo.Session.FindByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, errors.New("session not found")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.SeriesID == nil || *session.SeriesID != series.ID || session.OccursAt == nil {
+		return nil, nil, ErrOccurrenceNotFound
+	}
+	if !session.StartTime.After(time.Now()) {
+		return nil, nil, ErrOccurrenceStarted
+	}
+	return series, session, nil
+}
+
+// horizon is the start of the day after the generation window ends, so whole days are generated at once
+func (s *SessionSeriesService) horizon(now time.Time) time.Time {
+	end := now.Add(s.window).In(s.location)
+	return time.Date(end.Year(), end.Month(), end.Day()+1, 0, 0, 0, 0, s.location).UTC()
+}
+
+func (s *SessionSeriesService) checkPatient(patientID string) error {
+	if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("patient not found")
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *SessionSeriesService) checkStaff(staffID string) error {
+	if _, err := s.repo.Staff.FindByID(staffID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("staff member not found")
+		}
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package service
+
+// backend/internal/service/session_series_service_test.go
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestExtendSkipsConflictingOccurrences(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	service := NewSessionSeriesService(repo, config.Scheduling{Timezone: "UTC", SeriesHorizon: 72 * time.Hour})
+
+	now := time.Date(2026, 3, 2, 8, 0, 0, 0, time.UTC)
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	series := &models.SessionSeries{
+		ID:             uuid.NewString(),
+		PatientID:      patient.ID,
+		StaffID:        staff.ID,
+		Recurrence:     "FREQ=DAILY",
+		StartTime:      start,
+		EndTime:        start.Add(time.Hour),
+		GeneratedUntil: start,
+	}
+	if err := repo.SessionSeries.Create(series); err != nil {
+		t.Fatal(err)
+	}
+	// The staff member is already busy during the second occurrence
+	busy := start.AddDate(0, 0, 1)
+	blocking := &models.Session{
+		ID:        uuid.NewString(),
+		PatientID: patient.ID,
+		StaffID:   staff.ID,
+		StartTime: busy.Add(30 * time.Minute),
+		EndTime:   busy.Add(90 * time.Minute),
+	}
+	if err := repo.Session.Create(blocking); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		now   time.Time
+		clear bool // Move the blocking session out of the way first
+		want  []string
+		until time.Time
+	}{
+		{
+			// The horizon is the start of March 6th; the skipped 3rd is tried again next time
+			name:  "conflict skipped",
+			now:   now,
+			want:  []string{"2026-03-02", "2026-03-04", "2026-03-05"},
+			until: busy,
+		},
+		{
+			name:  "still in the way",
+			now:   now.AddDate(0, 0, 1).Add(-time.Hour),
+			want:  []string{"2026-03-02", "2026-03-04", "2026-03-05", "2026-03-06"},
+			until: busy,
+		},
+		{
+			name:  "out of the way",
+			now:   now.AddDate(0, 0, 1).Add(-time.Hour),
+			clear: true,
+			want:  []string{"2026-03-02", "2026-03-03", "2026-03-04", "2026-03-05", "2026-03-06"},
+			until: time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tt := range tests {
+		if tt.clear {
+			if err := repo.Session.Delete(blocking.ID); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := service.Extend(tt.now); err != nil {
+			t.Fatalf("%s: Extend() error = %v", tt.name, err)
+		}
+
+		sessions, err := repo.Session.FindBySeriesID(series.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var dates []string
+		for _, session := range sessions {
+			dates = append(dates, session.StartTime.UTC().Format(time.DateOnly))
+		}
+		if !equalDates(dates, tt.want) {
+			t.Errorf("%s: generated sessions on %v, want %v", tt.name, dates, tt.want)
+		}
+
+		saved, err := repo.SessionSeries.FindByID(series.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !saved.GeneratedUntil.Equal(tt.until) {
+			t.Errorf("%s: generated until %v, want %v", tt.name, saved.GeneratedUntil, tt.until)
+		}
+	}
+}
+
+func TestExtendGivesUpOnPastConflicts(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	service := NewSessionSeriesService(repo, config.Scheduling{Timezone: "UTC", SeriesHorizon: 72 * time.Hour})
+
+	// The series was last extended before its occurrence on the 2nd, which is now past and clashes
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	series := &models.SessionSeries{
+		ID:             uuid.NewString(),
+		PatientID:      patient.ID,
+		StaffID:        staff.ID,
+		Recurrence:     "FREQ=DAILY",
+		StartTime:      start,
+		EndTime:        start.Add(time.Hour),
+		GeneratedUntil: start,
+	}
+	if err := repo.SessionSeries.Create(series); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Session.Create(&models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(time.Hour)}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := service.Extend(start.Add(2 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := repo.SessionSeries.FindByID(series.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if horizon := time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC); !saved.GeneratedUntil.Equal(horizon) {
+		t.Errorf("generated until %v, want the horizon %v", saved.GeneratedUntil, horizon)
+	}
+}
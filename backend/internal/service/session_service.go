@@ -0,0 +1,310 @@
+package service
+
+// backend/internal/service/session_service.go
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+// SessionDetails is a session with its patient, staff member and activities
+type SessionDetails struct {
+	Session    *models.Session    `json:"session"`
+	Patient    *models.Patient    `json:"patient"`
+	Staff      *models.Staff      `json:"staff"`
+	Activities []*models.Activity `json:"activities"`
+}
+
+type SessionServiceInterface interface {
+	List(limit, offset int) ([]*models.Session, int64, error)
+	Create(session *models.Session) (*models.Session, []string, error)
+	GetByID(id string) (*models.Session, error)
+	Update(id string, updates map[string]interface{}) (*models.Session, []string, error)
+	Delete(id string) error
+	GetDetails(id string) (*SessionDetails, error)
+	GetByPatientID(patientID, sessionID string) (*models.Session, error)
+}
+
+type SessionService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewSessionService(repo *repository.Repository, scheduling config.Scheduling) SessionServiceInterface {
+	return &SessionService{repo: repo, location: scheduling.Location()}
+}
+
+// List sessions, newest first
+func (s *SessionService) List(limit, offset int) ([]*models.Session, int64, error) {
+	return s.repo.Session.List(limit, offset)
+}
+
+// Create books a session for a patient with a staff member, who mustn't have another session at the time.
+// Sessions at a branch must fit its hours and closures; the warnings are about hours of a branch that
+// only warns about them.
+func (s *SessionService) Create(session *models.Session) (*models.Session, []string, error) {
+	if staffID := s.repo.Viewer().OwnStaffID(); staffID != "" {
+		session.StaffID = staffID
+	}
+	if session.PatientID == "" {
+		return nil, nil, errors.New("patient ID is required")
+	}
+	if session.StaffID == "" {
+		return nil, nil, errors.New("staff ID is required")
+	}
+	session.StartTime, session.EndTime = session.StartTime.UTC(), session.EndTime.UTC()
+	if err := checkTimes(session.StartTime, session.EndTime); err != nil {
+		return nil, nil, err
+	}
+	if _, err := s.repo.Patient.FindByID(session.PatientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errors.New("patient not found")
+		}
+		return nil, nil, err
+	}
+	if _, err := s.repo.Staff.FindByID(session.StaffID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil, errors.New("staff member not found")
+		}
+		return nil, nil, err
+	}
+
+	warnings, err := checkSchedule(s.repo, s.location, session.BranchID, session.StartTime, session.EndTime)
+	if err != nil {
+		return nil, nil, err
+	}
+	overlapping, err := s.repo.Session.CheckOverlappingSessions(session.StaffID, session.StartTime, session.EndTime, "")
+	if err != nil {
+		return nil, nil, err
+	}
+	if overlapping {
+		return nil, nil, errors.New("staff member has overlapping session at this time")
+	}
+
+	session.ID = uuid.NewString()
+	if err := s.repo.Session.Create(session); err != nil {
+		return nil, nil, err
+	}
+	return session, warnings, nil
+}
+
+// Get a session by ID. Sessions of patients outside the caller's caseload aren't found.
+func (s *SessionService) GetByID(id string) (*models.Session, error) {
+	session, err := s.repo.Session.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("session not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// Update a session. Moving it, or giving it to another staff member, is checked for overlaps,
+// and moving it in time or between branches against the branch's hours and closures. A session
+// flagged by a closure that's moved off it has its flag cleared. It can only be moved to a
+// patient of the caller's caseload, and therapists keep their sessions.
+func (s *SessionService) Update(id string, updates map[string]interface{}) (*models.Session, []string, error) {
+	session, err := s.GetByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	delete(updates, "id")
+	delete(updates, "closure_id")
+	if staffID := s.repo.Viewer().OwnStaffID(); staffID != "" {
+		delete(updates, "staff_id")
+	}
+	if value, ok := updates["patient_id"]; ok {
+		patientID, _ := value.(string)
+		if _, err := s.repo.Patient.FindByID(patientID); err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, nil, errors.New("patient not found")
+			}
+			return nil, nil, err
+		}
+	}
+
+	var warnings []string
+	_, staffChanged := updates["staff_id"]
+	_, branchChanged := updates["branch_id"]
+	_, startChanged := updates["start_time"]
+	_, endChanged := updates["end_time"]
+	if branchChanged || startChanged || endChanged {
+		branchID := session.BranchID
+		if value, ok := updates["branch_id"]; ok {
+			branchID = nil
+			if value != nil {
+				id, ok := value.(float64)
+				if !ok {
+					return nil, nil, errors.New("branch_id must be a number")
+				}
+				branch := int(id)
+				branchID = &branch
+			}
+		}
+		start, end, err := updatedTimes(updates, session.StartTime, session.EndTime)
+		if err != nil {
+			return nil, nil, err
+		}
+		if warnings, err = checkSchedule(s.repo, s.location, branchID, start, end); err != nil {
+			return nil, nil, err
+		}
+		if session.ClosureID != nil {
+			updates["closure_id"] = nil
+		}
+	}
+	if staffChanged || startChanged || endChanged {
+		staffID := session.StaffID
+		if staffChanged {
+			value, ok := updates["staff_id"].(string)
+			if !ok || value == "" {
+				return nil, nil, errors.New("staff ID is required")
+			}
+			if _, err := s.repo.Staff.FindByID(value); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, nil, errors.New("staff member not found")
+				}
+				return nil, nil, err
+			}
+			staffID = value
+		}
+		start, end, err := updatedTimes(updates, session.StartTime, session.EndTime)
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := checkTimes(start, end); err != nil {
+			return nil, nil, err
+		}
+		overlapping, err := s.repo.Session.CheckOverlappingSessions(staffID, start, end, session.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if overlapping {
+			return nil, nil, errors.New("staff member has overlapping session at this time")
+		}
+		if startChanged {
+			updates["start_time"] = start
+		}
+		if endChanged {
+			updates["end_time"] = end
+		}
+	}
+
+	if _, err := s.repo.Session.Update(id, updates); err != nil {
+		return nil, nil, err
+	}
+	updated, err := s.GetByID(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return updated, warnings, nil
+}
+
+// Delete a session booked by mistake. Sessions with activities, or that started more than
+// a day ago, are kept as a record of the therapy given.
+func (s *SessionService) Delete(id string) error {
+	session, err := s.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if session.StartTime.Before(time.Now().Add(-24 * time.Hour)) {
+		return errors.New("cannot delete sessions older than 24 hours")
+	}
+	return deleteSession(s.repo, session)
+}
+
+// GetDetails gets a session with its patient, staff member and activities
+func (s *SessionService) GetDetails(id string) (*SessionDetails, error) {
+	session, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	patient, err := s.repo.Patient.FindByID(session.PatientID)
+	if err != nil {
+		return nil, err
+	}
+	staff, err := s.repo.Staff.FindByID(session.StaffID)
+	if err != nil {
+		return nil, err
+	}
+	activities, err := s.repo.Activity.FindBySessionID(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionDetails{Session: session, Patient: patient, Staff: staff, Activities: activities}, nil
+}
+
+// GetByPatientID gets one of a patient's sessions
+func (s *SessionService) GetByPatientID(patientID, sessionID string) (*models.Session, error) {
+	session, err := s.GetByID(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if session.PatientID != patientID {
+		return nil, errors.New("session does not belong to the specified patient")
+	}
+	return session, nil
+}
+
+// updatedTimes reads new start and end times from session updates, keeping start and end
+// for those left out, in UTC
+func updatedTimes(updates map[string]interface{}, start, end time.Time) (time.Time, time.Time, error) {
+	for key, field := range map[string]*time.Time{"start_time": &start, "end_time": &end} {
+		value, ok := updates[key]
+		if !ok {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, fmt.Sprint(value))
+		if err != nil {
+			return start, end, fmt.Errorf("%s must be a date-time like 2025-01-31T10:00:00Z", key)
+		}
+		*field = parsed
+	}
+	return start.UTC(), end.UTC(), nil
+}
+
+// checkTimes checks a session has a start time and ends after it, within a day
+func checkTimes(start, end time.Time) error {
+	if start.IsZero() {
+		return errors.New("start time is required")
+	}
+	if !end.After(start) {
+		return errors.New("end time must be after start time")
+	}
+	if end.Sub(start) >= 24*time.Hour {
+		return errors.New("a session must be shorter than a day")
+	}
+	return nil
+}
+
+// deleteSession deletes a session unless activities were already recorded in it
+func deleteSession(repo *repository.Repository, session *models.Session) error {
+	activities, err := repo.Activity.FindBySessionID(session.ID)
+	if err != nil {
+		return err
+	}
+	if len(activities) > 0 {
+		return errors.New("cannot delete session with existing activities")
+	}
+	return repo.Session.Delete(session.ID)
+}
+
+// page returns the items from offset, up to limit of them
+func page[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	items = items[offset:]
+	if limit < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
@@ -0,0 +1,197 @@
+package service
+
+// backend/internal/service/session_service_test.go
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestSessionWritesStayInTherapistCaseload(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, therapist := createTestPatient(t, repo)
+	other, colleague := createTestPatient(t, repo)
+	service := NewSessionService(repo.ForViewer(&models.Viewer{StaffID: therapist.ID, Role: models.RoleTherapist}), config.Scheduling{Timezone: "UTC"})
+
+	start := time.Now().Add(time.Hour).Truncate(time.Minute)
+	tests := []struct {
+		name      string
+		patientID string
+		staffID   string
+		wantErr   string
+	}{
+		{"own patient", patient.ID, therapist.ID, ""},
+		{"booked for a colleague", patient.ID, colleague.ID, ""},
+		{"patient outside the caseload", other.ID, therapist.ID, "patient not found"},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			begins := start.Add(time.Duration(i) * 2 * time.Hour)
+			session, _, err := service.Create(&models.Session{
+				PatientID: tt.patientID,
+				StaffID:   tt.staffID,
+				StartTime: begins,
+				EndTime:   begins.Add(time.Hour),
+			})
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("Create() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Create() error = %v", err)
+			}
+			if session.StaffID != therapist.ID {
+				t.Errorf("session is run by %s, want the therapist %s", session.StaffID, therapist.ID)
+			}
+		})
+	}
+
+	sessions, _, err := service.List(10, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := service.Update(sessions[0].ID, map[string]interface{}{"patient_id": other.ID}); err == nil || err.Error() != "patient not found" {
+		t.Errorf("Update() moving to a patient outside the caseload error = %v, want patient not found", err)
+	}
+}
+
+func TestWithContextKeepsCaseload(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, therapist := createTestPatient(t, repo)
+	other, _ := createTestPatient(t, repo)
+	scoped := repo.ForViewer(&models.Viewer{StaffID: therapist.ID, Role: models.RoleTherapist}).WithContext(context.Background())
+
+	if viewer := scoped.Viewer(); viewer == nil || viewer.StaffID != therapist.ID {
+		t.Fatalf("viewer = %+v, want the therapist", viewer)
+	}
+	if _, err := scoped.Patient.FindByID(patient.ID); err != nil {
+		t.Errorf("finding their own patient error = %v", err)
+	}
+	if _, err := scoped.Patient.FindByID(other.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Errorf("finding a patient outside the caseload error = %v, want not found", err)
+	}
+}
+
+func TestSessionBranchHours(t *testing.T) {
+	repo := newTestRepository(t)
+	branchService := NewBranchService(repo, config.Scheduling{Timezone: "UTC"})
+	service := NewSessionService(repo, config.Scheduling{Timezone: "UTC"})
+	// Each booking is with a new patient and staff member, so the sessions never overlap
+	book := func(branchID *int, start, end time.Time) ([]string, error) {
+		patient, staff := createTestPatient(t, repo)
+		_, warnings, err := service.Create(&models.Session{PatientID: patient.ID, StaffID: staff.ID, BranchID: branchID, StartTime: start, EndTime: end})
+		return warnings, err
+	}
+
+	branches := map[models.HoursPolicy]*models.Branch{}
+	for _, policy := range []models.HoursPolicy{models.HoursEnforce, models.HoursWarn} {
+		branch, err := branchService.Create(&models.Branch{OpeningDate: time.Now(), Active: true, HoursPolicy: policy})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Open 09:00 to 17:00 on Mondays and closed on Sundays, with no hours set for other days
+		if _, err := branchService.SetHours(branch.ID, []*models.OperatingHours{
+			{DayOfWeek: int16(time.Monday), OpenTime: "09:00", CloseTime: "17:00"},
+			{DayOfWeek: int16(time.Sunday), IsClosed: true},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		branches[policy] = branch
+	}
+
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	at := func(day time.Time, hour, minute int) time.Time {
+		return day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	tests := []struct {
+		name       string
+		start, end time.Time
+		outside    bool
+	}{
+		{"within the hours", at(monday, 9, 0), at(monday, 17, 0), false},
+		{"starts before opening", at(monday, 8, 30), at(monday, 9, 30), true},
+		{"ends after closing", at(monday, 16, 30), at(monday, 17, 30), true},
+		{"runs past midnight", at(monday, 16, 0), at(monday, 24, 30), true},
+		{"on a closed day", at(monday.AddDate(0, 0, -1), 10, 0), at(monday.AddDate(0, 0, -1), 11, 0), true},
+		{"on a day without hours", at(monday.AddDate(0, 0, 1), 6, 0), at(monday.AddDate(0, 0, 1), 7, 0), false},
+	}
+	for _, tt := range tests {
+		// Branches that enforce their hours reject the session
+		enforce := branches[models.HoursEnforce].ID
+		warnings, err := book(&enforce, tt.start, tt.end)
+		var outside *OutsideHoursError
+		if tt.outside != errors.As(err, &outside) || len(warnings) > 0 {
+			t.Errorf("%s: enforced hours = %v, %v, want outside %v", tt.name, warnings, err, tt.outside)
+		}
+
+		// Branches that only warn save it with a warning
+		warn := branches[models.HoursWarn].ID
+		warnings, err = book(&warn, tt.start, tt.end)
+		if err != nil || tt.outside != (len(warnings) == 1) {
+			t.Errorf("%s: warned hours = %v, %v, want outside %v", tt.name, warnings, err, tt.outside)
+		}
+	}
+
+	if warnings, err := book(nil, at(monday, 3, 0), at(monday, 4, 0)); err != nil || len(warnings) > 0 {
+		t.Errorf("a session without a branch = %v, %v, want it unrestricted", warnings, err)
+	}
+	missing := 0
+	if _, err := book(&missing, at(monday, 10, 0), at(monday, 11, 0)); !errors.Is(err, ErrBranchNotFound) {
+		t.Errorf("a session at an unknown branch error = %v, want %v", err, ErrBranchNotFound)
+	}
+}
+
+func TestSessionUpdateBranchHours(t *testing.T) {
+	repo := newTestRepository(t)
+	branchService := NewBranchService(repo, config.Scheduling{Timezone: "UTC"})
+	service := NewSessionService(repo, config.Scheduling{Timezone: "UTC"})
+	branch, err := branchService.Create(&models.Branch{OpeningDate: time.Now(), Active: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := branchService.SetHours(branch.ID, []*models.OperatingHours{
+		{DayOfWeek: int16(time.Monday), OpenTime: "09:00", CloseTime: "17:00"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	patient, staff := createTestPatient(t, repo)
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	session, _, err := service.Create(&models.Session{
+		PatientID: patient.ID,
+		StaffID:   staff.ID,
+		BranchID:  &branch.ID,
+		StartTime: start,
+		EndTime:   start.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		updates map[string]interface{}
+		wantErr bool
+	}{
+		{"later within the hours", map[string]interface{}{"start_time": "2026-03-02T15:00:00Z", "end_time": "2026-03-02T16:00:00Z"}, false},
+		{"end moved past closing", map[string]interface{}{"end_time": "2026-03-02T18:00:00Z"}, true},
+		{"moved off the branch", map[string]interface{}{"branch_id": nil, "end_time": "2026-03-02T18:00:00Z"}, false},
+		{"description only", map[string]interface{}{"description": "Bring the picture cards"}, false},
+		{"unreadable time", map[string]interface{}{"start_time": "Monday"}, true},
+	}
+	for _, tt := range tests {
+		_, _, err := service.Update(session.ID, tt.updates)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: Update error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package service
+
+// backend/internal/service/staff_service.go
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+type StaffServiceInterface interface {
+	List(limit, offset int) ([]*models.Staff, int64, error)
+	Create(staff *models.Staff) (*models.Staff, error)
+	GetByID(id string) (*models.Staff, error)
+	Update(id string, updates map[string]interface{}) (*models.Staff, error)
+	Delete(id string) error
+	GetSessions(staffID string, limit, offset int) ([]*models.Session, int64, error)
+}
+
+type StaffService struct {
+	repo *repository.Repository
+}
+
+func NewStaffService(repo *repository.Repository) StaffServiceInterface {
+	return &StaffService{repo: repo}
+}
+
+// List staff members by name
+func (s *StaffService) List(limit, offset int) ([]*models.Staff, int64, error) {
+	staff, err := s.repo.Staff.FindAll()
+	if err != nil {
+		return nil, 0, err
+	}
+	return page(staff, limit, offset), int64(len(staff)), nil
+}
+
+// Create adds a staff member, who joins today unless they say otherwise
+func (s *StaffService) Create(staff *models.Staff) (*models.Staff, error) {
+	staff.Name = strings.TrimSpace(staff.Name)
+	if staff.Name == "" {
+		return nil, errors.New("staff name is required")
+	}
+	if err := checkRole(staff.Role); err != nil {
+		return nil, err
+	}
+	if staff.JoinDate.IsZero() {
+		staff.JoinDate = time.Now()
+	}
+
+	staff.ID = uuid.NewString()
+	if err := s.repo.Staff.Create(staff); err != nil {
+		return nil, err
+	}
+	return staff, nil
+}
+
+// Get a staff member by ID
+func (s *StaffService) GetByID(id string) (*models.Staff, error) {
+	staff, err := s.repo.Staff.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("staff member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return staff, nil
+}
+
+// Update a staff member
+func (s *StaffService) Update(id string, updates map[string]interface{}) (*models.Staff, error) {
+	if _, err := s.GetByID(id); err != nil {
+		return nil, err
+	}
+	delete(updates, "id")
+	if name, ok := updates["name"]; ok {
+		if name, _ := name.(string); strings.TrimSpace(name) == "" {
+			return nil, errors.New("staff name is required")
+		}
+	}
+	if role, ok := updates["role"]; ok {
+		role, _ := role.(string)
+		if err := checkRole(models.StaffRole(role)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Staff.Update(id, updates); err != nil {
+		return nil, err
+	}
+	return s.GetByID(id)
+}
+
+// Delete a staff member
+func (s *StaffService) Delete(id string) error {
+	if _, err := s.GetByID(id); err != nil {
+		return err
+	}
+	return s.repo.Staff.Delete(id)
+}
+
+// GetSessions lists a staff member's sessions within the caller's caseload, newest first
+func (s *StaffService) GetSessions(staffID string, limit, offset int) ([]*models.Session, int64, error) {
+	if _, err := s.GetByID(staffID); err != nil {
+		return nil, 0, err
+	}
+	sessions, err := s.repo.Session.FindByStaffID(staffID)
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.After(sessions[j].StartTime)
+	})
+	return page(sessions, limit, offset), int64(len(sessions)), nil
+}
+
+func checkRole(role models.StaffRole) error {
+	switch role {
+	case models.RoleAdmin, models.RoleTherapist, models.RoleDoctor, models.RoleBehavioralAnalyst:
+		return nil
+	default:
+		return errors.New("role must be admin, therapist, doctor or behavioral_analyst")
+	}
+}
@@ -0,0 +1,549 @@
+package service
+
+// backend/internal/service/tax_invoice_service.go
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/pdf"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrTaxInvoiceNotFound = errors.New("tax invoice not found")
+	ErrInvoiceNotPaid     = errors.New("only paid invoices get a tax invoice")
+)
+
+// maxTaxInvoiceNumber is the longest invoice number GST allows
+const maxTaxInvoiceNumber = 16
+
+var (
+	gstinPattern         = regexp.MustCompile(`^[0-9]{2}[A-Z]{5}[0-9]{4}[A-Z][1-9A-Z]Z[0-9A-Z]$`)
+	financialYearPattern = regexp.MustCompile(`^([0-9]{4})-([0-9]{2})$`)
+)
+
+// TaxInvoiceDetails is everything printed on a tax invoice. It's saved with the document
+// so reprints match the original even after the clinic's or guardian's details change.
+// Amounts are in paise and include GST.
+type TaxInvoiceDetails struct {
+	Title       string              `json:"title"`
+	Number      string              `json:"number"`
+	IssueDate   string              `json:"issue_date"`
+	InvoiceID   int                 `json:"invoice_id"`
+	InvoiceDate string              `json:"invoice_date"`
+	Supplier    TaxInvoiceParty     `json:"supplier"`
+	Recipient   TaxInvoiceParty     `json:"recipient"`
+	PatientName string              `json:"patient_name"`
+	GSTRate     float64             `json:"gst_rate"`
+	Lines       []TaxInvoiceLine    `json:"lines"`
+	Payments    []TaxInvoicePayment `json:"payments"`
+	Taxable     int64               `json:"taxable"`
+	CGST        int64               `json:"cgst"`
+	SGST        int64               `json:"sgst"`
+	Total       int64               `json:"total"`
+}
+
+type TaxInvoiceParty struct {
+	Name      string `json:"name"`
+	Address   string `json:"address,omitempty"`
+	GSTIN     string `json:"gstin,omitempty"`
+	StateCode string `json:"state_code,omitempty"`
+	Contact   string `json:"contact,omitempty"`
+}
+
+type TaxInvoiceLine struct {
+	Description string `json:"description"`
+	SAC         string `json:"sac"`
+	Minutes     int    `json:"minutes"`
+	Price       int64  `json:"price"`
+	Discount    int64  `json:"discount"`
+	Taxable     int64  `json:"taxable"`
+	CGST        int64  `json:"cgst"`
+	SGST        int64  `json:"sgst"`
+	Amount      int64  `json:"amount"`
+}
+
+type TaxInvoicePayment struct {
+	Date      string `json:"date"`
+	Method    string `json:"method"`
+	Reference string `json:"reference,omitempty"`
+	Amount    int64  `json:"amount"`
+}
+
+type TaxInvoiceServiceInterface interface {
+	Issue(caller *models.Viewer, invoiceID int) (*models.TaxInvoice, error)
+	Original(invoiceID int) (*models.TaxInvoice, error)
+	Reprint(invoiceID int, now time.Time) (*models.TaxInvoice, []byte, error)
+	Register(branchID int, financialYear string) ([]*models.TaxInvoice, error)
+}
+
+type TaxInvoiceService struct {
+	repo     *repository.Repository
+	location *time.Location
+	billing  config.Billing
+}
+
+func NewTaxInvoiceService(repo *repository.Repository, scheduling config.Scheduling, billing config.Billing) TaxInvoiceServiceInterface {
+	return &TaxInvoiceService{repo: repo, location: scheduling.Location(), billing: billing}
+}
+
+// Issue numbers and renders the tax invoice of a paid invoice. An invoice only ever gets
+// one, so issuing it again, even at the same time, returns the first.
+func (s *TaxInvoiceService) Issue(caller *models.Viewer, invoiceID int) (*models.TaxInvoice, error) {
+	invoice, err := s.repo.Invoice.FindByID(invoiceID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrInvoiceNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if invoice.Status != models.InvoicePaid {
+		return nil, ErrInvoiceNotPaid
+	}
+	existing, err := s.repo.TaxInvoice.FindByInvoiceID(invoiceID)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if s.billing.LegalName == "" {
+		return nil, errors.New("set CLINIC_LEGAL_NAME to issue tax invoices")
+	}
+
+	details, branchID, err := s.details(invoice)
+	if err != nil {
+		return nil, err
+	}
+	today := time.Now().In(s.location)
+	financialYear := financialYearOf(today)
+	details.IssueDate = today.Format(dateLayout)
+
+	taxInvoice := &models.TaxInvoice{
+		InvoiceID:     invoice.ID,
+		BranchID:      branchID,
+		FinancialYear: financialYear,
+		IssueDate:     details.IssueDate,
+		Taxable:       details.Taxable,
+		CGST:          details.CGST,
+		SGST:          details.SGST,
+		Total:         details.Total,
+		CreatedByID:   caller.StaffID,
+	}
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		// Lock the invoice and look again, so two requests issuing it don't both number one
+		if _, err := repo.Invoice.FindForUpdate(invoiceID); err != nil {
+			return err
+		}
+		existing, err = repo.TaxInvoice.FindByInvoiceID(invoiceID)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		existing = nil
+
+		sequence, err := repo.TaxInvoice.NextNumber(branchID, financialYear)
+		if err != nil {
+			return err
+		}
+		taxInvoice.SequenceNumber = sequence
+		taxInvoice.Number, err = taxInvoiceNumber(branchID, financialYear, sequence)
+		if err != nil {
+			return err
+		}
+		details.Number = taxInvoice.Number
+
+		encoded, err := json.Marshal(details)
+		if err != nil {
+			return err
+		}
+		taxInvoice.Details = string(encoded)
+		taxInvoice.PDF = renderTaxInvoice(details, "Original for recipient")
+		return repo.TaxInvoice.Create(taxInvoice)
+	})
+	if err != nil {
+		// Saving fails on the unique invoice ID when another request issued it first
+		if issued, findErr := s.repo.TaxInvoice.FindByInvoiceID(invoiceID); findErr == nil {
+			return issued, nil
+		}
+		return nil, err
+	}
+	if existing != nil {
+		return existing, nil
+	}
+	return taxInvoice, nil
+}
+
+// taxInvoiceNumber formats the number of a branch's tax invoice, such as B1/26-27/00001
+func taxInvoiceNumber(branchID int, financialYear string, sequence int) (string, error) {
+	number := fmt.Sprintf("B%d/%s/%05d", branchID, financialYear[2:], sequence)
+	if len(number) > maxTaxInvoiceNumber {
+		return "", fmt.Errorf("tax invoice number %s is longer than the %d characters GST allows", number, maxTaxInvoiceNumber)
+	}
+	return number, nil
+}
+
+// Original gets an invoice's tax invoice with the PDF as it was issued
+func (s *TaxInvoiceService) Original(invoiceID int) (*models.TaxInvoice, error) {
+	taxInvoice, err := s.repo.TaxInvoice.FindByInvoiceID(invoiceID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTaxInvoiceNotFound
+	}
+	return taxInvoice, err
+}
+
+// Reprint renders a copy of an invoice's tax invoice from its saved details, marked with
+// how many times it's been reprinted, and counts the reprint
+func (s *TaxInvoiceService) Reprint(invoiceID int, now time.Time) (*models.TaxInvoice, []byte, error) {
+	taxInvoice, err := s.Original(invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	var details TaxInvoiceDetails
+	if err := json.Unmarshal([]byte(taxInvoice.Details), &details); err != nil {
+		return nil, nil, err
+	}
+
+	label := fmt.Sprintf("Reprint %d, printed %s", taxInvoice.Reprints+1, now.In(s.location).Format("2 Jan 2006 15:04"))
+	document := renderTaxInvoice(&details, label)
+	if err := s.repo.TaxInvoice.RecordReprint(taxInvoice.ID, now); err != nil {
+		return nil, nil, err
+	}
+	return taxInvoice, document, nil
+}
+
+// Register lists a branch's tax invoices for a financial year such as 2026-27, the current
+// one when it's empty, in number order
+func (s *TaxInvoiceService) Register(branchID int, financialYear string) ([]*models.TaxInvoice, error) {
+	if _, err := s.repo.Branch.GetBranchByID(branchID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrBranchNotFound
+		}
+		return nil, err
+	}
+	if financialYear == "" {
+		financialYear = financialYearOf(time.Now().In(s.location))
+	}
+	if !validFinancialYear(financialYear) {
+		return nil, errors.New("financial year must look like 2026-27")
+	}
+	return s.repo.TaxInvoice.FindByBranch(branchID, financialYear)
+}
+
+// details collects what's printed on an invoice's tax invoice, and the branch numbering it:
+// the patient's primary branch, or else the branch of the invoice's first session
+func (s *TaxInvoiceService) details(invoice *models.Invoice) (*TaxInvoiceDetails, int, error) {
+	patient, err := s.repo.Patient.FindByID(invoice.PatientID)
+	if err != nil {
+		return nil, 0, err
+	}
+	guardian, err := s.repo.Guardian.FindByID(invoice.GuardianID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	branchID := patient.PrimaryBranchID
+	for _, line := range invoice.Lines {
+		if branchID != nil {
+			break
+		}
+		if line.SessionID == nil {
+			continue
+		}
+		session, err := s.repo.Session.FindByID(*line.SessionID)
+		if err != nil {
+			return nil, 0, err
+		}
+		branchID = session.BranchID
+	}
+	if branchID == nil {
+		return nil, 0, errors.New("invoice has no branch to number its tax invoice by")
+	}
+	branch, err := s.repo.Branch.GetBranchByID(*branchID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	details := &TaxInvoiceDetails{
+		Title:       "Tax Invoice",
+		InvoiceID:   invoice.ID,
+		InvoiceDate: invoice.IssueDate,
+		Supplier: TaxInvoiceParty{
+			Name:    s.billing.LegalName,
+			Address: s.billing.Address,
+			GSTIN:   s.billing.GSTIN,
+		},
+		Recipient:   TaxInvoiceParty{Name: guardian.Name},
+		PatientName: patient.Name,
+		GSTRate:     s.billing.GSTRate,
+	}
+	if s.billing.GSTRate == 0 {
+		// Exempt supplies get a bill of supply rather than a tax invoice
+		details.Title = "Bill of Supply"
+	}
+	if s.billing.GSTIN != "" {
+		details.Supplier.StateCode = s.billing.GSTIN[:2]
+	}
+	if branch.Location != nil {
+		details.Supplier.Contact = "Branch: " + *branch.Location
+	}
+	var contact []string
+	if guardian.PhoneNumber != nil {
+		contact = append(contact, *guardian.PhoneNumber)
+	}
+	if guardian.Email != nil {
+		contact = append(contact, *guardian.Email)
+	}
+	details.Recipient.Contact = strings.Join(contact, ", ")
+
+	for _, line := range invoice.Lines {
+		taxable := int64(math.Round(float64(line.Amount) * 100 / (100 + s.billing.GSTRate)))
+		cgst := (line.Amount - taxable) / 2
+		details.Lines = append(details.Lines, TaxInvoiceLine{
+			Description: line.Description,
+			SAC:         s.billing.SAC,
+			Minutes:     line.Minutes,
+			Price:       line.UnitPrice,
+			Discount:    line.Discount,
+			Taxable:     taxable,
+			CGST:        cgst,
+			SGST:        line.Amount - taxable - cgst,
+			Amount:      line.Amount,
+		})
+		details.Taxable += taxable
+		details.CGST += cgst
+		details.SGST += line.Amount - taxable - cgst
+		details.Total += line.Amount
+	}
+	for _, payment := range invoice.Payments {
+		details.Payments = append(details.Payments, TaxInvoicePayment{
+			Date:      payment.ReceivedAt.In(s.location).Format(dateLayout),
+			Method:    string(payment.Method),
+			Reference: payment.Reference,
+			Amount:    payment.Amount,
+		})
+	}
+	return details, *branchID, nil
+}
+
+// renderTaxInvoice lays out a tax invoice on A4 pages, labelled with which copy it is
+func renderTaxInvoice(details *TaxInvoiceDetails, label string) []byte {
+	const (
+		left  = 40.0
+		right = pdf.PageWidth - 40
+		size  = 8.0
+	)
+	document := &pdf.Document{Title: details.Title + " " + details.Number}
+	page := document.AddPage()
+	y := 50.0
+
+	page.Text(left, y, 16, true, strings.ToUpper(details.Title))
+	page.TextRight(right, y, size, false, label)
+	y += 22
+
+	// The clinic on the left, the document's number and dates on the right
+	top := y
+	page.Text(left, y, 11, true, details.Supplier.Name)
+	y += 13
+	for _, line := range pdf.Wrap(details.Supplier.Address, size, false, 280) {
+		page.Text(left, y, size, false, line)
+		y += 11
+	}
+	if details.Supplier.GSTIN != "" {
+		page.Text(left, y, size, false, "GSTIN: "+details.Supplier.GSTIN+"    State code: "+details.Supplier.StateCode)
+		y += 11
+	}
+	if details.Supplier.Contact != "" {
+		page.Text(left, y, size, false, details.Supplier.Contact)
+		y += 11
+	}
+	meta := [][2]string{
+		{"Number", details.Number},
+		{"Date", displayDate(details.IssueDate)},
+		{"Invoice", fmt.Sprintf("#%d of %s", details.InvoiceID, displayDate(details.InvoiceDate))},
+	}
+	if details.Supplier.StateCode != "" {
+		meta = append(meta, [2]string{"Place of supply", "State code " + details.Supplier.StateCode})
+	}
+	for i, row := range meta {
+		page.TextRight(right-110, top+float64(i)*11, size, true, row[0]+":")
+		page.TextRight(right, top+float64(i)*11, size, false, row[1])
+	}
+	y = math.Max(y, top+float64(len(meta))*11) + 6
+	page.Line(left, y, right, y, 0.5)
+	y += 16
+
+	page.Text(left, y, size, true, "Billed to")
+	y += 11
+	page.Text(left, y, size, false, details.Recipient.Name)
+	y += 11
+	if details.Recipient.Contact != "" {
+		page.Text(left, y, size, false, details.Recipient.Contact)
+		y += 11
+	}
+	page.Text(left, y, size, false, "For sessions of "+details.PatientName)
+	y += 20
+
+	// Right edges of the number columns
+	columns := []struct {
+		x     float64
+		title string
+	}{
+		{240, "SAC"}, {265, "Min"}, {315, "Price"}, {362, "Discount"},
+		{412, "Taxable"}, {460, fmt.Sprintf("CGST %s%%", percent(details.GSTRate/2))},
+		{508, fmt.Sprintf("SGST %s%%", percent(details.GSTRate/2))}, {right, "Amount"},
+	}
+	header := func() {
+		page.Text(left, y, size, true, "Description")
+		for _, column := range columns {
+			page.TextRight(column.x, y, size, true, column.title)
+		}
+		y += 5
+		page.Line(left, y, right, y, 0.5)
+		y += 12
+	}
+	header()
+	for _, line := range details.Lines {
+		description := pdf.Wrap(line.Description, size, false, 150)
+		if y+float64(len(description))*10 > pdf.PageHeight-60 {
+			page = document.AddPage()
+			y = 50
+			header()
+		}
+		values := []string{
+			line.SAC, fmt.Sprint(line.Minutes), rupees(line.Price), rupees(line.Discount),
+			rupees(line.Taxable), rupees(line.CGST), rupees(line.SGST), rupees(line.Amount),
+		}
+		for i, column := range columns {
+			page.TextRight(column.x, y, size, false, values[i])
+		}
+		for _, text := range description {
+			page.Text(left, y, size, false, text)
+			y += 10
+		}
+		y += 3
+	}
+	page.Line(left, y, right, y, 0.5)
+	y += 14
+
+	if y > pdf.PageHeight-160 {
+		page = document.AddPage()
+		y = 50
+	}
+	totals := [][2]string{
+		{"Taxable value", rupees(details.Taxable)},
+		{fmt.Sprintf("CGST @ %s%%", percent(details.GSTRate/2)), rupees(details.CGST)},
+		{fmt.Sprintf("SGST @ %s%%", percent(details.GSTRate/2)), rupees(details.SGST)},
+	}
+	for _, row := range totals {
+		page.TextRight(right-90, y, size, false, row[0])
+		page.TextRight(right, y, size, false, row[1])
+		y += 11
+	}
+	page.TextRight(right-90, y, 10, true, "Total (INR)")
+	page.TextRight(right, y, 10, true, rupees(details.Total))
+	y += 22
+
+	for _, payment := range details.Payments {
+		text := fmt.Sprintf("Received INR %s by %s on %s", rupees(payment.Amount), strings.ToUpper(payment.Method), displayDate(payment.Date))
+		if payment.Reference != "" {
+			text += ", reference " + payment.Reference
+		}
+		page.Text(left, y, size, false, text)
+		y += 11
+	}
+	y += 10
+
+	var notes []string
+	if details.GSTRate == 0 {
+		notes = append(notes, "Health care services by a clinical establishment are exempt from GST (Notification 12/2017-Central Tax (Rate), entry 74).")
+	} else {
+		notes = append(notes, "Prices include GST.")
+	}
+	notes = append(notes, "This is a computer-generated document and needs no signature.")
+	for _, note := range notes {
+		for _, line := range pdf.Wrap(note, size, false, right-left) {
+			page.Text(left, y, size, false, line)
+			y += 10
+		}
+	}
+	return document.Bytes()
+}
+
+// financialYearOf names the April to March financial year a day falls in, such as 2026-27
+func financialYearOf(day time.Time) string {
+	start := day.Year()
+	if day.Month() < time.April {
+		start--
+	}
+	return fmt.Sprintf("%d-%02d", start, (start+1)%100)
+}
+
+func validFinancialYear(financialYear string) bool {
+	match := financialYearPattern.FindStringSubmatch(financialYear)
+	if match == nil {
+		return false
+	}
+	var start, end int
+	fmt.Sscan(match[1], &start)
+	fmt.Sscan(match[2], &end)
+	return (start+1)%100 == end
+}
+
+// validGSTIN checks a GST identification number's format and check character
+func validGSTIN(gstin string) bool {
+	if !gstinPattern.MatchString(gstin) {
+		return false
+	}
+	const characters = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	sum := 0
+	for i := 0; i < 14; i++ {
+		product := strings.IndexByte(characters, gstin[i]) * (1 + i%2)
+		sum += product/36 + product%36
+	}
+	return characters[(36-sum%36)%36] == gstin[14]
+}
+
+// rupees formats paise as rupees with Indian digit grouping, such as 1,25,000.50
+func rupees(paise int64) string {
+	sign := ""
+	if paise < 0 {
+		sign, paise = "-", -paise
+	}
+	whole := fmt.Sprint(paise / 100)
+	if len(whole) > 3 {
+		head, tail := whole[:len(whole)-3], whole[len(whole)-3:]
+		var groups []string
+		for len(head) > 2 {
+			groups = append([]string{head[len(head)-2:]}, groups...)
+			head = head[:len(head)-2]
+		}
+		groups = append([]string{head}, groups...)
+		whole = strings.Join(groups, ",") + "," + tail
+	}
+	return fmt.Sprintf("%s%s.%02d", sign, whole, paise%100)
+}
+
+func percent(rate float64) string {
+	return strings.TrimSuffix(strings.TrimRight(fmt.Sprintf("%.2f", rate), "0"), ".")
+}
+
+func displayDate(date string) string {
+	day, err := time.Parse(dateLayout, date)
+	if err != nil {
+		return date
+	}
+	return day.Format("2 Jan 2006")
+}
@@ -0,0 +1,158 @@
+package service
+
+// backend/internal/service/tax_invoice_service_test.go
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+func TestNextNumber(t *testing.T) {
+	repo := newTestRepository(t)
+	branch := &models.Branch{OpeningDate: time.Now(), Active: true}
+	if err := repo.Branch.Create(branch); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		financialYear string
+		want          int
+	}{
+		{"2025-26", 1},
+		{"2025-26", 2},
+		{"2026-27", 1},
+		{"2025-26", 3},
+		{"2026-27", 2},
+	}
+	for _, tt := range tests {
+		var got int
+		err := repo.Transaction(func(repo *repository.Repository) error {
+			var err error
+			got, err = repo.TaxInvoice.NextNumber(branch.ID, tt.financialYear)
+			return err
+		})
+		if err != nil {
+			t.Fatalf("NextNumber(%s) error = %v", tt.financialYear, err)
+		}
+		if got != tt.want {
+			t.Errorf("NextNumber(%s) = %d, want %d", tt.financialYear, got, tt.want)
+		}
+	}
+}
+
+func TestTaxInvoiceNumber(t *testing.T) {
+	tests := []struct {
+		branchID int
+		sequence int
+		want     string
+	}{
+		{1, 1, "B1/26-27/00001"},
+		{12, 123456, "B12/26-27/123456"},
+		{999, 99999, "B999/26-27/99999"},
+		{1000, 1, ""},
+		{100, 100000, ""},
+	}
+	for _, tt := range tests {
+		got, err := taxInvoiceNumber(tt.branchID, "2026-27", tt.sequence)
+		if tt.want == "" {
+			if err == nil {
+				t.Errorf("taxInvoiceNumber(%d, %d) = %s, want it rejected as too long", tt.branchID, tt.sequence, got)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("taxInvoiceNumber(%d, %d) = %s, %v, want %s", tt.branchID, tt.sequence, got, err, tt.want)
+		}
+	}
+}
+
+func TestIssueTaxInvoiceOnce(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	service := NewTaxInvoiceService(repo, config.Scheduling{Timezone: "UTC"}, config.Billing{LegalName: "Clinic"})
+	caller := &models.Viewer{StaffID: staff.ID, Role: models.RoleAdmin}
+
+	// issue bills a paid invoice to the patient at a branch with the given ID
+	issue := func(branchID int) (*models.Invoice, *models.TaxInvoice, error) {
+		t.Helper()
+		if _, err := repo.Branch.GetBranchByID(branchID); err != nil {
+			if err := repo.Branch.Create(&models.Branch{ID: branchID, OpeningDate: time.Now(), Active: true}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := repo.Patient.Update(patient.ID, map[string]interface{}{"primary_branch_id": branchID}); err != nil {
+			t.Fatal(err)
+		}
+		guardian := &models.Guardian{ID: uuid.NewString(), Name: "Guardian"}
+		if err := repo.Guardian.Create(guardian); err != nil {
+			t.Fatal(err)
+		}
+		invoice := &models.Invoice{PatientID: patient.ID, GuardianID: guardian.ID, Status: models.InvoicePaid, IssueDate: "2026-03-02", DueDate: "2026-03-17", CreatedByID: staff.ID}
+		if err := repo.Invoice.Create(invoice); err != nil {
+			t.Fatal(err)
+		}
+		taxInvoice, err := service.Issue(caller, invoice.ID)
+		return invoice, taxInvoice, err
+	}
+
+	invoice, first, err := issue(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := service.Issue(caller, invoice.ID)
+	if err != nil || again.ID != first.ID || again.Number != first.Number {
+		t.Errorf("issuing again = %v, %v, want the first tax invoice %s", again, err, first.Number)
+	}
+
+	// A number too long for GST isn't issued, and isn't used up
+	if _, _, err := issue(1000); err == nil {
+		t.Error("issuing at branch 1000 succeeded, want the number rejected as too long")
+	}
+	register, err := service.Register(1000, first.FinancialYear)
+	if err != nil || len(register) != 0 {
+		t.Errorf("branch 1000 register = %v, %v, want no tax invoices", register, err)
+	}
+}
+
+func TestFinancialYearOf(t *testing.T) {
+	tests := []struct {
+		day  time.Time
+		want string
+	}{
+		{time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC), "2025-26"},
+		{time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), "2026-27"},
+		{time.Date(2099, 12, 31, 0, 0, 0, 0, time.UTC), "2099-00"},
+	}
+	for _, tt := range tests {
+		if got := financialYearOf(tt.day); got != tt.want {
+			t.Errorf("financialYearOf(%s) = %s, want %s", tt.day.Format(dateLayout), got, tt.want)
+		}
+		if !validFinancialYear(tt.want) {
+			t.Errorf("validFinancialYear(%s) = false", tt.want)
+		}
+	}
+}
+
+func TestRupees(t *testing.T) {
+	tests := []struct {
+		paise int64
+		want  string
+	}{
+		{0, "0.00"},
+		{99, "0.99"},
+		{100000, "1,000.00"},
+		{12500050, "1,25,000.50"},
+		{-1234567, "-12,345.67"},
+	}
+	for _, tt := range tests {
+		if got := rupees(tt.paise); got != tt.want {
+			t.Errorf("rupees(%d) = %s, want %s", tt.paise, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package service
+
+// backend/internal/service/timesheet_service.go
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var ErrTimesheetNotAllowed = errors.New("only admins can view another staff member's timesheet")
+
+// TimesheetPeriod is the length of a timesheet. Weeks start on Monday.
+type TimesheetPeriod string
+
+const (
+	PeriodWeek  TimesheetPeriod = "week"
+	PeriodMonth TimesheetPeriod = "month"
+)
+
+// UtilizationFlag compares a staff member's hours with their expected hours
+type UtilizationFlag string
+
+const (
+	FlagUnder    UtilizationFlag = "under"
+	FlagOver     UtilizationFlag = "over"
+	FlagOnTarget UtilizationFlag = "on_target"
+	FlagNoTarget UtilizationFlag = "no_target" // No expected hours are set
+)
+
+// Timesheet totals a staff member's session hours over a week or month. Completed hours are
+// from sessions that ended with activities recorded; sessions that ended without any are
+// unrecorded, and ones still to come are upcoming. Hours are rounded to hundredths.
+type Timesheet struct {
+	StaffID         string          `json:"staff_id"`
+	StaffName       string          `json:"staff_name"`
+	Period          TimesheetPeriod `json:"period"`
+	StartDate       string          `json:"start_date"`
+	EndDate         string          `json:"end_date"` // Included
+	ExpectedHours   float64         `json:"expected_hours"`
+	ScheduledHours  float64         `json:"scheduled_hours"`
+	CompletedHours  float64         `json:"completed_hours"`
+	UnrecordedHours float64         `json:"unrecorded_hours"`
+	UpcomingHours   float64         `json:"upcoming_hours"`
+	Sessions        int             `json:"sessions"`
+	Utilization     *float64        `json:"utilization"` // Completed hours as a share of expected hours
+	Flag            UtilizationFlag `json:"flag"`
+	Days            []TimesheetDay  `json:"days"`
+}
+
+// TimesheetDay is one day of a timesheet. Sessions count on the day they start.
+type TimesheetDay struct {
+	Date           string  `json:"date"`
+	ScheduledHours float64 `json:"scheduled_hours"`
+	CompletedHours float64 `json:"completed_hours"`
+}
+
+type TimesheetServiceInterface interface {
+	ForStaff(caller *models.Viewer, staffID string, period TimesheetPeriod, date string, now time.Time) (*Timesheet, error)
+	ForAll(period TimesheetPeriod, date string, now time.Time) ([]*Timesheet, error)
+}
+
+type TimesheetService struct {
+	repo     *repository.Repository
+	location *time.Location
+	under    float64
+	over     float64
+}
+
+func NewTimesheetService(repo *repository.Repository, scheduling config.Scheduling, timesheets config.Timesheets) TimesheetServiceInterface {
+	return &TimesheetService{
+		repo:     repo,
+		location: scheduling.Location(),
+		under:    timesheets.UnderUtilization,
+		over:     timesheets.OverUtilization,
+	}
+}
+
+// ForStaff builds a staff member's timesheet for the week or month containing date, or
+// today when date is empty. Staff can see their own timesheet; admins anyone's.
+func (s *TimesheetService) ForStaff(caller *models.Viewer, staffID string, period TimesheetPeriod, date string, now time.Time) (*Timesheet, error) {
+	if caller.StaffID != staffID && caller.Role != models.RoleAdmin {
+		return nil, ErrTimesheetNotAllowed
+	}
+	start, end, err := s.period(period, date, now)
+	if err != nil {
+		return nil, err
+	}
+	staff, err := s.repo.Staff.FindByID(staffID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("staff member not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.repo.Session.FindStartingBetween(staffID, start, end)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := s.recorded(sessions)
+	if err != nil {
+		return nil, err
+	}
+	return s.timesheet(staff, period, start, end, sessions, recorded, now), nil
+}
+
+// ForAll builds every staff member's timesheet for the week or month containing date
+func (s *TimesheetService) ForAll(period TimesheetPeriod, date string, now time.Time) ([]*Timesheet, error) {
+	start, end, err := s.period(period, date, now)
+	if err != nil {
+		return nil, err
+	}
+	staff, err := s.repo.Staff.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	sessions, err := s.repo.Session.FindStartingBetween("", start, end)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := s.recorded(sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	byStaff := map[string][]*models.Session{}
+	for _, session := range sessions {
+		byStaff[session.StaffID] = append(byStaff[session.StaffID], session)
+	}
+	timesheets := make([]*Timesheet, 0, len(staff))
+	for _, member := range staff {
+		timesheets = append(timesheets, s.timesheet(member, period, start, end, byStaff[member.ID], recorded, now))
+	}
+	return timesheets, nil
+}
+
+// TimesheetsCSV writes timesheets as one row per staff member, for payroll
+func TimesheetsCSV(timesheets []*Timesheet) ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+	rows := [][]string{{
+		"staff_id", "staff_name", "period", "start_date", "end_date", "expected_hours", "scheduled_hours",
+		"completed_hours", "unrecorded_hours", "upcoming_hours", "sessions", "utilization", "flag",
+	}}
+	for _, t := range timesheets {
+		utilization := ""
+		if t.Utilization != nil {
+			utilization = formatHours(*t.Utilization)
+		}
+		rows = append(rows, []string{
+			t.StaffID, t.StaffName, string(t.Period), t.StartDate, t.EndDate, formatHours(t.ExpectedHours),
+			formatHours(t.ScheduledHours), formatHours(t.CompletedHours), formatHours(t.UnrecordedHours),
+			formatHours(t.UpcomingHours), strconv.Itoa(t.Sessions), utilization, string(t.Flag),
+		})
+	}
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// timesheet totals the sessions of a staff member starting from start until before end
+func (s *TimesheetService) timesheet(staff *models.Staff, period TimesheetPeriod, start, end time.Time, sessions []*models.Session, recorded map[string]int64, now time.Time) *Timesheet {
+	sheet := &Timesheet{
+		StaffID:   staff.ID,
+		StaffName: staff.Name,
+		Period:    period,
+		StartDate: start.Format(dateLayout),
+		EndDate:   end.AddDate(0, 0, -1).Format(dateLayout),
+		Sessions:  len(sessions),
+		Days:      []TimesheetDay{},
+	}
+
+	days := map[string]*TimesheetDay{}
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		sheet.Days = append(sheet.Days, TimesheetDay{Date: day.Format(dateLayout)})
+	}
+	for i := range sheet.Days {
+		days[sheet.Days[i].Date] = &sheet.Days[i]
+	}
+
+	// Expected hours are weekly, spread evenly over the days worked since the staff member joined
+	worked := 0
+	for _, day := range sheet.Days {
+		if staff.JoinDate.IsZero() || day.Date >= staff.JoinDate.In(s.location).Format(dateLayout) {
+			worked++
+		}
+	}
+	sheet.ExpectedHours = round(float64(staff.ExpectedHours) * float64(worked) / 7)
+
+	for _, session := range sessions {
+		hours := session.EndTime.Sub(session.StartTime).Hours()
+		day := days[session.StartTime.In(s.location).Format(dateLayout)]
+		sheet.ScheduledHours += hours
+		day.ScheduledHours += hours
+		switch {
+		case session.EndTime.After(now):
+			sheet.UpcomingHours += hours
+		case recorded[session.ID] > 0:
+			sheet.CompletedHours += hours
+			day.CompletedHours += hours
+		default:
+			sheet.UnrecordedHours += hours
+		}
+	}
+	sheet.ScheduledHours = round(sheet.ScheduledHours)
+	sheet.CompletedHours = round(sheet.CompletedHours)
+	sheet.UnrecordedHours = round(sheet.UnrecordedHours)
+	sheet.UpcomingHours = round(sheet.UpcomingHours)
+	for i := range sheet.Days {
+		sheet.Days[i].ScheduledHours = round(sheet.Days[i].ScheduledHours)
+		sheet.Days[i].CompletedHours = round(sheet.Days[i].CompletedHours)
+	}
+
+	sheet.Flag = FlagNoTarget
+	if sheet.ExpectedHours > 0 {
+		utilization := round(sheet.CompletedHours / sheet.ExpectedHours)
+		sheet.Utilization = &utilization
+
+		// Until the period is over, judge it by the hours that could still be completed
+		delivered := sheet.CompletedHours
+		if end.After(now) {
+			delivered += sheet.UpcomingHours
+		}
+		switch share := delivered / sheet.ExpectedHours; {
+		case share < s.under:
+			sheet.Flag = FlagUnder
+		case share > s.over:
+			sheet.Flag = FlagOver
+		default:
+			sheet.Flag = FlagOnTarget
+		}
+	}
+	return sheet
+}
+
+// recorded counts the activities recorded in each session
+func (s *TimesheetService) recorded(sessions []*models.Session) (map[string]int64, error) {
+	ids := make([]string, 0, len(sessions))
+	for _, session := range sessions {
+		ids = append(ids, session.ID)
+	}
+	return s.repo.Activity.CountBySessionIDs(ids)
+}
+
+// period finds the local midnights starting and ending the week or month containing date
+func (s *TimesheetService) period(period TimesheetPeriod, date string, now time.Time) (time.Time, time.Time, error) {
+	day := now.In(s.location)
+	if date != "" {
+		parsed, err := time.ParseInLocation(dateLayout, date, s.location)
+		if err != nil {
+			return time.Time{}, time.Time{}, errors.New("date must look like 2026-01-31")
+		}
+		day = parsed
+	}
+
+	if period != PeriodWeek && period != PeriodMonth {
+		return time.Time{}, time.Time{}, errors.New("period must be week or month")
+	}
+	start := periodStart(period, day)
+	return start, nextPeriod(period, start), nil
+}
+
+// periodStart finds the local midnight starting the week or month containing day. Weeks start on Monday.
+func periodStart(period TimesheetPeriod, day time.Time) time.Time {
+	if period == PeriodMonth {
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	}
+	offset := (int(day.Weekday()) + 6) % 7 // Days since Monday
+	return time.Date(day.Year(), day.Month(), day.Day()-offset, 0, 0, 0, 0, day.Location())
+}
+
+// nextPeriod finds the start of the week or month after the one starting at start
+func nextPeriod(period TimesheetPeriod, start time.Time) time.Time {
+	return addPeriods(period, start, 1)
+}
+
+func addPeriods(period TimesheetPeriod, start time.Time, n int) time.Time {
+	if period == PeriodMonth {
+		return start.AddDate(0, n, 0)
+	}
+	return start.AddDate(0, 0, 7*n)
+}
+
+func round(hours float64) float64 {
+	return math.Round(hours*100) / 100
+}
+
+func formatHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', 2, 64)
+}
@@ -0,0 +1,206 @@
+package service
+
+// backend/internal/service/timesheet_service_test.go
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestTimesheetUtilization(t *testing.T) {
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	afterWeek := monday.AddDate(0, 0, 8)
+
+	// booking is a two hour session at 10:00 on a day of the week, with or without activities
+	type booking struct {
+		day      int
+		recorded bool
+	}
+	daily := func(days int, recorded bool) []booking {
+		var bookings []booking
+		for day := range days {
+			bookings = append(bookings, booking{day, recorded})
+		}
+		return bookings
+	}
+
+	tests := []struct {
+		name     string
+		expected int       // Weekly hours
+		joined   time.Time // Zero for long before the week
+		bookings []booking
+		now      time.Time
+		want     Timesheet
+	}{
+		{
+			name:     "on target",
+			expected: 10,
+			bookings: daily(5, true),
+			now:      afterWeek,
+			want:     Timesheet{ExpectedHours: 10, ScheduledHours: 10, CompletedHours: 10, Sessions: 5, Flag: FlagOnTarget},
+		},
+		{
+			name:     "under with unrecorded sessions",
+			expected: 10,
+			bookings: append(daily(2, true), booking{4, false}),
+			now:      afterWeek,
+			want:     Timesheet{ExpectedHours: 10, ScheduledHours: 6, CompletedHours: 4, UnrecordedHours: 2, Sessions: 3, Flag: FlagUnder},
+		},
+		{
+			name:     "over",
+			expected: 10,
+			bookings: daily(7, true),
+			now:      afterWeek,
+			want:     Timesheet{ExpectedHours: 10, ScheduledHours: 14, CompletedHours: 14, Sessions: 7, Flag: FlagOver},
+		},
+		{
+			name:     "upcoming hours count until the week is over",
+			expected: 10,
+			bookings: append(daily(1, true), booking{3, false}, booking{4, false}, booking{5, false}, booking{6, false}),
+			now:      monday.AddDate(0, 0, 2),
+			want:     Timesheet{ExpectedHours: 10, ScheduledHours: 10, CompletedHours: 2, UpcomingHours: 8, Sessions: 5, Flag: FlagOnTarget},
+		},
+		{
+			name:     "expected hours from the day they joined",
+			expected: 14,
+			joined:   monday.AddDate(0, 0, 3),
+			bookings: []booking{{3, true}, {4, true}, {5, true}, {6, true}},
+			now:      afterWeek,
+			want:     Timesheet{ExpectedHours: 8, ScheduledHours: 8, CompletedHours: 8, Sessions: 4, Flag: FlagOnTarget},
+		},
+		{
+			name:     "no expected hours",
+			bookings: daily(2, true),
+			now:      afterWeek,
+			want:     Timesheet{ScheduledHours: 4, CompletedHours: 4, Sessions: 2, Flag: FlagNoTarget},
+		},
+	}
+
+	for _, tt := range tests {
+		repo := newTestRepository(t)
+		patient, _ := createTestPatient(t, repo)
+		joined := tt.joined
+		if joined.IsZero() {
+			joined = monday.AddDate(-1, 0, 0)
+		}
+		staff := &models.Staff{ID: uuid.NewString(), Name: "Therapist", Role: models.RoleTherapist, JoinDate: joined, ExpectedHours: tt.expected}
+		if err := repo.Staff.Create(staff); err != nil {
+			t.Fatal(err)
+		}
+		for _, b := range tt.bookings {
+			start := monday.AddDate(0, 0, b.day).Add(10 * time.Hour)
+			session := &models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(2 * time.Hour)}
+			if err := repo.Session.Create(session); err != nil {
+				t.Fatal(err)
+			}
+			if b.recorded {
+				if err := repo.Activity.Create(&models.Activity{ID: uuid.NewString(), SessionID: &session.ID}); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+
+		service := NewTimesheetService(repo, config.Scheduling{Timezone: "UTC"}, config.Timesheets{UnderUtilization: 0.9, OverUtilization: 1.1})
+		sheet, err := service.ForStaff(&models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist}, staff.ID, PeriodWeek, "2026-03-04", tt.now)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if sheet.StartDate != "2026-03-02" || sheet.EndDate != "2026-03-08" || len(sheet.Days) != 7 {
+			t.Errorf("%s: the week runs from %s to %s over %d days, want Monday to Sunday", tt.name, sheet.StartDate, sheet.EndDate, len(sheet.Days))
+		}
+		got := Timesheet{
+			ExpectedHours:   sheet.ExpectedHours,
+			ScheduledHours:  sheet.ScheduledHours,
+			CompletedHours:  sheet.CompletedHours,
+			UnrecordedHours: sheet.UnrecordedHours,
+			UpcomingHours:   sheet.UpcomingHours,
+			Sessions:        sheet.Sessions,
+			Flag:            sheet.Flag,
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: timesheet = %+v, want %+v", tt.name, got, tt.want)
+		}
+		if tt.want.ExpectedHours == 0 {
+			if sheet.Utilization != nil {
+				t.Errorf("%s: utilization = %v, want none", tt.name, *sheet.Utilization)
+			}
+		} else if want := round(tt.want.CompletedHours / tt.want.ExpectedHours); sheet.Utilization == nil || *sheet.Utilization != want {
+			t.Errorf("%s: utilization = %v, want %v", tt.name, sheet.Utilization, want)
+		}
+	}
+}
+
+func TestTimesheetAccessAndPeriods(t *testing.T) {
+	repo := newTestRepository(t)
+	_, staff := createTestPatient(t, repo)
+	service := NewTimesheetService(repo, config.Scheduling{Timezone: "UTC"}, config.Timesheets{UnderUtilization: 0.9, OverUtilization: 1.1})
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		caller     models.Viewer
+		period     TimesheetPeriod
+		date       string
+		start, end string
+		wantErr    error
+	}{
+		{"own week", models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist}, PeriodWeek, "", "2026-03-02", "2026-03-08", nil},
+		{"own month", models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist}, PeriodMonth, "2026-02-14", "2026-02-01", "2026-02-28", nil},
+		{"week from a Sunday", models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist}, PeriodWeek, "2026-03-08", "2026-03-02", "2026-03-08", nil},
+		{"someone else's as an admin", models.Viewer{StaffID: uuid.NewString(), Role: models.RoleAdmin}, PeriodWeek, "", "2026-03-02", "2026-03-08", nil},
+		{"someone else's as a therapist", models.Viewer{StaffID: uuid.NewString(), Role: models.RoleTherapist}, PeriodWeek, "", "", "", ErrTimesheetNotAllowed},
+	}
+	for _, tt := range tests {
+		sheet, err := service.ForStaff(&tt.caller, staff.ID, tt.period, tt.date, now)
+		if tt.wantErr != nil {
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if sheet.StartDate != tt.start || sheet.EndDate != tt.end {
+			t.Errorf("%s: runs from %s to %s, want %s to %s", tt.name, sheet.StartDate, sheet.EndDate, tt.start, tt.end)
+		}
+	}
+
+	if _, err := service.ForStaff(&models.Viewer{StaffID: staff.ID}, staff.ID, "fortnight", "", now); err == nil {
+		t.Error("an unknown period was accepted")
+	}
+}
+
+func TestTimesheetsCSV(t *testing.T) {
+	utilization := 0.85
+	data, err := TimesheetsCSV([]*Timesheet{
+		{
+			StaffID: "staff-1", StaffName: "Asha, R", Period: PeriodWeek, StartDate: "2026-03-02", EndDate: "2026-03-08",
+			ExpectedHours: 20, ScheduledHours: 18.5, CompletedHours: 17, UnrecordedHours: 1.5, Sessions: 9,
+			Utilization: &utilization, Flag: FlagUnder,
+		},
+		{StaffID: "staff-2", StaffName: "Ravi", Period: PeriodWeek, StartDate: "2026-03-02", EndDate: "2026-03-08", Flag: FlagNoTarget},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := strings.Join([]string{
+		"staff_id,staff_name,period,start_date,end_date,expected_hours,scheduled_hours,completed_hours,unrecorded_hours,upcoming_hours,sessions,utilization,flag",
+		`staff-1,"Asha, R",week,2026-03-02,2026-03-08,20.00,18.50,17.00,1.50,0.00,9,0.85,under`,
+		"staff-2,Ravi,week,2026-03-02,2026-03-08,0.00,0.00,0.00,0.00,0.00,0,,no_target",
+		"",
+	}, "\n")
+	if string(data) != want {
+		t.Errorf("TimesheetsCSV() =\n%s\nwant\n%s", data, want)
+	}
+}
@@ -0,0 +1,527 @@
+package service
+
+// backend/internal/service/treatment_plan_service.go
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrPlanNotFound       = errors.New("treatment plan not found")
+	ErrGoalNotFound       = errors.New("treatment goal not found")
+	ErrTargetNotFound     = errors.New("treatment target not found")
+	ErrPlanAlreadyActive  = errors.New("the patient already has an active treatment plan")
+	ErrPlanNotDraft       = errors.New("only draft treatment plans can be deleted")
+	ErrPlanClosed         = errors.New("completed and discontinued treatment plans can't be changed")
+	ErrTargetPractised    = errors.New("targets practised in activities can't be deleted")
+	ErrTargetNotInPlan    = errors.New("target is not in the session patient's treatment plan")
+	ErrTargetNotPractised = errors.New("activities can only practise targets of an active treatment plan that haven't been discontinued")
+)
+
+// Mastery criteria given to targets that don't set their own: 80% across 3 consecutive sessions
+const (
+	defaultMasteryPercent  = 80
+	defaultMasterySessions = 3
+)
+
+type TreatmentPlanServiceInterface interface {
+	ListByPatient(patientID string) ([]*models.TreatmentPlan, error)
+	GetByID(id int) (*models.TreatmentPlan, error)
+	Create(caller *models.Viewer, plan *models.TreatmentPlan) (*models.TreatmentPlan, error)
+	Update(id int, plan *models.TreatmentPlan) (*models.TreatmentPlan, error)
+	Delete(id int) error
+	AddGoal(planID int, goal *models.TreatmentGoal) (*models.TreatmentGoal, error)
+	UpdateGoal(id int, goal *models.TreatmentGoal) (*models.TreatmentGoal, error)
+	DeleteGoal(id int) error
+	AddTarget(goalID int, target *models.TreatmentTarget) (*models.TreatmentTarget, error)
+	UpdateTarget(id int, target *models.TreatmentTarget) (*models.TreatmentTarget, error)
+	DeleteTarget(id int) error
+	CheckActivityTarget(sessionID string, targetID *int) error
+}
+
+type TreatmentPlanService struct {
+	repo     *repository.Repository
+	location *time.Location
+}
+
+func NewTreatmentPlanService(repo *repository.Repository, scheduling config.Scheduling) TreatmentPlanServiceInterface {
+	return &TreatmentPlanService{repo: repo, location: scheduling.Location()}
+}
+
+// List a patient's treatment plans, newest first
+func (s *TreatmentPlanService) ListByPatient(patientID string) ([]*models.TreatmentPlan, error) {
+	if _, err := s.findPatient(patientID); err != nil {
+		return nil, err
+	}
+	return s.repo.TreatmentPlan.FindByPatientID(patientID)
+}
+
+// Get a treatment plan by ID. Plans of patients outside the caller's caseload aren't found.
+func (s *TreatmentPlanService) GetByID(id int) (*models.TreatmentPlan, error) {
+	plan, err := s.repo.TreatmentPlan.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrPlanNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.findPatient(plan.PatientID); err != nil {
+		if err.Error() == "patient not found" {
+			return nil, ErrPlanNotFound
+		}
+		return nil, err
+	}
+	return plan, nil
+}
+
+// Create a treatment plan with the goals and targets it comes with
+func (s *TreatmentPlanService) Create(caller *models.Viewer, plan *models.TreatmentPlan) (*models.TreatmentPlan, error) {
+	if _, err := s.findPatient(plan.PatientID); err != nil {
+		return nil, err
+	}
+	if plan.StartDate == "" {
+		plan.StartDate = time.Now().In(s.location).Format(dateLayout)
+	}
+	if err := s.checkPlan(0, plan); err != nil {
+		return nil, err
+	}
+	for i := range plan.Goals {
+		if err := checkGoal(&plan.Goals[i]); err != nil {
+			return nil, err
+		}
+		for j := range plan.Goals[i].Targets {
+			if err := checkTarget(&plan.Goals[i].Targets[j]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	plan.CreatedByID = caller.StaffID
+
+	err := s.repo.Transaction(func(repo *repository.Repository) error {
+		if err := repo.TreatmentPlan.Create(plan); err != nil {
+			return err
+		}
+		for i := range plan.Goals {
+			goal := &plan.Goals[i]
+			goal.PlanID = plan.ID
+			if err := createGoal(repo, goal); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.TreatmentPlan.FindByID(plan.ID)
+}
+
+// Update a treatment plan's title, status, dates and notes. Its goals are left alone.
+func (s *TreatmentPlanService) Update(id int, plan *models.TreatmentPlan) (*models.TreatmentPlan, error) {
+	existing, err := s.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	plan.PatientID = existing.PatientID
+	if plan.StartDate == "" {
+		plan.StartDate = existing.StartDate
+	}
+	if err := s.checkPlan(id, plan); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.TreatmentPlan.Update(id, map[string]interface{}{
+		"title":            plan.Title,
+		"status":           plan.Status,
+		"start_date":       plan.StartDate,
+		"review_date":      plan.ReviewDate,
+		"last_reviewed_on": plan.LastReviewedOn,
+		"notes":            plan.Notes,
+	}); err != nil {
+		return nil, err
+	}
+	return s.repo.TreatmentPlan.FindByID(id)
+}
+
+// Delete a draft treatment plan. Plans that were put to use are completed or discontinued instead.
+func (s *TreatmentPlanService) Delete(id int) error {
+	plan, err := s.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if plan.Status != models.PlanDraft {
+		return ErrPlanNotDraft
+	}
+	if err := s.checkUnpractised(targetIDs(plan.Goals...)); err != nil {
+		return err
+	}
+
+	return s.repo.Transaction(func(repo *repository.Repository) error {
+		return repo.TreatmentPlan.Delete(id)
+	})
+}
+
+// AddGoal adds a goal with the targets it comes with to a draft or active plan
+func (s *TreatmentPlanService) AddGoal(planID int, goal *models.TreatmentGoal) (*models.TreatmentGoal, error) {
+	plan, err := s.GetByID(planID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOpen(plan); err != nil {
+		return nil, err
+	}
+	if err := checkGoal(goal); err != nil {
+		return nil, err
+	}
+	for i := range goal.Targets {
+		if err := checkTarget(&goal.Targets[i]); err != nil {
+			return nil, err
+		}
+	}
+	goal.PlanID = plan.ID
+
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		return createGoal(repo, goal)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.TreatmentPlan.FindGoalByID(goal.ID)
+}
+
+// UpdateGoal changes a goal's description and status. Its targets are left alone.
+func (s *TreatmentPlanService) UpdateGoal(id int, goal *models.TreatmentGoal) (*models.TreatmentGoal, error) {
+	if _, err := s.openGoal(id); err != nil {
+		return nil, err
+	}
+	if err := checkGoal(goal); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.TreatmentPlan.UpdateGoal(id, map[string]interface{}{
+		"description": goal.Description,
+		"status":      goal.Status,
+	}); err != nil {
+		return nil, err
+	}
+	return s.repo.TreatmentPlan.FindGoalByID(id)
+}
+
+// DeleteGoal deletes a goal with its targets, unless activities practised any of them
+func (s *TreatmentPlanService) DeleteGoal(id int) error {
+	goal, err := s.openGoal(id)
+	if err != nil {
+		return err
+	}
+	if err := s.checkUnpractised(targetIDs(*goal)); err != nil {
+		return err
+	}
+
+	return s.repo.Transaction(func(repo *repository.Repository) error {
+		return repo.TreatmentPlan.DeleteGoal(id)
+	})
+}
+
+// AddTarget adds a target to a goal of a draft or active plan
+func (s *TreatmentPlanService) AddTarget(goalID int, target *models.TreatmentTarget) (*models.TreatmentTarget, error) {
+	goal, err := s.openGoal(goalID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTarget(target); err != nil {
+		return nil, err
+	}
+	target.PlanID = goal.PlanID
+	target.GoalID = goal.ID
+
+	if err := s.repo.TreatmentPlan.CreateTarget(target); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// UpdateTarget changes a target's description, baseline, mastery criteria and status.
+// Marking it mastered records when; moving it back out of mastered clears that.
+func (s *TreatmentPlanService) UpdateTarget(id int, target *models.TreatmentTarget) (*models.TreatmentTarget, error) {
+	existing, err := s.openTarget(id)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTarget(target); err != nil {
+		return nil, err
+	}
+
+	masteredAt := existing.MasteredAt
+	switch {
+	case target.Status != models.TargetMastered:
+		masteredAt = nil
+	case existing.Status != models.TargetMastered:
+		now := time.Now()
+		masteredAt = &now
+	}
+
+	if err := s.repo.TreatmentPlan.UpdateTarget(id, map[string]interface{}{
+		"description":      target.Description,
+		"baseline_percent": target.BaselinePercent,
+		"baseline_notes":   target.BaselineNotes,
+		"mastery_percent":  target.MasteryPercent,
+		"mastery_sessions": target.MasterySessions,
+		"status":           target.Status,
+		"mastered_at":      masteredAt,
+	}); err != nil {
+		return nil, err
+	}
+	return s.repo.TreatmentPlan.FindTargetByID(id)
+}
+
+// DeleteTarget deletes a target no activity practised. Practised targets are discontinued instead.
+func (s *TreatmentPlanService) DeleteTarget(id int) error {
+	if _, err := s.openTarget(id); err != nil {
+		return err
+	}
+	if err := s.checkUnpractised([]int{id}); err != nil {
+		return err
+	}
+	return s.repo.TreatmentPlan.DeleteTarget(id)
+}
+
+// CheckActivityTarget checks that an activity of a session can practise a target: the target
+// must belong to the active plan of the session's patient and not be discontinued
+func (s *TreatmentPlanService) CheckActivityTarget(sessionID string, targetID *int) error {
+	if targetID == nil {
+		return nil
+	}
+	target, err := s.findTarget(*targetID)
+	if err != nil {
+		return err
+	}
+	session, err := s.repo.Session.FindByID(sessionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return errors.New("session not found")
+	}
+	if err != nil {
+		return err
+	}
+	plan, err := s.repo.TreatmentPlan.FindByID(target.PlanID)
+	if err != nil {
+		return err
+	}
+
+	if plan.PatientID != session.PatientID {
+		return ErrTargetNotInPlan
+	}
+	if plan.Status != models.PlanActive || target.Status == models.TargetDiscontinued {
+		return ErrTargetNotPractised
+	}
+	return nil
+}
+
+// createGoal creates a goal and the targets it comes with
+func createGoal(repo *repository.Repository, goal *models.TreatmentGoal) error {
+	if err := repo.TreatmentPlan.CreateGoal(goal); err != nil {
+		return err
+	}
+	for i := range goal.Targets {
+		target := &goal.Targets[i]
+		target.PlanID = goal.PlanID
+		target.GoalID = goal.ID
+		if err := repo.TreatmentPlan.CreateTarget(target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkPlan validates a plan and that activating it leaves the patient with one active plan
+func (s *TreatmentPlanService) checkPlan(id int, plan *models.TreatmentPlan) error {
+	plan.Title = strings.TrimSpace(plan.Title)
+	if plan.Title == "" {
+		return errors.New("title is required")
+	}
+	if plan.Status == "" {
+		plan.Status = models.PlanDraft
+	}
+	switch plan.Status {
+	case models.PlanDraft, models.PlanActive, models.PlanCompleted, models.PlanDiscontinued:
+	default:
+		return errors.New("status must be draft, active, completed or discontinued")
+	}
+	if _, err := time.Parse(dateLayout, plan.StartDate); err != nil {
+		return errors.New("start date must look like 2026-01-31")
+	}
+	if _, err := time.Parse(dateLayout, plan.ReviewDate); err != nil {
+		return errors.New("review date must look like 2026-01-31")
+	}
+	if plan.ReviewDate < plan.StartDate {
+		return errors.New("review date can't be before the start date")
+	}
+	if plan.LastReviewedOn != nil {
+		if _, err := time.Parse(dateLayout, *plan.LastReviewedOn); err != nil {
+			return errors.New("last reviewed date must look like 2026-01-31")
+		}
+	}
+
+	if plan.Status != models.PlanActive {
+		return nil
+	}
+	active, err := s.repo.TreatmentPlan.FindActive(plan.PatientID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if active.ID != id {
+		return ErrPlanAlreadyActive
+	}
+	return nil
+}
+
+func checkGoal(goal *models.TreatmentGoal) error {
+	goal.Description = strings.TrimSpace(goal.Description)
+	if goal.Description == "" {
+		return errors.New("goal description is required")
+	}
+	if goal.Status == "" {
+		goal.Status = models.GoalActive
+	}
+	switch goal.Status {
+	case models.GoalActive, models.GoalMet, models.GoalDiscontinued:
+		return nil
+	default:
+		return errors.New("goal status must be active, met or discontinued")
+	}
+}
+
+// checkTarget validates a target, giving it the default mastery criteria if it has none
+func checkTarget(target *models.TreatmentTarget) error {
+	target.Description = strings.TrimSpace(target.Description)
+	if target.Description == "" {
+		return errors.New("target description is required")
+	}
+	if target.BaselinePercent != nil && (*target.BaselinePercent < 0 || *target.BaselinePercent > 100) {
+		return errors.New("baseline percent must be between 0 and 100")
+	}
+	if target.MasteryPercent == 0 {
+		target.MasteryPercent = defaultMasteryPercent
+	}
+	if target.MasterySessions == 0 {
+		target.MasterySessions = defaultMasterySessions
+	}
+	if target.MasteryPercent < 0 || target.MasteryPercent > 100 {
+		return errors.New("mastery percent must be between 0 and 100")
+	}
+	if target.MasterySessions < 1 {
+		return errors.New("mastery sessions must be at least 1")
+	}
+	if target.Status == "" {
+		target.Status = models.TargetInProgress
+	}
+	switch target.Status {
+	case models.TargetInProgress, models.TargetMastered, models.TargetOnHold, models.TargetDiscontinued:
+	default:
+		return errors.New("target status must be in_progress, mastered, on_hold or discontinued")
+	}
+	if target.Status == models.TargetMastered && target.MasteredAt == nil {
+		now := time.Now()
+		target.MasteredAt = &now
+	}
+	return nil
+}
+
+// checkOpen stops goals and targets of completed or discontinued plans from changing
+func checkOpen(plan *models.TreatmentPlan) error {
+	if plan.Status == models.PlanCompleted || plan.Status == models.PlanDiscontinued {
+		return ErrPlanClosed
+	}
+	return nil
+}
+
+// checkUnpractised stops targets that activities practised from being deleted
+func (s *TreatmentPlanService) checkUnpractised(ids []int) error {
+	practised, err := s.repo.TreatmentPlan.IsPractised(ids)
+	if err != nil {
+		return err
+	}
+	if practised {
+		return ErrTargetPractised
+	}
+	return nil
+}
+
+// openGoal finds a goal of a draft or active plan in the caller's caseload
+func (s *TreatmentPlanService) openGoal(id int) (*models.TreatmentGoal, error) {
+	goal, err := s.repo.TreatmentPlan.FindGoalByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrGoalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.GetByID(goal.PlanID)
+	if errors.Is(err, ErrPlanNotFound) {
+		return nil, ErrGoalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOpen(plan); err != nil {
+		return nil, err
+	}
+	return goal, nil
+}
+
+// openTarget finds a target of a draft or active plan in the caller's caseload
+func (s *TreatmentPlanService) openTarget(id int) (*models.TreatmentTarget, error) {
+	target, err := s.findTarget(id)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.GetByID(target.PlanID)
+	if errors.Is(err, ErrPlanNotFound) {
+		return nil, ErrTargetNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOpen(plan); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+func (s *TreatmentPlanService) findTarget(id int) (*models.TreatmentTarget, error) {
+	target, err := s.repo.TreatmentPlan.FindTargetByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTargetNotFound
+	}
+	return target, err
+}
+
+func (s *TreatmentPlanService) findPatient(patientID string) (*models.Patient, error) {
+	patient, err := s.repo.Patient.FindByID(patientID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("patient not found")
+	}
+	return patient, err
+}
+
+// targetIDs lists the IDs of the goals' targets
+func targetIDs(goals ...models.TreatmentGoal) []int {
+	var ids []int
+	for _, goal := range goals {
+		for _, target := range goal.Targets {
+			ids = append(ids, target.ID)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,195 @@
+package service
+
+// backend/internal/service/treatment_plan_service_test.go
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/config"
+	"palaam/internal/models"
+)
+
+func TestCheckTarget(t *testing.T) {
+	percent := func(value float64) *float64 { return &value }
+
+	tests := []struct {
+		name         string
+		target       models.TreatmentTarget
+		wantPercent  float64
+		wantSessions int
+		wantStatus   models.TargetStatus
+		wantErr      bool
+	}{
+		{name: "default mastery criteria", target: models.TreatmentTarget{Description: "Requests preferred items"}, wantPercent: 80, wantSessions: 3, wantStatus: models.TargetInProgress},
+		{name: "own mastery criteria", target: models.TreatmentTarget{Description: "Tacts colors", MasteryPercent: 90, MasterySessions: 2, BaselinePercent: percent(20)}, wantPercent: 90, wantSessions: 2, wantStatus: models.TargetInProgress},
+		{name: "mastered from the start", target: models.TreatmentTarget{Description: "Imitates claps", Status: models.TargetMastered}, wantPercent: 80, wantSessions: 3, wantStatus: models.TargetMastered},
+		{name: "no description", target: models.TreatmentTarget{Description: "  "}, wantErr: true},
+		{name: "mastery over 100%", target: models.TreatmentTarget{Description: "Tacts colors", MasteryPercent: 120}, wantErr: true},
+		{name: "negative mastery sessions", target: models.TreatmentTarget{Description: "Tacts colors", MasterySessions: -1}, wantErr: true},
+		{name: "baseline under 0%", target: models.TreatmentTarget{Description: "Tacts colors", BaselinePercent: percent(-5)}, wantErr: true},
+		{name: "unknown status", target: models.TreatmentTarget{Description: "Tacts colors", Status: "done"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		target := tt.target
+		err := checkTarget(&target)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: checkTarget error = %v, want error %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if target.MasteryPercent != tt.wantPercent || target.MasterySessions != tt.wantSessions || target.Status != tt.wantStatus {
+			t.Errorf("%s: target is %s at %v%% across %d sessions, want %s at %v%% across %d", tt.name,
+				target.Status, target.MasteryPercent, target.MasterySessions, tt.wantStatus, tt.wantPercent, tt.wantSessions)
+		}
+		if (target.Status == models.TargetMastered) != (target.MasteredAt != nil) {
+			t.Errorf("%s: a %s target was mastered at %v", tt.name, target.Status, target.MasteredAt)
+		}
+	}
+}
+
+func TestTreatmentPlanLifecycle(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	service := NewTreatmentPlanService(repo, config.Scheduling{Timezone: "UTC"})
+	caller := &models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist}
+
+	plan, err := service.Create(caller, &models.TreatmentPlan{
+		PatientID:  patient.ID,
+		Title:      "Spring plan",
+		Status:     models.PlanActive,
+		StartDate:  "2026-03-01",
+		ReviewDate: "2026-06-01",
+		Goals: []models.TreatmentGoal{{
+			Description: "Requests preferred items",
+			Targets:     []models.TreatmentTarget{{Description: "Two-word requests"}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Goals) != 1 || len(plan.Goals[0].Targets) != 1 || plan.CreatedByID != staff.ID {
+		t.Fatalf("created plan = %+v, want one goal with one target by the caller", plan)
+	}
+	target := plan.Goals[0].Targets[0]
+
+	tests := []struct {
+		name    string
+		plan    models.TreatmentPlan
+		wantErr error
+	}{
+		{"second active plan", models.TreatmentPlan{PatientID: patient.ID, Title: "Another", Status: models.PlanActive, ReviewDate: "2026-12-01"}, ErrPlanAlreadyActive},
+		{"draft beside the active plan", models.TreatmentPlan{PatientID: patient.ID, Title: "Next", ReviewDate: "2026-12-01"}, nil},
+		{"no title", models.TreatmentPlan{PatientID: patient.ID, ReviewDate: "2026-12-01"}, errors.New("title is required")},
+		{"review before the start", models.TreatmentPlan{PatientID: patient.ID, Title: "Next", StartDate: "2026-03-01", ReviewDate: "2026-02-01"}, errors.New("review date can't be before the start date")},
+		{"unknown patient", models.TreatmentPlan{PatientID: uuid.NewString(), Title: "Next", ReviewDate: "2026-12-01"}, errors.New("patient not found")},
+	}
+	for _, tt := range tests {
+		_, err := service.Create(caller, &tt.plan)
+		if tt.wantErr == nil {
+			if err != nil {
+				t.Errorf("%s: Create error = %v", tt.name, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tt.wantErr.Error() {
+			t.Errorf("%s: Create error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+
+	// Targets practised in an activity stay, and the plan can't go back to being deleted as a draft
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	session := &models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(time.Hour)}
+	if err := repo.Session.Create(session); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Activity.Create(&models.Activity{ID: uuid.NewString(), SessionID: &session.ID, TargetID: &target.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := service.DeleteTarget(target.ID); !errors.Is(err, ErrTargetPractised) {
+		t.Errorf("deleting a practised target error = %v, want %v", err, ErrTargetPractised)
+	}
+	if err := service.Delete(plan.ID); !errors.Is(err, ErrPlanNotDraft) {
+		t.Errorf("deleting an active plan error = %v, want %v", err, ErrPlanNotDraft)
+	}
+
+	// Marking a target mastered records when, and moving it back clears that
+	mastered := models.TreatmentTarget{Description: target.Description, Status: models.TargetMastered}
+	updated, err := service.UpdateTarget(target.ID, &mastered)
+	if err != nil || updated.MasteredAt == nil {
+		t.Errorf("mastering the target = %v, %v, want it mastered", updated, err)
+	}
+	reopened := models.TreatmentTarget{Description: target.Description, Status: models.TargetInProgress}
+	updated, err = service.UpdateTarget(target.ID, &reopened)
+	if err != nil || updated.MasteredAt != nil {
+		t.Errorf("reopening the target = %v, %v, want it no longer mastered", updated, err)
+	}
+
+	// Goals of a completed plan can't change
+	completed := *plan
+	completed.Status = models.PlanCompleted
+	if _, err := service.Update(plan.ID, &completed); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := service.AddGoal(plan.ID, &models.TreatmentGoal{Description: "Follows directions"}); !errors.Is(err, ErrPlanClosed) {
+		t.Errorf("adding a goal to a completed plan error = %v, want %v", err, ErrPlanClosed)
+	}
+}
+
+func TestCheckActivityTarget(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	other, _ := createTestPatient(t, repo)
+	service := NewTreatmentPlanService(repo, config.Scheduling{Timezone: "UTC"})
+	caller := &models.Viewer{StaffID: staff.ID, Role: models.RoleTherapist}
+
+	createPlan := func(patientID string, status models.TreatmentPlanStatus, targets ...models.TreatmentTarget) []models.TreatmentTarget {
+		t.Helper()
+		plan, err := service.Create(caller, &models.TreatmentPlan{
+			PatientID:  patientID,
+			Title:      "Plan",
+			Status:     status,
+			ReviewDate: "2099-01-01",
+			Goals:      []models.TreatmentGoal{{Description: "Goal", Targets: targets}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return plan.Goals[0].Targets
+	}
+	active := createPlan(patient.ID, models.PlanActive,
+		models.TreatmentTarget{Description: "Practised"},
+		models.TreatmentTarget{Description: "Dropped", Status: models.TargetDiscontinued})
+	draft := createPlan(patient.ID, models.PlanDraft, models.TreatmentTarget{Description: "Not started"})
+	othersActive := createPlan(other.ID, models.PlanActive, models.TreatmentTarget{Description: "Someone else's"})
+
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	session := &models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, StartTime: start, EndTime: start.Add(time.Hour)}
+	if err := repo.Session.Create(session); err != nil {
+		t.Fatal(err)
+	}
+
+	missing := 0
+	tests := []struct {
+		name     string
+		targetID *int
+		wantErr  error
+	}{
+		{"no target", nil, nil},
+		{"target of the active plan", &active[0].ID, nil},
+		{"discontinued target", &active[1].ID, ErrTargetNotPractised},
+		{"target of a draft plan", &draft[0].ID, ErrTargetNotPractised},
+		{"another patient's target", &othersActive[0].ID, ErrTargetNotInPlan},
+		{"unknown target", &missing, ErrTargetNotFound},
+	}
+	for _, tt := range tests {
+		if err := service.CheckActivityTarget(session.ID, tt.targetID); !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: CheckActivityTarget error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package service
+
+// backend/internal/service/trial_service.go
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"gorm.io/gorm"
+
+	"palaam/internal/models"
+	"palaam/internal/repository"
+)
+
+var (
+	ErrTrialNotFound = errors.New("trial not found")
+	ErrNoTrials      = errors.New("at least one trial is required")
+)
+
+// TargetSession scores the trials a target was practised with in one session, across all
+// of the session's activities that practised it
+type TargetSession struct {
+	SessionID          string    `json:"session_id"`
+	StartTime          time.Time `json:"start_time"`
+	Trials             int       `json:"trials"`
+	Independent        int       `json:"independent"`
+	PercentIndependent float64   `json:"percent_independent"`
+	MeetsCriterion     bool      `json:"meets_criterion"` // At or above the target's mastery percent
+}
+
+// TargetProgress is a target with how it scored in each session it was practised, earliest first
+type TargetProgress struct {
+	Target   *models.TreatmentTarget `json:"target"`
+	Sessions []*TargetSession        `json:"sessions"`
+}
+
+// TrialResult is what recording trials did. Session and Target are nil when the activity
+// doesn't practise a target.
+type TrialResult struct {
+	Trials   []*models.Trial         `json:"trials"`
+	Session  *TargetSession          `json:"session"`
+	Target   *models.TreatmentTarget `json:"target"`
+	Mastered bool                    `json:"mastered"` // Whether the trials met the target's mastery criteria
+}
+
+type TrialServiceInterface interface {
+	FindActivity(id string) (*models.Activity, error)
+	Get(id int) (*models.Trial, error)
+	List(activityID string) ([]*models.Trial, error)
+	Record(caller *models.Viewer, activityID string, trials []*models.Trial, now time.Time) (*TrialResult, error)
+	Delete(id int) error
+	TargetProgress(targetID int) (*TargetProgress, error)
+}
+
+type TrialService struct {
+	repo *repository.Repository
+}
+
+func NewTrialService(repo *repository.Repository) TrialServiceInterface {
+	return &TrialService{repo: repo}
+}
+
+// FindActivity finds an activity in the caller's caseload
+func (s *TrialService) FindActivity(id string) (*models.Activity, error) {
+	activity, err := s.repo.Activity.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, errors.New("activity not found")
+	}
+	return activity, err
+}
+
+// Get a trial with its activity. Trials of activities outside the caller's caseload aren't found.
+func (s *TrialService) Get(id int) (*models.Trial, error) {
+	trial, err := s.repo.Trial.FindByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTrialNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	activity, err := s.FindActivity(trial.ActivityID)
+	if err != nil {
+		if err.Error() == "activity not found" {
+			return nil, ErrTrialNotFound
+		}
+		return nil, err
+	}
+	trial.Activity = activity
+	return trial, nil
+}
+
+// List an activity's trials in the order they were run
+func (s *TrialService) List(activityID string) ([]*models.Trial, error) {
+	if _, err := s.FindActivity(activityID); err != nil {
+		return nil, err
+	}
+	return s.repo.Trial.FindByActivityID(activityID)
+}
+
+// Record adds trials to an activity, numbered after the ones it already has. When the activity
+// practises a target that's in progress and the trials complete its mastery criteria, the
+// target is marked mastered.
+func (s *TrialService) Record(caller *models.Viewer, activityID string, trials []*models.Trial, now time.Time) (*TrialResult, error) {
+	activity, err := s.FindActivity(activityID)
+	if err != nil {
+		return nil, err
+	}
+	if len(trials) == 0 {
+		return nil, ErrNoTrials
+	}
+	for _, trial := range trials {
+		if err := checkTrial(trial); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &TrialResult{Trials: trials}
+	err = s.repo.Transaction(func(repo *repository.Repository) error {
+		last, err := repo.Trial.LastNumber(activityID)
+		if err != nil {
+			return err
+		}
+		for i, trial := range trials {
+			trial.ActivityID = activityID
+			trial.Number = last + i + 1
+			trial.RecordedByID = caller.StaffID
+		}
+		if err := repo.Trial.Create(trials); err != nil {
+			return err
+		}
+
+		if activity.TargetID == nil {
+			return nil
+		}
+		target, err := repo.TreatmentPlan.FindTargetByID(*activity.TargetID)
+		if err != nil {
+			return err
+		}
+		sessions, err := targetSessions(repo, target)
+		if err != nil {
+			return err
+		}
+		for _, session := range sessions {
+			if activity.SessionID != nil && session.SessionID == *activity.SessionID {
+				result.Session = session
+			}
+		}
+
+		if target.Status == models.TargetInProgress && masteryMet(target, sessions) {
+			if err := repo.TreatmentPlan.UpdateTarget(target.ID, map[string]interface{}{
+				"status":      models.TargetMastered,
+				"mastered_at": now,
+			}); err != nil {
+				return err
+			}
+			target.Status = models.TargetMastered
+			target.MasteredAt = &now
+			result.Mastered = true
+		}
+		result.Target = target
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Delete a trial recorded by mistake. A target already marked mastered stays mastered.
+func (s *TrialService) Delete(id int) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+	return s.repo.Trial.Delete(id)
+}
+
+// TargetProgress scores a target's trials session by session
+func (s *TrialService) TargetProgress(targetID int) (*TargetProgress, error) {
+	target, err := s.repo.TreatmentPlan.FindTargetByID(targetID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrTargetNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	plan, err := s.repo.TreatmentPlan.FindByID(target.PlanID)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.Patient.FindByID(plan.PatientID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTargetNotFound
+		}
+		return nil, err
+	}
+
+	sessions, err := targetSessions(s.repo, target)
+	if err != nil {
+		return nil, err
+	}
+	return &TargetProgress{Target: target, Sessions: sessions}, nil
+}
+
+// targetSessions scores a target's trials by the session they were run in, earliest first.
+// Correct trials are the independent ones.
+func targetSessions(repo *repository.Repository, target *models.TreatmentTarget) ([]*TargetSession, error) {
+	trials, err := repo.Trial.FindByTargetID(target.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bySession := map[string]*TargetSession{}
+	sessions := []*TargetSession{}
+	for _, trial := range trials {
+		if trial.Activity == nil || trial.Activity.Session == nil {
+			continue
+		}
+		session, ok := bySession[trial.Activity.Session.ID]
+		if !ok {
+			session = &TargetSession{
+				SessionID: trial.Activity.Session.ID,
+				StartTime: trial.Activity.Session.StartTime,
+			}
+			bySession[session.SessionID] = session
+			sessions = append(sessions, session)
+		}
+		session.Trials++
+		if trial.Outcome == models.TrialCorrect {
+			session.Independent++
+		}
+	}
+
+	for _, session := range sessions {
+		session.PercentIndependent = round(float64(session.Independent) * 100 / float64(session.Trials))
+		session.MeetsCriterion = session.PercentIndependent >= target.MasteryPercent
+	}
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].StartTime.Before(sessions[j].StartTime)
+	})
+	return sessions, nil
+}
+
+// masteryMet reports whether the target's last sessions all met its mastery percent,
+// as many of them in a row as its criteria ask for
+func masteryMet(target *models.TreatmentTarget, sessions []*TargetSession) bool {
+	if target.MasterySessions < 1 || len(sessions) < target.MasterySessions {
+		return false
+	}
+	for _, session := range sessions[len(sessions)-target.MasterySessions:] {
+		if !session.MeetsCriterion {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTrial validates a trial's outcome against its prompt level. Correct trials are
+// independent whether or not they say so.
+func checkTrial(trial *models.Trial) error {
+	switch trial.Outcome {
+	case models.TrialCorrect:
+		if trial.PromptLevel != nil && *trial.PromptLevel != models.PromptIndependent {
+			return errors.New("correct trials are independent; record prompted responses as prompted")
+		}
+		independent := models.PromptIndependent
+		trial.PromptLevel = &independent
+	case models.TrialPrompted:
+		if trial.PromptLevel == nil {
+			return errors.New("prompted trials need a prompt level")
+		}
+		switch *trial.PromptLevel {
+		case models.PromptFullPhysical, models.PromptPartialPhysical, models.PromptGestural:
+		default:
+			return errors.New("prompt level must be full_physical, partial_physical or gestural")
+		}
+	case models.TrialIncorrect:
+		if trial.PromptLevel != nil {
+			return errors.New("incorrect trials have no prompt level")
+		}
+	default:
+		return errors.New("outcome must be correct, incorrect or prompted")
+	}
+	return nil
+}
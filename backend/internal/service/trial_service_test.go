@@ -0,0 +1,112 @@
+package service
+
+// backend/internal/service/trial_service_test.go
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"palaam/internal/models"
+)
+
+func TestMasteryMet(t *testing.T) {
+	// Sessions are given earliest first, by whether each met the mastery percent
+	tests := []struct {
+		name     string
+		sessions int
+		met      []bool
+		want     bool
+	}{
+		{"no sessions", 3, nil, false},
+		{"too few sessions", 3, []bool{true, true}, false},
+		{"enough in a row", 3, []bool{true, true, true}, true},
+		{"latest sessions in a row", 3, []bool{false, true, true, true}, true},
+		{"broken by the latest session", 3, []bool{true, true, true, false}, false},
+		{"broken in the middle", 3, []bool{true, true, false, true, true}, false},
+		{"one session", 1, []bool{false, true}, true},
+		{"no criterion", 0, []bool{true, true}, false},
+	}
+	for _, tt := range tests {
+		target := &models.TreatmentTarget{MasterySessions: tt.sessions}
+		sessions := make([]*TargetSession, len(tt.met))
+		for i, met := range tt.met {
+			sessions[i] = &TargetSession{MeetsCriterion: met}
+		}
+		if got := masteryMet(target, sessions); got != tt.want {
+			t.Errorf("%s: masteryMet() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTargetSessions(t *testing.T) {
+	repo := newTestRepository(t)
+	patient, staff := createTestPatient(t, repo)
+	plan := &models.TreatmentPlan{PatientID: patient.ID, Title: "Plan", Status: models.PlanActive, StartDate: "2026-01-01", ReviewDate: "2026-06-01", CreatedByID: staff.ID}
+	if err := repo.TreatmentPlan.Create(plan); err != nil {
+		t.Fatal(err)
+	}
+	goal := &models.TreatmentGoal{PlanID: plan.ID, Description: "Requests items"}
+	if err := repo.TreatmentPlan.CreateGoal(goal); err != nil {
+		t.Fatal(err)
+	}
+	target := &models.TreatmentTarget{PlanID: plan.ID, GoalID: goal.ID, Description: "Asks for water", MasteryPercent: 80, MasterySessions: 2}
+	if err := repo.TreatmentPlan.CreateTarget(target); err != nil {
+		t.Fatal(err)
+	}
+
+	// Sessions are recorded out of order, and one has two activities practising the target
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	sessions := []struct {
+		day      int
+		outcomes [][]models.TrialOutcome // Per activity
+	}{
+		{2, [][]models.TrialOutcome{{models.TrialCorrect, models.TrialCorrect, models.TrialCorrect, models.TrialCorrect, models.TrialCorrect}}},
+		{0, [][]models.TrialOutcome{{models.TrialCorrect, models.TrialIncorrect, models.TrialPrompted}}},
+		{1, [][]models.TrialOutcome{{models.TrialCorrect, models.TrialCorrect}, {models.TrialCorrect, models.TrialCorrect, models.TrialIncorrect}}},
+	}
+	for _, s := range sessions {
+		begins := start.AddDate(0, 0, s.day)
+		session := &models.Session{ID: uuid.NewString(), PatientID: patient.ID, StaffID: staff.ID, StartTime: begins, EndTime: begins.Add(time.Hour)}
+		if err := repo.Session.Create(session); err != nil {
+			t.Fatal(err)
+		}
+		for _, outcomes := range s.outcomes {
+			activity := &models.Activity{ID: uuid.NewString(), SessionID: &session.ID, TargetID: &target.ID}
+			if err := repo.Activity.Create(activity); err != nil {
+				t.Fatal(err)
+			}
+			trials := make([]*models.Trial, len(outcomes))
+			for i, outcome := range outcomes {
+				trials[i] = &models.Trial{ActivityID: activity.ID, Number: i + 1, Outcome: outcome, RecordedByID: staff.ID}
+			}
+			if err := repo.Trial.Create(trials); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	got, err := targetSessions(repo, target)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TargetSession{
+		{StartTime: start, Trials: 3, Independent: 1, PercentIndependent: 33.33, MeetsCriterion: false},
+		{StartTime: start.AddDate(0, 0, 1), Trials: 5, Independent: 4, PercentIndependent: 80, MeetsCriterion: true},
+		{StartTime: start.AddDate(0, 0, 2), Trials: 5, Independent: 5, PercentIndependent: 100, MeetsCriterion: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("targetSessions() found %d sessions, want %d", len(got), len(want))
+	}
+	for i, session := range got {
+		session.SessionID = ""
+		session.StartTime = session.StartTime.UTC()
+		if *session != want[i] {
+			t.Errorf("session %d = %+v, want %+v", i, *session, want[i])
+		}
+	}
+	if !masteryMet(target, got) {
+		t.Error("masteryMet() = false after two sessions in a row at 80% or more")
+	}
+}